@@ -0,0 +1,11 @@
+// Package tt bundles the builtin themes, word lists, quotes and keyboard layouts into the tt binary.
+package tt
+
+import "embed"
+
+// Resources holds the files under themes/, words/, quotes/ and layouts/ exactly as they
+// appear in the repository, keyed by their slash separated relative path
+// (e.g. "themes/gruvbox").
+//
+//go:embed themes themes/_base words quotes layouts
+var Resources embed.FS
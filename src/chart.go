@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+// secondStats counts the characters typed during one second of a test.
+type secondStats struct {
+	correct int
+	errors  int
+}
+
+// currentSecond returns the counts of the characters typed in the current second of the segment.
+func (st *typingState) currentSecond() *secondStats {
+	second := int(time.Since(st.startTime) / time.Second)
+	for len(st.timeline) <= second {
+		st.timeline = append(st.timeline, secondStats{})
+	}
+
+	return &st.timeline[second]
+}
+
+// The number of rows of the chart taken up by the WPM plot, and in total with the row of errors
+// and the time axis below it.
+const (
+	chartPlotRows = 6
+	chartHeight   = chartPlotRows + 2
+)
+
+// errorBars are the characters used to draw the number of errors made each second, fewest first.
+var errorBars = []rune("▁▂▃▄▅▆▇█")
+
+// wpmSamples returns the WPM of each second of a test of the given duration. The last second is
+// usually cut short, so its speed is calculated over the part of it the test lasted for.
+func wpmSamples(timeline []secondStats, duration time.Duration) []float64 {
+	var samples []float64
+
+	for i, s := range timeline {
+		length := time.Second
+		if i == len(timeline)-1 {
+			if rest := duration - time.Duration(i)*time.Second; rest > 0 && rest < length {
+				length = rest
+			}
+
+			// Too short to say anything meaningful.
+			if length < time.Second/4 && len(samples) > 0 {
+				break
+			}
+		}
+
+		samples = append(samples, float64(s.correct)/5/length.Minutes())
+	}
+
+	return samples
+}
+
+// consistency scores how steady the typist's speed was from one second to the next, out of 100, by
+// subtracting the coefficient of variation of the samples (their standard deviation relative to
+// their mean) from 1.
+func consistency(samples []float64) float64 {
+	if len(samples) == 0 {
+		return 0
+	}
+
+	var mean float64
+	for _, s := range samples {
+		mean += s
+	}
+	mean /= float64(len(samples))
+
+	if mean == 0 {
+		return 0
+	}
+
+	var variance float64
+	for _, s := range samples {
+		variance += (s - mean) * (s - mean)
+	}
+	variance /= float64(len(samples))
+
+	return math.Max(0, 100*(1-math.Sqrt(variance)/mean))
+}
+
+// drawChart draws the samples as a braille line chart of the given width with its top left corner
+// at x, y, with the errors made in each second as bars below it and the axes labelled.
+func drawChart(
+	scr tcell.Screen,
+	x, y, width int,
+	samples []float64,
+	timeline []secondStats,
+	duration time.Duration,
+	style, errorStyle tcell.Style,
+) {
+	max := 0.0
+	for _, s := range samples {
+		max = math.Max(max, s)
+	}
+	max = math.Ceil(max/10) * 10
+	if max == 0 {
+		max = 10
+	}
+
+	topLabel := fmt.Sprintf("%.0f", max)
+	labelWidth := len(topLabel)
+	if labelWidth < 3 {
+		labelWidth = 3
+	}
+
+	drawString(scr, x, y, fmt.Sprintf("%*s", labelWidth, topLabel), -1, style)
+	drawString(scr, x, y+chartPlotRows-1, fmt.Sprintf("%*s", labelWidth, "0"), -1, style)
+	drawString(scr, x, y+chartPlotRows, fmt.Sprintf("%*s", labelWidth, "err"), -1, errorStyle)
+
+	plotX := x + labelWidth + 1
+	plotWidth := width - labelWidth - 1
+	if plotWidth < 1 || len(samples) == 0 {
+		return
+	}
+
+	for row := 0; row < chartPlotRows; row++ {
+		scr.SetContent(plotX-1, y+row, '│', nil, style)
+	}
+
+	// Each cell holds a 2x4 grid of braille dots.
+	dotBits := [2][4]rune{{0x01, 0x02, 0x04, 0x40}, {0x08, 0x10, 0x20, 0x80}}
+	dotsWide, dotsHigh := plotWidth*2, chartPlotRows*4
+	cells := make([][]rune, chartPlotRows)
+	for i := range cells {
+		cells[i] = make([]rune, plotWidth)
+	}
+
+	setDot := func(col, row int) {
+		cells[row/4][col/2] |= dotBits[col%2][row%4]
+	}
+
+	previous := -1
+	for col := 0; col < dotsWide; col++ {
+		// Interpolate between the samples either side of the column.
+		v := samples[0]
+		if len(samples) > 1 {
+			pos := float64(col) * float64(len(samples)-1) / float64(dotsWide-1)
+			i := int(pos)
+			if i >= len(samples)-1 {
+				v = samples[len(samples)-1]
+			} else {
+				v = samples[i] + (samples[i+1]-samples[i])*(pos-float64(i))
+			}
+		}
+
+		row := dotsHigh - 1 - int(math.Round(v/max*float64(dotsHigh-1)))
+
+		// Join the dot to the previous one so the line is continuous.
+		from, to := row, row
+		if previous != -1 {
+			if previous < row {
+				from = previous + 1
+			} else if previous > row {
+				to = previous - 1
+			}
+		}
+		for r := from; r <= to; r++ {
+			setDot(col, r)
+		}
+		previous = row
+	}
+
+	for row := range cells {
+		for col, c := range cells[row] {
+			if c != 0 {
+				scr.SetContent(plotX+col, y+row, 0x2800+c, nil, style)
+			}
+		}
+	}
+
+	// Each cell of the error row covers the seconds falling within its share of the test.
+	errors := make([]int, plotWidth)
+	maxErrors := 0
+	for col := range errors {
+		first := col * len(timeline) / plotWidth
+		last := (col + 1) * len(timeline) / plotWidth
+		if last <= first {
+			last = first + 1
+		}
+
+		for i := first; i < last && i < len(timeline); i++ {
+			errors[col] += timeline[i].errors
+		}
+		if errors[col] > maxErrors {
+			maxErrors = errors[col]
+		}
+	}
+
+	for col, n := range errors {
+		if n > 0 {
+			bar := errorBars[(n*len(errorBars)-1)/maxErrors]
+			scr.SetContent(plotX+col, y+chartPlotRows, bar, nil, errorStyle)
+		}
+	}
+
+	end := fmt.Sprintf("%ds", int(duration.Seconds()))
+	drawString(scr, plotX, y+chartPlotRows+1, "0s", -1, style)
+	drawString(scr, plotX+plotWidth-len(end), y+chartPlotRows+1, end, -1, style)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// configFilePaths returns the locations searched for the configuration file, in order of precedence.
+func configFilePaths() []string {
+	home, _ := os.LookupEnv("HOME")
+
+	configHome, ok := os.LookupEnv("XDG_CONFIG_HOME")
+	if !ok || configHome == "" {
+		configHome = filepath.Join(home, ".config")
+	}
+
+	return []string{
+		filepath.Join(configHome, "tt", "config"),
+		filepath.Join(home, ".tt", "config"),
+	}
+}
+
+// applyConfig reads the first configuration file found and uses it to set every flag which was
+// not given on the command line. The file consists of 'flag: value' lines as accepted by
+// parseConfig. Lines of the form 'profile.flag: value' belong to the named profile and take
+// precedence over unqualified ones when that profile is selected, either with -profile or
+// with a 'profile: name' line.
+func applyConfig(profile string) {
+	var path string
+	var cfg map[string]string
+
+	for _, p := range configFilePaths() {
+		if b, err := os.ReadFile(p); err == nil {
+			path = p
+			cfg = parseConfig(b)
+			break
+		}
+	}
+
+	if cfg == nil {
+		if profile != "" {
+			die("Profile %s requested but no configuration file was found.", profile)
+		}
+		return
+	}
+
+	if profile == "" {
+		profile = cfg["profile"]
+	}
+
+	setOnCommandLine := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) { setOnCommandLine[f.Name] = true })
+
+	base := map[string]string{}
+	overrides := map[string]string{}
+	profileFound := false
+
+	for key, value := range cfg {
+		name := key
+		if i := strings.Index(key, "."); i != -1 {
+			name = key[i+1:]
+			if key[:i] == profile {
+				overrides[name] = value
+				profileFound = true
+			}
+		} else if name != "profile" {
+			base[name] = value
+		}
+
+		if flag.Lookup(name) == nil {
+			die("%s: %s is not a valid option.", path, key)
+		}
+	}
+
+	if profile != "" && !profileFound {
+		die("%s: profile %s is not defined.", path, profile)
+	}
+
+	for _, values := range []map[string]string{base, overrides} {
+		for name, value := range values {
+			if setOnCommandLine[name] {
+				continue
+			}
+
+			if err := flag.Set(name, value); err != nil {
+				die("%s: invalid value '%s' for %s: %v", path, value, name, err)
+			}
+		}
+	}
+}
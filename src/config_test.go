@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// configTest points the configuration paths at a temporary home, writes the given files to it
+// and registers a few flags on a fresh command line parsed from args.
+func configTest(t *testing.T, files map[string]string, args ...string) (n *int, theme *string, noskip *bool) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	for path, content := range files {
+		path = filepath.Join(home, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	commandLine := flag.CommandLine
+	t.Cleanup(func() { flag.CommandLine = commandLine })
+
+	flag.CommandLine = flag.NewFlagSet("tt", flag.ContinueOnError)
+	n = flag.Int("n", 50, "")
+	theme = flag.String("theme", "default", "")
+	noskip = flag.Bool("noskip", false, "")
+	flag.String("profile", "", "")
+	if err := flag.CommandLine.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+
+	return n, theme, noskip
+}
+
+func TestApplyConfig(t *testing.T) {
+	const config = `
+# Defaults
+n: 20
+theme: gruvbox
+noskip: true
+sprint.n: 10
+marathon.n: 500
+marathon.theme: nord
+`
+
+	for _, c := range []struct {
+		name    string
+		files   map[string]string
+		profile string
+		args    []string
+		n       int
+		theme   string
+	}{
+		{"defaults", map[string]string{".config/tt/config": config}, "", nil, 20, "gruvbox"},
+		{"profile", map[string]string{".config/tt/config": config}, "marathon", nil, 500, "nord"},
+		{"command line wins", map[string]string{".config/tt/config": config}, "marathon", []string{"-n", "5"}, 5, "nord"},
+		{"profile in the file", map[string]string{".config/tt/config": "profile: sprint\n" + config}, "", nil, 10, "gruvbox"},
+		{"old location", map[string]string{".tt/config": config}, "", nil, 20, "gruvbox"},
+		{"xdg first", map[string]string{".config/tt/config": config, ".tt/config": "n: 99"}, "", nil, 20, "gruvbox"},
+		{"no config", nil, "", nil, 50, "default"},
+	} {
+		n, theme, noskip := configTest(t, c.files, c.args...)
+		applyConfig(c.profile)
+
+		if *n != c.n || *theme != c.theme {
+			t.Errorf("%s: got -n %d -theme %s, expected -n %d -theme %s", c.name, *n, *theme, c.n, c.theme)
+		}
+		if expected := c.files != nil; *noskip != expected {
+			t.Errorf("%s: got -noskip %v, expected %v", c.name, *noskip, expected)
+		}
+	}
+}
+
+func TestApplyConfigXDGConfigHome(t *testing.T) {
+	n, _, _ := configTest(t, map[string]string{"elsewhere/tt/config": "n: 7", ".config/tt/config": "n: 99"})
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(os.Getenv("HOME"), "elsewhere"))
+
+	applyConfig("")
+	if *n != 7 {
+		t.Errorf("got -n %d, expected the config in XDG_CONFIG_HOME to set it to 7", *n)
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg := parseConfig([]byte("# comment: ignored\nkey: value: with colon \n  spaced  :  x\nnocolon\n"))
+
+	if len(cfg) != 2 || cfg["key"] != "value: with colon" || cfg["spaced"] != "x" {
+		t.Errorf("unexpected config %q", cfg)
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+)
+
+// diffStyle is the style of the text of a diff which was typed correctly.
+var diffStyle = tcell.StyleDefault
+
+// mistakeStyles are the styles the report shows mistakes in: the mistyped characters of the text
+// and what was typed in their place.
+type mistakeStyles struct {
+	incorrect tcell.Style
+	typed     tcell.Style
+}
+
+// mistakeStyles returns the colours of the typer's theme for mistakes, on the report's background.
+func (t *TyperScreen) mistakeStyles() mistakeStyles {
+	incorrect, _, _ := t.incorrectStyle.Decompose()
+	typed, _, _ := t.typedStyle.Decompose()
+
+	return mistakeStyles{
+		incorrect: tcell.StyleDefault.Foreground(incorrect),
+		typed:     tcell.StyleDefault.Foreground(typed),
+	}
+}
+
+// diffLines wraps the typed characters into lines of at most width characters (not counting the
+// space at the end of each line), breaking them between words, including those either side of a
+// line break of the text.
+func diffLines(chars []typedChar, width int) [][]typedChar {
+	var lines [][]typedChar
+	var line, word []typedChar
+
+	addWord := func() {
+		n := len(line) + len(word)
+		if word[len(word)-1].expected == ' ' {
+			n--
+		}
+
+		if n > width && len(line) > 0 {
+			lines = append(lines, line)
+			line = nil
+		}
+
+		line = append(line, word...)
+		word = nil
+	}
+
+	for _, c := range chars {
+		word = append(word, c)
+
+		// The line breaks of -raw input aren't preceded by a space, so one stands in for them.
+		if c.lineEnd && c.expected != ' ' {
+			word = append(word, typedChar{expected: ' ', typed: ' '})
+		}
+
+		if word[len(word)-1].expected == ' ' {
+			addWord()
+		}
+	}
+
+	if len(word) > 0 {
+		addWord()
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// drawDiff draws the given lines with their top left corner at x, y, each taking up two rows: the
+// text, with the characters which were mistyped highlighted and those which were skipped
+// underlined, and below it what was typed in place of the mistyped ones. Lines are cut off at
+// the given width.
+func drawDiff(scr tcell.Screen, x, y, width int, lines [][]typedChar, styles mistakeStyles) {
+	for i, line := range lines {
+		for j, c := range line {
+			if j >= width {
+				break
+			}
+
+			switch {
+			case c.typed == c.expected:
+				scr.SetContent(x+j, y+i*2, c.expected, nil, diffStyle)
+			case c.typed == 0:
+				scr.SetContent(x+j, y+i*2, c.expected, nil, styles.incorrect.Underline(true))
+			default:
+				scr.SetContent(x+j, y+i*2, c.expected, nil, styles.incorrect.Reverse(true))
+				typed := c.typed
+				if typed == ' ' {
+					typed = '␣'
+				}
+				scr.SetContent(x+j, y+i*2+1, typed, nil, styles.typed)
+			}
+		}
+	}
+}
+
+// viewDiff shows the whole text with the mistakes made typing it highlighted, scrolling through it
+// with the arrow keys if it doesn't fit on the screen. It reports whether the typist chose to
+// continue on from the report rather than go back to it.
+func viewDiff(scr tcell.Screen, chars []typedChar, styles mistakeStyles) bool {
+	first := 0
+
+	for {
+		sw, sh := scr.Size()
+		width := sw - 4
+		if width > 80 {
+			width = 80
+		}
+
+		// Two rows for the title and two for the footer.
+		lines := diffLines(chars, width)
+		visible := (sh - 4) / 2
+		if visible < 1 {
+			visible = 1
+		}
+
+		if first > len(lines)-visible {
+			first = len(lines) - visible
+		}
+		if first < 0 {
+			first = 0
+		}
+
+		last := first + visible
+		if last > len(lines) {
+			last = len(lines)
+		}
+
+		x := (sw - width) / 2
+		scr.Clear()
+		drawString(scr, x, 0, fmt.Sprintf("Lines %d-%d of %d", first+1, last, len(lines)), -1, diffStyle)
+		drawDiff(scr, x, 2, width, lines[first:last], styles)
+		drawString(scr, x, sh-1, "↑/↓ and PgUp/PgDn to scroll, d to go back, SPACE to continue.", -1, diffStyle)
+		scr.HideCursor()
+		scr.Show()
+
+		switch ev := scr.PollEvent().(type) {
+		case *tcell.EventKey:
+			switch ev.Key() {
+			case tcell.KeyUp:
+				first--
+			case tcell.KeyDown:
+				first++
+			case tcell.KeyPgUp:
+				first -= visible
+			case tcell.KeyPgDn:
+				first += visible
+			case tcell.KeyHome:
+				first = 0
+			case tcell.KeyEnd:
+				first = len(lines)
+			case tcell.KeyEscape:
+				return false
+			case tcell.KeyCtrlC:
+				exit(1)
+			case tcell.KeyRune:
+				switch ev.Rune() {
+				case 'k':
+					first--
+				case 'j':
+					first++
+				case 'd', 'q':
+					return false
+				case ' ':
+					return true
+				}
+			}
+		}
+	}
+}
@@ -8,8 +8,10 @@ import (
 
 // generateTestFromFile is a function that accepts a file path and a starting paragraph number.
 // It reads the file and segments it into paragraphs.
-// The function returns a closure that, when invoked, returns the next paragraph as a segment.
-func generateTestFromFile(filePath string, startParagraph int) func() []segment {
+// The function returns a closure that, when invoked, returns the next paragraph as a segment,
+// and a second closure which persists the index of the first untyped paragraph so that
+// subsequent invocations on the same file resume from there.
+func generateTestFromFile(filePath string, startParagraph int) (func() []segment, func(int)) {
 	var listOfParagraphs []string  // Contains file contents segmented into paragraphs
 	var fileStateDB map[string]int // Map to keep track of the last read paragraph for each file
 	var err error                  // error variable to catch errors
@@ -45,18 +47,24 @@ func generateTestFromFile(filePath string, startParagraph int) func() []segment
 		listOfParagraphs = getParagraphs(string(fileContentBytes))
 	}
 
+	// Progress is only persisted once the caller knows what happened to a paragraph
+	// (completed, skipped or navigated away from), never when it is merely generated.
+	saveProgress := func(paragraphIdx int) {
+		if paragraphIdx < 0 {
+			paragraphIdx = 0
+		}
+		if paragraphIdx > len(listOfParagraphs) {
+			paragraphIdx = len(listOfParagraphs)
+		}
+
+		fileStateDB[filePath] = paragraphIdx
+		writeValue(FILE_STATE_DB, fileStateDB)
+	}
+
 	// Return a closure function
 	return func() []segment {
 		// Increment the current paragraph index
 		currentParagraphIdx++
-		// step back the save, because this way we can resume the test from the previous paragraph if needed
-		// requires restart to step back, so it should be safe enough for now, otherwise we need to
-		// change the logic for customFunctionToExtractNextListOfSegments
-		stepBackSize := 1
-		// Update the state with the new paragraph index
-		fileStateDB[filePath] = currentParagraphIdx - stepBackSize
-		// Persist the updated state to disk
-		writeValue(FILE_STATE_DB, fileStateDB)
 
 		// If the current paragraph index exceeds the number of paragraphs, return nil
 		if currentParagraphIdx >= len(listOfParagraphs) {
@@ -73,5 +81,5 @@ func generateTestFromFile(filePath string, startParagraph int) func() []segment
 
 		// Return the current paragraph as a segment
 		return []segment{segment{listOfParagraphs[currentParagraphIdx], "", currentParagraphIdx}}
-	}
+	}, saveProgress
 }
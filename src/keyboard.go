@@ -0,0 +1,252 @@
+package main
+
+import (
+	"fmt"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell"
+)
+
+// finger identifies the finger which types a key when touch typing.
+type finger int
+
+const (
+	LeftPinky finger = iota
+	LeftRing
+	LeftMiddle
+	LeftIndex
+	Thumb
+	RightIndex
+	RightMiddle
+	RightRing
+	RightPinky
+)
+
+// The rows of a keyboard, from top to bottom.
+const (
+	NumberRow = iota
+	TopRow
+	HomeRow
+	BottomRow
+	SpaceRow
+)
+
+var fingerNames = []string{"left pinky", "left ring", "left middle", "left index", "thumb",
+	"right index", "right middle", "right ring", "right pinky"}
+
+var rowNames = []string{"number", "top", "home", "bottom", "space"}
+
+func (f finger) String() string {
+	return fingerNames[f]
+}
+
+// rowFingers assigns the keys of each row (on an ANSI keyboard) to fingers, keys past the end of
+// a row belong to the right pinky.
+var rowFingers = [][]finger{
+	NumberRow: {LeftPinky, LeftPinky, LeftRing, LeftMiddle, LeftIndex, LeftIndex, RightIndex, RightIndex, RightMiddle, RightRing, RightPinky},
+	TopRow:    {LeftPinky, LeftRing, LeftMiddle, LeftIndex, LeftIndex, RightIndex, RightIndex, RightMiddle, RightRing},
+	HomeRow:   {LeftPinky, LeftRing, LeftMiddle, LeftIndex, LeftIndex, RightIndex, RightIndex, RightMiddle, RightRing},
+	BottomRow: {LeftPinky, LeftRing, LeftMiddle, LeftIndex, LeftIndex, RightIndex, RightIndex, RightMiddle, RightRing},
+}
+
+// fingerColours are the colours of the keys typed by each finger, mirrored between the hands.
+var fingerColours = []tcell.Color{
+	tcell.NewHexColor(0xf4a6a6),
+	tcell.NewHexColor(0xf7d08a),
+	tcell.NewHexColor(0xa8e6a1),
+	tcell.NewHexColor(0x9cc9f5),
+	tcell.NewHexColor(0xd3b8f0),
+	tcell.NewHexColor(0x9cc9f5),
+	tcell.NewHexColor(0xa8e6a1),
+	tcell.NewHexColor(0xf7d08a),
+	tcell.NewHexColor(0xf4a6a6),
+}
+
+// keyboardKey is a key of a keyboard layout.
+type keyboardKey struct {
+	char    rune
+	shifted rune
+	row     int
+	finger  finger
+	x       int // The offset of the key from the left of the keyboard when drawn.
+}
+
+// keyboardLayout describes the characters produced by each key of a keyboard.
+type keyboardLayout struct {
+	name string
+	keys []keyboardKey
+	iso  bool // Whether the bottom row starts with the extra key of ISO keyboards.
+}
+
+// The dimensions of the keyboard diagram: each key is keyWidth cells wide followed by a gap.
+const (
+	keyWidth       = 3
+	keyboardWidth  = 58
+	keyboardHeight = 6 // Including the gap between the keyboard and the timer above it.
+)
+
+// rowOffsets is the offset of the first key of each row, which are staggered like a real keyboard.
+var rowOffsets = []int{NumberRow: 0, TopRow: 6, HomeRow: 7, BottomRow: 9}
+
+// parseKeyboardLayout parses a layout file, which gives the keys of each row from left to right
+// as 'ROW: keys' and 'shift_ROW: shifted keys' lines (ROW being number, top, home or bottom).
+func parseKeyboardLayout(name string, b []byte) (*keyboardLayout, error) {
+	cfg := parseConfig(b)
+	l := &keyboardLayout{name: name}
+
+	for row := NumberRow; row <= BottomRow; row++ {
+		chars := []rune(cfg[rowNames[row]])
+		shifted := []rune(cfg["shift_"+rowNames[row]])
+
+		if len(chars) == 0 {
+			return nil, fmt.Errorf("%s is not defined", rowNames[row])
+		}
+		if len(shifted) != len(chars) {
+			return nil, fmt.Errorf("shift_%s has %d keys but %s has %d", rowNames[row], len(shifted), rowNames[row], len(chars))
+		}
+
+		offset := rowOffsets[row]
+		fingers := rowFingers[row]
+		if row == BottomRow && len(chars) == 11 {
+			l.iso = true
+			offset -= keyWidth + 1
+			fingers = append([]finger{LeftPinky}, fingers...)
+		}
+
+		for i := range chars {
+			f := RightPinky
+			if i < len(fingers) {
+				f = fingers[i]
+			}
+
+			l.keys = append(l.keys, keyboardKey{chars[i], shifted[i], row, f, offset + i*(keyWidth+1)})
+		}
+	}
+
+	// The space bar spans the keys from c to m.
+	l.keys = append(l.keys, keyboardKey{' ', ' ', SpaceRow, Thumb, rowOffsets[BottomRow] + 2*(keyWidth+1)})
+
+	return l, nil
+}
+
+// loadKeyboardLayout reads and parses the given layout, dying if it does not exist or is invalid.
+func loadKeyboardLayout(name string) *keyboardLayout {
+	b := readResource("layouts", name)
+	if b == nil {
+		die("%s does not appear to be a valid layout, try '-list layouts' for a list of built in layouts.", name)
+	}
+
+	l, err := parseKeyboardLayout(name, b)
+	if err != nil {
+		die("%s: %v.", name, err)
+	}
+
+	return l
+}
+
+// layoutRemapping maps each character typed on a keyboard with the physical layout to the one the
+// key in the same position produces in the emulated layout, both with and without shift.
+func layoutRemapping(physical, emulated *keyboardLayout) map[rune]rune {
+	type position struct{ row, x int }
+
+	keyAt := map[position]keyboardKey{}
+	for _, k := range emulated.keys {
+		keyAt[position{k.row, k.x}] = k
+	}
+
+	m := map[rune]rune{}
+	for _, k := range physical.keys {
+		if e, ok := keyAt[position{k.row, k.x}]; ok {
+			m[k.char] = e.char
+			m[k.shifted] = e.shifted
+		}
+	}
+
+	return m
+}
+
+// keyFor returns the key which types the given character and whether shift must be held.
+func (l *keyboardLayout) keyFor(c rune) (key *keyboardKey, shifted bool) {
+	for i := range l.keys {
+		if l.keys[i].char == c {
+			return &l.keys[i], false
+		}
+	}
+
+	for i := range l.keys {
+		if l.keys[i].shifted == c {
+			return &l.keys[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// drawKeyboard draws the keyboard diagram with its top left corner at the given position, colouring
+// each key by the finger which types it. The key for the next character (and the shift key needed
+// to type it, on the opposite hand) is highlighted, and a key which was just mistyped flashes red.
+func (t *TyperScreen) drawKeyboard(x, y int, st *typingState) {
+	l := t.Keyboard
+
+	var next *keyboardKey
+	var nextShifted bool
+	if st.cursorPositionInText < len(st.referenceText) {
+		next, nextShifted = l.keyFor(st.referenceText[st.cursorPositionInText])
+	}
+
+	var mistyped *keyboardKey
+	if st.mistypedKey != 0 && time.Since(st.mistypedTime) < 300*time.Millisecond && !t.Blind {
+		mistyped, _ = l.keyFor(st.mistypedKey)
+	}
+
+	styleFor := func(k *keyboardKey) tcell.Style {
+		switch {
+		case k == mistyped:
+			return t.incorrectSpaceStyle
+		case k == next:
+			return t.defaultStyle.Reverse(true).Bold(true)
+		default:
+			return tcell.StyleDefault.Foreground(tcell.NewHexColor(0x000000)).Background(fingerColours[k.finger])
+		}
+	}
+
+	drawKey := func(x, y, width int, label string, style tcell.Style) {
+		for i := 0; i < width; i++ {
+			t.Screen.SetContent(x+i, y, ' ', nil, style)
+		}
+		drawString(t.Screen, x+(width-len([]rune(label)))/2, y, label, -1, style)
+	}
+
+	for i := range l.keys {
+		k := &l.keys[i]
+		if k.row == SpaceRow {
+			drawKey(x+k.x, y+k.row, 5*(keyWidth+1)-1, "", styleFor(k))
+		} else {
+			label := k.char
+			if unicode.ToUpper(k.char) == k.shifted {
+				label = k.shifted
+			}
+			drawKey(x+k.x, y+k.row, keyWidth, string(label), styleFor(k))
+		}
+	}
+
+	// Shift is held by the pinky of the hand which isn't typing the key.
+	bottomStart := rowOffsets[BottomRow]
+	if l.iso {
+		bottomStart -= keyWidth + 1
+	}
+	leftShift := tcell.StyleDefault.Foreground(tcell.NewHexColor(0x000000)).Background(fingerColours[LeftPinky])
+	rightShift := leftShift
+	if next != nil && nextShifted {
+		if next.finger < Thumb {
+			rightShift = t.defaultStyle.Reverse(true).Bold(true)
+		} else {
+			leftShift = t.defaultStyle.Reverse(true).Bold(true)
+		}
+	}
+
+	bottomEnd := rowOffsets[BottomRow] + 10*(keyWidth+1)
+	drawKey(x, y+BottomRow, bottomStart-1, "⇧", leftShift)
+	drawKey(x+bottomEnd, y+BottomRow, keyboardWidth-bottomEnd, "⇧", rightShift)
+}
@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestLayoutRemapping(t *testing.T) {
+	qwerty := loadKeyboardLayout("qwerty")
+	colemak := loadKeyboardLayout("colemak")
+	qwertz := loadKeyboardLayout("qwertz")
+
+	m := layoutRemapping(qwerty, colemak)
+	for from, to := range map[rune]rune{
+		'q': 'q',
+		'e': 'f',
+		'd': 's',
+		'k': 'e',
+		';': 'o',
+		'n': 'k',
+		'P': ':',
+		'J': 'N',
+	} {
+		if m[from] != to {
+			t.Errorf("qwerty to colemak: %q = %q, expected %q", from, m[from], to)
+		}
+	}
+
+	// The extra ISO key is to the left of the first ANSI key, so rows still line up.
+	m = layoutRemapping(qwertz, qwerty)
+	for from, to := range map[rune]rune{
+		'y': 'z',
+		'z': 'y',
+		'-': '/',
+		'Y': 'Z',
+		'ö': ';',
+	} {
+		if m[from] != to {
+			t.Errorf("qwertz to qwerty: %q = %q, expected %q", from, m[from], to)
+		}
+	}
+	if c, ok := m['<']; ok {
+		t.Errorf("the ISO key has no counterpart in qwerty, got %q", c)
+	}
+}
+
+func TestParseKeyboardLayoutProblems(t *testing.T) {
+	for _, c := range []struct {
+		layout, expected string
+	}{
+		{"number: 12\nshift_number: !@\n", "top is not defined"},
+		{"number: 12\nshift_number: !\n", "shift_number has 1 keys but number has 2"},
+	} {
+		if _, err := parseKeyboardLayout("test", []byte(c.layout)); err == nil || err.Error() != c.expected {
+			t.Errorf("parseKeyboardLayout(%q) = %v, expected %q", c.layout, err, c.expected)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// typedChar records a character of the text, what was typed in its place and how long the
+// keystroke took.
+type typedChar struct {
+	expected rune
+	typed    rune
+	interval time.Duration // The time since the previous keystroke, 0 if unknown (e.g. if skipped).
+	lineEnd  bool          // Whether a line break of the text follows it (they aren't typed).
+}
+
+// keyGroupStats holds the speed and accuracy of the characters typed by a finger, hand or row.
+type keyGroupStats struct {
+	Name     string  `json:"name"`
+	Chars    int     `json:"chars"`
+	Wpm      int     `json:"wpm"`
+	Accuracy float64 `json:"accuracy"`
+
+	correct int
+	timed   int // The number of correct characters whose interval is known.
+	time    time.Duration
+}
+
+// keyBreakdown breaks the results of a test down by finger, hand and row.
+type keyBreakdown struct {
+	Fingers []keyGroupStats `json:"fingers"`
+	Hands   []keyGroupStats `json:"hands"`
+	Rows    []keyGroupStats `json:"rows"`
+}
+
+// breakDownByKey computes the speed and accuracy of each finger, hand and row of the given layout
+// from the characters typed. Characters which aren't on the layout are ignored, as is the space
+// bar when grouping by hand and row. The breakdown is empty if no layout is given.
+func breakDownByKey(l *keyboardLayout, chars []typedChar) keyBreakdown {
+	if l == nil {
+		return keyBreakdown{}
+	}
+
+	fingers := make([]keyGroupStats, len(fingerNames))
+	hands := []keyGroupStats{{Name: "left"}, {Name: "right"}}
+	rows := make([]keyGroupStats, SpaceRow)
+
+	for i := range fingers {
+		fingers[i].Name = fingerNames[i]
+	}
+	for i := range rows {
+		rows[i].Name = rowNames[i]
+	}
+
+	add := func(g *keyGroupStats, c typedChar) {
+		g.Chars++
+		if c.typed == c.expected {
+			g.correct++
+			if c.interval > 0 {
+				g.timed++
+				g.time += c.interval
+			}
+		}
+	}
+
+	for _, c := range chars {
+		k, _ := l.keyFor(c.expected)
+		if k == nil {
+			continue
+		}
+
+		add(&fingers[k.finger], c)
+
+		if k.finger < Thumb {
+			add(&hands[0], c)
+		} else if k.finger > Thumb {
+			add(&hands[1], c)
+		}
+
+		if k.row != SpaceRow {
+			add(&rows[k.row], c)
+		}
+	}
+
+	// Only keep the groups which were used.
+	finish := func(groups []keyGroupStats) []keyGroupStats {
+		var used []keyGroupStats
+		for _, g := range groups {
+			if g.Chars == 0 {
+				continue
+			}
+
+			g.Accuracy = float64(g.correct) / float64(g.Chars) * 100
+			if g.time > 0 {
+				g.Wpm = int(float64(g.timed) / 5 / (float64(g.time) / 60e9))
+			}
+			used = append(used, g)
+		}
+
+		return used
+	}
+
+	return keyBreakdown{finish(fingers), finish(hands), finish(rows)}
+}
+
+// String formats the breakdown as tables of fingers, hands and rows side by side.
+func (b keyBreakdown) String() string {
+	tables := []struct {
+		title  string
+		groups []keyGroupStats
+		width  int
+	}{
+		{"Finger", b.Fingers, 12},
+		{"Hand", b.Hands, 5},
+		{"Row", b.Rows, 6},
+	}
+
+	numLines := 0
+	for _, t := range tables {
+		if len(t.groups) > numLines {
+			numLines = len(t.groups)
+		}
+	}
+
+	if numLines == 0 {
+		return ""
+	}
+
+	lines := make([]string, numLines+1)
+	for _, t := range tables {
+		lines[0] += fmt.Sprintf("%-*s %4s %8s   ", t.width, t.title, "WPM", "Accuracy")
+		for i := 0; i < numLines; i++ {
+			if i < len(t.groups) {
+				g := t.groups[i]
+				lines[i+1] += fmt.Sprintf("%-*s %4d %7.2f%%   ", t.width, g.Name, g.Wpm, g.Accuracy)
+			} else {
+				lines[i+1] += fmt.Sprintf("%-*s %4s %8s   ", t.width, "", "", "")
+			}
+		}
+	}
+
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -1,209 +0,0 @@
-package main
-
-import "encoding/base64"
-
-func readPackedFile(path string) []byte {
-	if b, ok := packedFiles[path]; !ok {
-		return nil
-	} else {
-		b, err := base64.StdEncoding.DecodeString(b)
-		if err != nil {
-			panic(err)
-		}
-
-		return b
-	}
-}
-
-var packedFiles = map[string]string{
-	"themes/slate":                          "Ymdjb2w6ICMyMjIyMjIKZmdjb2w6ICMzNWIxZDIKaGljb2w6ICMwMmM1ZTAKaGljb2wyOiAjYmM5OWEyCmhpY29sMzogI2ZmY2RkOQplcnJjb2w6ICNlMmE4YmYK",
-	"themes/ocean":                          "Ymdjb2w6ICMyMjRmYmMKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNiZmJmYmYKaGljb2wyOiAjYWExNzM4CmhpY29sMzogI2U1MDAwMAplcnJjb2w6ICM5OTAwMDAK",
-	"themes/novel":                          "Ymdjb2w6ICNkZmRiYzMKZmdjb2w6ICMzYjIzMjIKaGljb2w6ICNjY2NjY2MKaGljb2wyOiAjZDE0MTNhCmhpY29sMzogI2NjMDAwMAplcnJjb2w6ICNjYzAwMDAK",
-	"themes/nord-light":                     "Ymdjb2w6ICNlYmVhZjIKZmdjb2w6ICMwMDRmN2MKaGljb2w6ICNCM0IzQjMKaGljb2wyOiAjZTZhM2I0CmhpY29sMzogI0U0ODU5QQplcnJjb2w6ICNFNjQ1NjkK",
-	"themes/rippedcasts":                    "Ymdjb2w6ICMyYjJiMmIKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNiZmJmYmYKaGljb2wyOiAjYjM5Yjg2CmhpY29sMzogI2VlY2JhZAplcnJjb2w6ICNjZGFmOTUK",
-	"themes/mar":                            "Ymdjb2w6ICNmZmZmZmYKZmdjb2w6ICMyMzQ3NmEKaGljb2w6ICNmOGY4ZjgKaGljb2wyOiAjZGM5ZGJjCmhpY29sMzogI2NkNzNhMAplcnJjb2w6ICNiNTQwN2IK",
-	"themes/vs-code-dark-plus":              "Ymdjb2w6ICMxRTFFMUUKZmdjb2w6ICNDQ0NDQ0MKaGljb2w6ICNDM0RERTEKaGljb2wyOiAjYWE0MTI1CmhpY29sMzogI0U2NTAyOQplcnJjb2w6ICNFOTY1M0IK",
-	"themes/material":                       "Ymdjb2w6ICMxRTI4MkMKZmdjb2w6ICNDM0M3RDEKaGljb2w6ICNGRkZGRkYKaGljb2wyOiAjYWQ0ZjU4CmhpY29sMzogI0VCNjA2QgplcnJjb2w6ICNFQjYwNkIK",
-	"themes/pro":                            "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNmMmYyZjIKaGljb2w6ICNiZmJmYmYKaGljb2wyOiAjYTAwMDAwCmhpY29sMzogI2U1MDAwMAplcnJjb2w6ICM5OTAwMDAK",
-	"themes/pnevma":                         "Ymdjb2w6ICMxYzFjMWMKZmdjb2w6ICNkMGQwZDAKaGljb2w6ICNkMGQwZDAKaGljb2wyOiAjOWU2NjY2CmhpY29sMzogI2Q3ODc4NwplcnJjb2w6ICNhMzY2NjYK",
-	"themes/vibrant-ink":                    "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNmNWY1ZjUKaGljb2wyOiAjYjIwMDAwCmhpY29sMzogI2ZmMDAwMAplcnJjb2w6ICNmZjY2MDAK",
-	"themes/crayon-pony-fish":               "Ymdjb2w6ICMxNTA3MDcKZmdjb2w6ICM2ODUyNWEKaGljb2w6ICM2ODUyNWEKaGljb2wyOiAjOTAxYzQzCmhpY29sMzogI2M1MjU1ZAplcnJjb2w6ICM5MTAwMmIK",
-	"themes/3024-night":                     "Ymdjb2w6ICMwOTAzMDAKZmdjb2w6ICNhNWEyYTIKaGljb2w6ICNhNWEyYTIKaGljb2wyOiAjYTU4MzkxCmhpY29sMzogI2U4YmJkMAplcnJjb2w6ICNkYjJkMjAK",
-	"themes/mono-white":                     "Ymdjb2w6ICMyNjI2MjYKZmdjb2w6ICNGQUZBRkEKaGljb2w6ICNGQUZBRkEKaGljb2wyOiAjYmFiYWJhCmhpY29sMzogI0ZBRkFGQQplcnJjb2w6ICNGQUZBRkEK",
-	"themes/broadcast":                      "Ymdjb2w6ICMyYjJiMmIKZmdjb2w6ICNlNmUxZGMKaGljb2w6ICNmZmZmZmYKaGljb2wyOiAjYmY2MzU3CmhpY29sMzogI2ZmN2I2YgplcnJjb2w6ICNkYTQ5MzkK",
-	"themes/shaman":                         "Ymdjb2w6ICMwMDEwMTUKZmdjb2w6ICM0MDU1NTUKaGljb2w6ICM0MDU1NTUKaGljb2wyOiAjYjIzMjM0CmhpY29sMzogI2ZmNDI0MgplcnJjb2w6ICNiMjMwMmQK",
-	"themes/symphonic":                      "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNmZmZmZmYKaGljb2wyOiAjOWEyMzIwCmhpY29sMzogI2RjMzIyZgplcnJjb2w6ICNkYzMyMmYK",
-	"themes/github":                         "Ymdjb2w6ICNmNGY0ZjQKZmdjb2w6ICMzZTNlM2UKaGljb2w6ICNmZmZmZmYKaGljb2wyOiAjZTQ0OTQ5CmhpY29sMzogI2RlMDAwMAplcnJjb2w6ICM5NzBiMTYK",
-	"themes/atom":                           "Ymdjb2w6ICMxNjE3MTkKZmdjb2w6ICNjNWM4YzYKaGljb2w6ICNlMGUwZTAKaGljb2wyOiAjYjc0OWIwCmhpY29sMzogI2ZkNWZmMQplcnJjb2w6ICNmZDVmZjEK",
-	"themes/one-half-black":                 "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNkY2RmZTQKaGljb2w6ICNkY2RmZTQKaGljb2wyOiAjOWM0YjUxCmhpY29sMzogI2UwNmM3NQplcnJjb2w6ICNlMDZjNzUK",
-	"themes/soft-server":                    "Ymdjb2w6ICMyNDI2MjYKZmdjb2w6ICM5OWEzYTIKaGljb2w6ICM5OWEzYTIKaGljb2wyOiAjYTU0YjRlCmhpY29sMzogI2RkNWM2MAplcnJjb2w6ICNhMjY4NmEK",
-	"themes/hurtado":                        "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNkYmRiZGIKaGljb2w6ICNjYmNjY2IKaGljb2wyOiAjOTUxNDAwCmhpY29sMzogI2Q1MWQwMAplcnJjb2w6ICNmZjFiMDAK",
-	"themes/gruvbox":                        "Ymdjb2w6ICNmYmYxYzcKZmdjb2w6ICMzYzM4MzYKaGljb2w6ICM3YzZmNjQKaGljb2wyOiAjYjk0ODNmCmhpY29sMzogIzlkMDAwNgplcnJjb2w6ICNjYzI0MWQK",
-	"themes/medallion":                      "Ymdjb2w6ICMxZDE5MDgKZmdjb2w6ICNjYWMyOTYKaGljb2w6ICNjYWMyOWEKaGljb2wyOiAjYmI2ZDM1CmhpY29sMzogI2ZmOTE0OQplcnJjb2w6ICNiNjRjMDAK",
-	"themes/darkside":                       "Ymdjb2w6ICMyMjIzMjQKZmdjb2w6ICNiYWJhYmEKaGljb2w6ICNiYWJhYmEKaGljb2wyOiAjYTY0OTQyCmhpY29sMzogI2UwNWE0ZgplcnJjb2w6ICNlODM0MWMK",
-	"themes/tomorrow-night-blue":            "Ymdjb2w6ICMwMDI0NTEKZmdjb2w6ICNGRkZFRkUKaGljb2w6ICNGRkZFRkUKaGljb2wyOiAjYjI3NzhhCmhpY29sMzogI0ZGOUNBMwplcnJjb2w6ICNGRjlEQTMK",
-	"themes/solarized-light":                "Ymdjb2w6ICNGREY2RTMKZmdjb2w6ICM2NTdCODMKaGljb2w6ICMwMDJCMzYKaGljb2wyOiAjODk5Njk2CmhpY29sMzogIzU4NkU3NQplcnJjb2w6ICM4NTk5MDAK",
-	"themes/tomorrow-night-bright":          "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNFOUU5RTkKaGljb2w6ICNGRkZFRkUKaGljb2wyOiAjOTQzNTNhCmhpY29sMzogI0Q0NEQ1MwplcnJjb2w6ICNENTRFNTMK",
-	"themes/molokai":                        "Ymdjb2w6ICMxYjFkMWUKZmdjb2w6ICNCQkJCQkIKaGljb2w6ICNCQkJCQkIKaGljb2wyOiAjNzU1MGI1CmhpY29sMzogIzlENjZGNgplcnJjb2w6ICM3MzI1RkEK",
-	"themes/hipster-green":                  "Ymdjb2w6ICMxMDBiMDUKZmdjb2w6ICM4NGMxMzgKaGljb2w6ICNiZmJmYmYKaGljb2wyOiAjYTUwMzAxCmhpY29sMzogI2U1MDAwMAplcnJjb2w6ICNiNjIxNGEK",
-	"themes/3024-day":                       "Ymdjb2w6ICNmN2Y3ZjcKZmdjb2w6ICM0YTQ1NDMKaGljb2w6ICNhNWEyYTIKaGljb2wyOiAjZWNjZGRiCmhpY29sMzogI2U4YmJkMAplcnJjb2w6ICNkYjJkMjAK",
-	"themes/royal":                          "Ymdjb2w6ICMxMDA4MTUKZmdjb2w6ICM1MTQ5NjgKaGljb2w6ICM1MjQ5NjYKaGljb2wyOiAjOTkyNzUxCmhpY29sMzogI2Q1MzU2YwplcnJjb2w6ICM5MTI4NGMK",
-	"themes/cai":                            "Ymdjb2w6ICMwOTExMWEKZmdjb2w6ICNkOWU2ZjIKaGljb2w6ICM4MDgwODAKaGljb2wyOiAjYTU2Nzc5CmhpY29sMzogI2U5OGRhMwplcnJjb2w6ICNjYTI3NGQK",
-	"themes/frontend-galaxy":                "Ymdjb2w6ICMxZDI4MzcKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYjc2ZTc0CmhpY29sMzogI2ZhOGM4ZgplcnJjb2w6ICNmOTU1NWYK",
-	"themes/frontend-delight":               "Ymdjb2w6ICMxYjFjMWQKZmdjb2w6ICNhZGFkYWQKaGljb2w6ICNhZGFkYWQKaGljb2wyOiAjYjQzNzFhCmhpY29sMzogI2Y3NDMxOQplcnJjb2w6ICNmODUxMWIK",
-	"themes/gooey":                          "Ymdjb2w6ICMwRDEwMUIKZmdjb2w6ICNFQkVFRjkKaGljb2w6ICM4NTg4OTMKaGljb2wyOiAjYWE1Zjc3CmhpY29sMzogI2VlODI5ZgplcnJjb2w6ICNCQjRGNkMK",
-	"themes/earthsong":                      "Ymdjb2w6ICMyOTI1MjAKZmdjb2w6ICNlNWM3YTkKaGljb2w6ICNlNWM2YWEKaGljb2wyOiAjYmU1MTQ4CmhpY29sMzogI2ZmNjQ1YQplcnJjb2w6ICNjOTQyMzQK",
-	"themes/borland":                        "Ymdjb2w6ICMwMDAwYTQKZmdjb2w6ICNmZmZmNGUKaGljb2w6ICNlZWVlZWUKaGljb2wyOiAjYjI3ZmFjCmhpY29sMzogI2ZmYjZiMAplcnJjb2w6ICNmZjZjNjAK",
-	"themes/cobalt-neon":                    "Ymdjb2w6ICMxNDI4MzgKZmdjb2w6ICM4ZmY1ODYKaGljb2w6ICNiYTQ2YjIKaGljb2wyOiAjOWEyZTMxCmhpY29sMzogI2Q0MzEyZQplcnJjb2w6ICNmZjIzMjAK",
-	"themes/oceanic-next":                   "Ymdjb2w6ICMxMjFiMjEKZmdjb2w6ICNiM2I4YzMKaGljb2w6ICNGRkZGRkYKaGljb2wyOiAjYTUzOTQ0CmhpY29sMzogI0U0NDc1NAplcnJjb2w6ICNFNDQ3NTQK",
-	"themes/wryan":                          "Ymdjb2w6ICMxMDEwMTAKZmdjb2w6ICM5OTk5OTMKaGljb2w6ICM4OTljYTEKaGljb2wyOiAjOGEzYTVlCmhpY29sMzogI2JmNGQ4MAplcnJjb2w6ICM4YzQ2NjUK",
-	"themes/man-page":                       "Ymdjb2w6ICNmZWY0OWMKZmdjb2w6ICMwMDAwMDAKaGljb2w6ICNjY2NjY2MKaGljb2wyOiAjZWM0OTJlCmhpY29sMzogI2U1MDAwMAplcnJjb2w6ICNjYzAwMDAK",
-	"themes/belafonte-day":                  "Ymdjb2w6ICNkNWNjYmEKZmdjb2w6ICM0NTM3M2MKaGljb2w6ICM5NjhjODMKaGljb2wyOiAjYzQ0ODQxCmhpY29sMzogI2JlMTAwZQplcnJjb2w6ICNiZTEwMGUK",
-	"themes/one-light":                      "Ymdjb2w6ICNGOEY4RjgKZmdjb2w6ICMyQTJCMzIKaGljb2w6ICM4RThGOTYKaGljb2wyOiAjZTM3NTcyCmhpY29sMzogI0RBM0UzOQplcnJjb2w6ICNEQTNFMzkK",
-	"themes/japanesque":                     "Ymdjb2w6ICMxZTFlMWUKZmdjb2w6ICNmN2Y2ZWMKaGljb2w6ICNmYWZhZjYKaGljb2wyOiAjOWI2ZDdkCmhpY29sMzogI2QxOGZhNgplcnJjb2w6ICNjZjNmNjEK",
-	"themes/chalkboard":                     "Ymdjb2w6ICMyOTI2MmYKZmdjb2w6ICNkOWU2ZjIKaGljb2w6ICNkOWQ5ZDkKaGljb2wyOiAjYTU4Mjg1CmhpY29sMzogI2RiYWFhYQplcnJjb2w6ICNjMzczNzIK",
-	"themes/sundried":                       "Ymdjb2w6ICMxYTE4MTgKZmdjb2w6ICNjOWM5YzkKaGljb2w6ICNjOWM5YzkKaGljb2wyOiAjN2UwNzBmCmhpY29sMzogI2FhMDAwYwplcnJjb2w6ICNhNzQ2M2QK",
-	"themes/aci":                            "Ymdjb2w6ICMwZDE5MjYKZmdjb2w6ICNiNGUxZmQKaGljb2w6ICNiNmI2YjYKaGljb2wyOiAjYjYxYzZlCmhpY29sMzogI2ZmMWU4ZQplcnJjb2w6ICNmZjA4ODMK",
-	"themes/obsidian":                       "Ymdjb2w6ICMyODMwMzMKZmdjb2w6ICNjZGNkY2QKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYmUwZTExCmhpY29sMzogI2ZmMDAwMwplcnJjb2w6ICNhNjAwMDEK",
-	"themes/papercolor-dark":                "Ymdjb2w6ICMxQzFDMUMKZmdjb2w6ICNEMEQwRDAKaGljb2w6ICNEMEQwRDAKaGljb2wyOiAjNGE4MjRhCmhpY29sMzogIzVGQUY1RgplcnJjb2w6ICNBRjAwNUYK",
-	"themes/urple":                          "Ymdjb2w6ICMxYjFiMjMKZmdjb2w6ICM4NzdhOWIKaGljb2w6ICM4Nzc5OWMKaGljb2wyOiAjYmE0ZDY5CmhpY29sMzogI2ZmNjM4OAplcnJjb2w6ICNiMDQyNWIK",
-	"themes/desert":                         "Ymdjb2w6ICMzMzMzMzMKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNmNWRlYjMKaGljb2wyOiAjYzE0YTRhCmhpY29sMzogI2ZmNTU1NQplcnJjb2w6ICNmZjJiMmIK",
-	"themes/sat":                            "Ymdjb2w6ICM3NTg0ODAKZmdjb2w6ICMyMzQ3NmEKaGljb2w6ICNmMmYyZjIKaGljb2wyOiAjZDU3ODdhCmhpY29sMzogI2ZmNzQ3OAplcnJjb2w6ICNkZDAwMDcK",
-	"themes/ciapre":                         "Ymdjb2w6ICMxOTFjMjcKZmdjb2w6ICNhZWE0N2EKaGljb2w6ICNhZWE0N2YKaGljb2wyOiAjN2YyZjMwCmhpY29sMzogI2FjMzgzNQplcnJjb2w6ICM4MTAwMDkK",
-	"themes/clone-of-ubuntu":                "Ymdjb2w6ICMzMDBhMjQKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNEM0Q3Q0YKaGljb2wyOiAjYjUxZjI3CmhpY29sMzogI0VGMjkyOQplcnJjb2w6ICNDQzAwMDAK",
-	"themes/birds-of-paradise":              "Ymdjb2w6ICMyYTFmMWQKZmdjb2w6ICNlMGRiYjcKaGljb2w6ICNlMGRiYjcKaGljb2wyOiAjYWUzYTI0CmhpY29sMzogI2U4NDYyNwplcnJjb2w6ICNiZTJkMjYK",
-	"themes/mono-red":                       "Ymdjb2w6ICMyQjBDMDAKZmdjb2w6ICNGRjM2MDAKaGljb2w6ICNGRjM2MDAKaGljb2wyOiAjYmYyOTAwCmhpY29sMzogI0ZGMzYwMAplcnJjb2w6ICNGRjM2MDAK",
-	"themes/elementary":                     "Ymdjb2w6ICMxMDEwMTAKZmdjb2w6ICNmMmYyZjIKaGljb2w6ICNmMmYyZjIKaGljb2wyOiAjYjcyYTE5CmhpY29sMzogI2ZmMzYxZQplcnJjb2w6ICNlMTMyMWEK",
-	"themes/espresso":                       "Ymdjb2w6ICMzMjMyMzIKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNlZWVlZWMKaGljb2wyOiAjYjcxNzE3CmhpY29sMzogI2YwMGMwYwplcnJjb2w6ICNkMjUyNTIK",
-	"themes/paul-millr":                     "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNmMmYyZjIKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYjIwMDU5CmhpY29sMzogI2ZmMDA4MAplcnJjb2w6ICNmZjAwMDAK",
-	"themes/highway":                        "Ymdjb2w6ICMyMjIyMjUKZmdjb2w6ICNlZGVkZWQKaGljb2w6ICNlZGVkZWQKaGljb2wyOiAjYjI2MjFiCmhpY29sMzogI2YwN2UxOAplcnJjb2w6ICNkMDBlMTgK",
-	"themes/ollie":                          "Ymdjb2w6ICMyMjIxMjUKZmdjb2w6ICM4YThkYWUKaGljb2w6ICM4YThlYWMKaGljb2wyOiAjYmMzNDNkCmhpY29sMzogI2ZmM2Q0OAplcnJjb2w6ICNhYzJlMzEK",
-	"themes/pencil-light":                   "Ymdjb2w6ICNmMWYxZjEKZmdjb2w6ICM0MjQyNDIKaGljb2w6ICNkOWQ5ZDkKaGljb2wyOiAjZjg0ODlkCmhpY29sMzogI2ZiMDA3YQplcnJjb2w6ICNjMzA3NzEK",
-	"themes/sea-shells":                     "Ymdjb2w6ICMwOTE0MWIKZmdjb2w6ICNkZWI4OGQKaGljb2w6ICNkZWI4OGQKaGljb2wyOiAjOTc2MzVjCmhpY29sMzogI2Q0ODY3OAplcnJjb2w6ICNkMTUxMjMK",
-	"themes/mathias":                        "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNiYmJiYmIKaGljb2w6ICNmMmYyZjIKaGljb2wyOiAjYjIzYjNiCmhpY29sMzogI2ZmNTU1NQplcnJjb2w6ICNlNTIyMjIK",
-	"themes/tomorrow-night-eighties":        "Ymdjb2w6ICMyQzJDMkMKZmdjb2w6ICNDQ0NDQ0MKaGljb2w6ICNGRkZFRkUKaGljb2wyOiAjYjU2MDYxCmhpY29sMzogI0YxNzc3OQplcnJjb2w6ICNGMjc3NzkK",
-	"themes/n0tch2k":                        "Ymdjb2w6ICMyMjIyMjIKZmdjb2w6ICNhMGEwYTAKaGljb2w6ICNkMGI4YTMKaGljb2wyOiAjODA1ZDVjCmhpY29sMzogI2E5Nzc3NQplcnJjb2w6ICNhOTU1NTEK",
-	"themes/hemisu-light":                   "Ymdjb2w6ICNFRkVGRUYKZmdjb2w6ICM0NDQ0NDQKaGljb2w6ICM5OTk5OTkKaGljb2wyOiAjZGQ4OTlhCmhpY29sMzogI0Q2NUU3NgplcnJjb2w6ICNGRjAwNTUK",
-	"themes/jellybeans":                     "Ymdjb2w6ICMxMjEyMTIKZmdjb2w6ICNkZWRlZGUKaGljb2w6ICNkZWRlZGUKaGljb2wyOiAjYjc3Njc2CmhpY29sMzogI2ZmYTFhMQplcnJjb2w6ICNlMjczNzMK",
-	"themes/grass":                          "Ymdjb2w6ICMxMzc3M2QKZmdjb2w6ICNmZmYwYTUKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjODgyMzEyCmhpY29sMzogI2JiMDAwMAplcnJjb2w6ICNiYjAwMDAK",
-	"themes/fishtank":                       "Ymdjb2w6ICMyMzI1MzcKZmdjb2w6ICNlY2YwZmUKaGljb2w6ICNlY2YwZmMKaGljb2wyOiAjYTMzZjcxCmhpY29sMzogI2RhNGI4YQplcnJjb2w6ICNjNjAwNGEK",
-	"themes/paraiso-dark":                   "Ymdjb2w6ICMyZjFlMmUKZmdjb2w6ICNhMzllOWIKaGljb2w6ICNhMzllOWIKaGljb2wyOiAjYjU0YzQ5CmhpY29sMzogI2VmNjE1NQplcnJjb2w6ICNlZjYxNTUK",
-	"themes/alien-blood":                    "Ymdjb2w6ICMwZjE2MTAKZmdjb2w6ICM2MzdkNzUKaGljb2w6ICM2NDdkNzUKaGljb2wyOiAjYTE2MDBiCmhpY29sMzogI2UwODAwOQplcnJjb2w6ICM3ZjJiMjcK",
-	"themes/mono-cyan":                      "Ymdjb2w6ICMwMDIyMkIKZmdjb2w6ICMwMENDRkYKaGljb2w6ICMwMENDRkYKaGljb2wyOiAjMDA5OGJmCmhpY29sMzogIzAwQ0NGRgplcnJjb2w6ICMwMENDRkYK",
-	"themes/spacegray":                      "Ymdjb2w6ICMyMDI0MmQKZmdjb2w6ICNiM2I4YzMKaGljb2w6ICNiM2I4YzMKaGljb2wyOiAjODQzZjRhCmhpY29sMzogI2IwNGI1NwplcnJjb2w6ICNiMDRiNTcK",
-	"themes/solarized-dark-higher-contrast": "Ymdjb2w6ICMwMDFlMjcKZmdjb2w6ICM5Y2MyYzMKaGljb2w6ICNlYWUzY2IKaGljb2wyOiAjYWIxODM1CmhpY29sMzogI2Y1MTYzYgplcnJjb2w6ICNkMTFjMjQK",
-	"themes/hemisu-dark":                    "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNGRkZGRkYKaGljb2w6ICNFREVERUQKaGljb2wyOiAjOTU0MTUxCmhpY29sMzogI0Q2NUU3NQplcnJjb2w6ICNGRjAwNTQK",
-	"themes/square":                         "Ymdjb2w6ICMwYTFlMjQKZmdjb2w6ICMxYTFhMWEKaGljb2w6ICNmMmYyZjIKaGljb2wyOiAjYjE2ZjY4CmhpY29sMzogI2Y5OTI4NgplcnJjb2w6ICNlOTg5N2MK",
-	"themes/tomorrow":                       "Ymdjb2w6ICNGRkZGRkYKZmdjb2w6ICM0RDRENEMKaGljb2w6ICNGRkZFRkUKaGljb2wyOiAjZDg2ODY4CmhpY29sMzogI0M4MjgyOAplcnJjb2w6ICNDODI4MjgK",
-	"themes/belafonte-night":                "Ymdjb2w6ICMyMDExMWIKZmdjb2w6ICM5NjhjODMKaGljb2w6ICM5NjhjODMKaGljb2wyOiAjOGUxMDExCmhpY29sMzogI2JlMTAwZQplcnJjb2w6ICNiZTEwMGUK",
-	"themes/azu":                            "Ymdjb2w6ICMwOTExMWEKZmdjb2w6ICNkOWU2ZjIKaGljb2w6ICNlNmU2ZTYKaGljb2wyOiAjOTg4NThiCmhpY29sMzogI2Q2YjhiYwplcnJjb2w6ICNhYzZkNzQK",
-	"themes/jup":                            "Ymdjb2w6ICM3NTg0ODAKZmdjb2w6ICMyMzQ3NmEKaGljb2w6ICNmMmYyZjIKaGljb2wyOiAjZDU3OGE3CmhpY29sMzogI2ZmNzRiOQplcnJjb2w6ICNkZDAwNmYK",
-	"themes/treehouse":                      "Ymdjb2w6ICMxOTE5MTkKZmdjb2w6ICM3ODZiNTMKaGljb2w6ICM3ODZiNTMKaGljb2wyOiAjYWQ0ODFkCmhpY29sMzogI2VkNWQyMAplcnJjb2w6ICNiMjI3MGUK",
-	"themes/warm-neon":                      "Ymdjb2w6ICM0MDQwNDAKZmdjb2w6ICNhZmRhYjYKaGljb2w6ICNkMGI4YTMKaGljb2wyOiAjYjY2MTYyCmhpY29sMzogI2U5NzA3MQplcnJjb2w6ICNlMjQzNDYK",
-	"themes/frontend-fun-forrest":           "Ymdjb2w6ICMyNTEyMDAKZmdjb2w6ICNkZWMxNjUKaGljb2w6ICNkZGMyNjUKaGljb2wyOiAjYWI0NDEzCmhpY29sMzogI2U1NWExYwplcnJjb2w6ICNkNjI2MmIK",
-	"themes/terminal-basic":                 "Ymdjb2w6ICNmZmZmZmYKZmdjb2w6ICMwMDAwMDAKaGljb2w6ICNiZmJmYmYKaGljb2wyOiAjZWM0YzRjCmhpY29sMzogI2U1MDAwMAplcnJjb2w6ICM5OTAwMDAK",
-	"themes/mono-green":                     "Ymdjb2w6ICMwMjJCMDAKZmdjb2w6ICMwQkZGMDAKaGljb2w6ICMwQkZGMDAKaGljb2wyOiAjMDhiZjAwCmhpY29sMzogIzBCRkYwMAplcnJjb2w6ICMwQkZGMDAK",
-	"themes/ir-black":                       "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNlZWVlZWUKaGljb2w6ICNlZWVlZWUKaGljb2wyOiAjYjI3ZjdiCmhpY29sMzogI2ZmYjZiMAplcnJjb2w6ICNmZjZjNjAK",
-	"themes/ic-orange-ppl":                  "Ymdjb2w6ICMyNjI2MjYKZmdjb2w6ICNmZmNiODMKaGljb2w6ICNmZmM4OGEKaGljb2wyOiAjYmQ2ZDU0CmhpY29sMzogI2ZmOGM2OAplcnJjb2w6ICNjMTM5MDAK",
-	"themes/one-dark":                       "Ymdjb2w6ICMxRTIxMjcKZmdjb2w6ICM1QzYzNzAKaGljb2w6ICNBQkIyQkYKaGljb2wyOiAjYTU1NTVkCmhpY29sMzogI0UwNkM3NQplcnJjb2w6ICNFMDZDNzUK",
-	"themes/vag":                            "Ymdjb2w6ICMxOTFmMWQKZmdjb2w6ICNkOWU2ZjIKaGljb2w6ICM4YThhOGEKaGljb2wyOiAjODI1YjMyCmhpY29sMzogI2IwNzYzYgplcnJjb2w6ICNhODcxMzkK",
-	"themes/mono-amber":                     "Ymdjb2w6ICMyQjE5MDAKZmdjb2w6ICNGRjk0MDAKaGljb2w6ICNGRjk0MDAKaGljb2wyOiAjYmY2ZjAwCmhpY29sMzogI0ZGOTQwMAplcnJjb2w6ICNGRjk0MDAK",
-	"themes/espresso-libre":                 "Ymdjb2w6ICMyYTIxMWMKZmdjb2w6ICNiOGE4OTgKaGljb2w6ICNkM2Q3Y2YKaGljb2wyOiAjYjMyNjI1CmhpY29sMzogI2VmMjkyOQplcnJjb2w6ICNjYzAwMDAK",
-	"themes/monokai-soda":                   "Ymdjb2w6ICMxYTFhMWEKZmdjb2w6ICNjNGM1YjUKaGljb2w6ICNjNGM1YjUKaGljb2wyOiAjYjIwNzRhCmhpY29sMzogI2Y0MDA1ZgplcnJjb2w6ICNmNDAwNWYK",
-	"themes/lavandula":                      "Ymdjb2w6ICMwNTAwMTQKZmdjb2w6ICM3MzZlN2QKaGljb2w6ICM3MzZlN2QKaGljb2wyOiAjOWUzODRlCmhpY29sMzogI2UwNTE2NwplcnJjb2w6ICM3ZDE2MjUK",
-	"themes/toy-chest":                      "Ymdjb2w6ICMyNDM2NGIKZmdjb2w6ICMzMWQwN2IKaGljb2w6ICMyM2QxODMKaGljb2wyOiAjYTU0ZTQ2CmhpY29sMzogI2RkNTk0NAplcnJjb2w6ICNiZTJkMjYK",
-	"themes/seafoam-pastel":                 "Ymdjb2w6ICMyNDM0MzUKZmdjb2w6ICNkNGU3ZDQKaGljb2w6ICNlMGUwZTAKaGljb2wyOiAjOWI3NjY1CmhpY29sMzogI2NmOTM3YQplcnJjb2w6ICM4MjVkNGQK",
-	"themes/neutron":                        "Ymdjb2w6ICMxYzFlMjIKZmdjb2w6ICNlNmU4ZWYKaGljb2w6ICNlNmU4ZWYKaGljb2wyOiAjODczNTMwCmhpY29sMzogI2I1NDAzNgplcnJjb2w6ICNiNTQwMzYK",
-	"themes/hybrid":                         "Ymdjb2w6ICMxNDE0MTQKZmdjb2w6ICM5NGEzYTUKaGljb2w6ICM5Njk4OTYKaGljb2wyOiAjOTQ0ZDRkCmhpY29sMzogI2NjNjY2NgplcnJjb2w6ICNBNTQyNDIK",
-	"themes/elio":                           "Ymdjb2w6ICMwNDFBM0IKZmdjb2w6ICNmMmYyZjIKaGljb2w6ICNmMmYyZjIKaGljb2wyOiAjYjMyZDI2CmhpY29sMzogI2ZmMzYxZQplcnJjb2w6ICNlMTMyMWEK",
-	"themes/flat":                           "Ymdjb2w6ICMxRjJEM0EKZmdjb2w6ICMxYWJjOWMKaGljb2w6ICNiZGMzYzcKaGljb2wyOiAjYWI0MjNiCmhpY29sMzogI2U3NGMzYwplcnJjb2w6ICNjMDM5MmIK",
-	"themes/default":                        "Zmdjb2w6ICM4QzhDOEMKYmdjb2w6ICMyODI4MjgKaGljb2wyOiAjYjQ4MDFiCmhpY29sMzogI2U4YTUyMgpoaWNvbDogICNmZmZmZmYKZXJyY29sOiAjYTEwNzA1Cg==",
-	"themes/zenburn":                        "Ymdjb2w6ICMzZjNmM2YKZmdjb2w6ICNkY2RjY2MKaGljb2w6ICNkY2RjY2MKaGljb2wyOiAjYWM4NTg1CmhpY29sMzogI2RjYTNhMwplcnJjb2w6ICM3MDUwNTAK",
-	"themes/flatland":                       "Ymdjb2w6ICMxZDFmMjEKZmdjb2w6ICNiOGRiZWYKaGljb2w6ICNmZmZmZmYKaGljb2wyOiAjOWIyNjIzCmhpY29sMzogI2QyMmEyNAplcnJjb2w6ICNmMTgzMzkK",
-	"themes/vaughn":                         "Ymdjb2w6ICMyNTIzNGYKZmdjb2w6ICNkY2RjY2MKaGljb2w6ICM3MDkwODAKaGljb2wyOiAjYTU3Yzg5CmhpY29sMzogI2RjYTNhMwplcnJjb2w6ICM3MDUwNTAK",
-	"themes/gruvbox-dark":                   "Ymdjb2w6ICMyODI4MjgKZmdjb2w6ICNlYmRiYjIKaGljb2w6ICNhODk5ODQKaGljb2wyOiAjYmIzZjMwCmhpY29sMzogI2ZiNDkzNAplcnJjb2w6ICNjYzI0MWQK",
-	"themes/arthur":                         "Ymdjb2w6ICMxYzFjMWMKZmdjb2w6ICNkZGVlZGQKaGljb2w6ICNiYmFhOTkKaGljb2wyOiAjOTc0MzJjCmhpY29sMzogI2NjNTUzMwplcnJjb2w6ICNjZDVjNWMK",
-	"themes/ic-green-ppl":                   "Ymdjb2w6ICMzYTNkM2YKZmdjb2w6ICNkOWVmZDMKaGljb2w6ICNlMGZmZWYKaGljb2wyOiAjODZjNDNlCmhpY29sMzogI2E3ZmYzZgplcnJjb2w6ICNmYjAwMmEK",
-	"themes/misterioso":                     "Ymdjb2w6ICMyZDM3NDMKZmdjb2w6ICNlMWUxZTAKaGljb2w6ICNlMWUxZTAKaGljb2wyOiAjYzAzMzQyCmhpY29sMzogI2ZmMzI0MgplcnJjb2w6ICNmZjQyNDIK",
-	"themes/brogrammer":                     "Ymdjb2w6ICMxMzEzMTMKZmdjb2w6ICNkNmRiZTUKaGljb2w6ICNkNmRiZTUKaGljb2wyOiAjYTEyYTI1CmhpY29sMzogI2RlMzUyZQplcnJjb2w6ICNmODExMTgK",
-	"themes/spacegray-eighties-dull":        "Ymdjb2w6ICMyMjIyMjIKZmdjb2w6ICNjOWM2YmMKaGljb2w6ICNiM2I4YzMKaGljb2wyOiAjYWY0YzUyCmhpY29sMzogI2VjNWY2NwplcnJjb2w6ICNiMjRhNTYK",
-	"themes/seti":                           "Ymdjb2w6ICMxMTEyMTMKZmdjb2w6ICNjYWNlY2QKaGljb2w6ICNlZWVlZWUKaGljb2wyOiAjOGMyMTI4CmhpY29sMzogI2MyMjgzMgplcnJjb2w6ICNjMjI4MzIK",
-	"themes/foxnightly":                     "Ymdjb2w6ICMyQTJBMkUKZmdjb2w6ICNEN0Q3REIKaGljb2w6ICNGRkZGRkYKaGljb2wyOiAjOTIzOTNhCmhpY29sMzogI0JGNDA0MAplcnJjb2w6ICNCOThFRkYK",
-	"themes/miu":                            "Ymdjb2w6ICMwZDE5MjYKZmdjb2w6ICNkOWU2ZjIKaGljb2w6ICNkOWQ5ZDkKaGljb2wyOiAjOWQ4YjhmCmhpY29sMzogI2RiYmRiZAplcnJjb2w6ICNiODdhN2EK",
-	"themes/ura":                            "Ymdjb2w6ICNmZWZmZWUKZmdjb2w6ICMyMzQ3NmEKaGljb2w6ICM4MDgwODAKaGljb2wyOiAjZjJhOGM4CmhpY29sMzogI2VlODRiOQplcnJjb2w6ICNjMjFiNmYK",
-	"themes/solarized-darcula":              "Ymdjb2w6ICMzZDNmNDEKZmdjb2w6ICNkMmQ4ZDkKaGljb2w6ICNkMmQ4ZDkKaGljb2wyOiAjYmI0NTQwCmhpY29sMzogI2YyNDg0MAplcnJjb2w6ICNmMjQ4NDAK",
-	"themes/later-this-evening":             "Ymdjb2w6ICMyMjIyMjIKZmdjb2w6ICM5NTk1OTUKaGljb2w6ICMzYzNkM2QKaGljb2wyOiAjOWQyMjJiCmhpY29sMzogI2QzMjMyZgplcnJjb2w6ICNkNDVhNjAK",
-	"themes/terminix-dark":                  "Ymdjb2w6ICMwOTExMTYKZmdjb2w6ICM4NjhBOEMKaGljb2w6ICM3Nzc3NzcKaGljb2wyOiAjOGQyYTJiCmhpY29sMzogI2M2MzUzNQplcnJjb2w6ICNhNTQyNDIK",
-	"themes/kibble":                         "Ymdjb2w6ICMwZTEwMGEKZmdjb2w6ICNmN2Y3ZjcKaGljb2w6ICNlMmQxZTMKaGljb2wyOiAjYWMxMzU3CmhpY29sMzogI2YwMTU3OAplcnJjb2w6ICNjNzAwMzEK",
-	"themes/liquid-carbon-transparent":      "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNhZmMyYzIKaGljb2w6ICNiY2NjY2MKaGljb2wyOiAjYjIyMTIxCmhpY29sMzogI2ZmMzAzMAplcnJjb2w6ICNmZjMwMzAK",
-	"themes/monokai-dark":                   "Ymdjb2w6ICMyNzI4MjIKZmdjb2w6ICNmOGY4ZjIKaGljb2w6ICNmOWY4ZjUKaGljb2wyOiAjYjkyNjVhCmhpY29sMzogI2Y5MjY3MgplcnJjb2w6ICNmOTI2NzIK",
-	"themes/grape":                          "Ymdjb2w6ICMxNzE0MjMKZmdjb2w6ICM5ZjlmYTEKaGljb2w6ICM5ZTllYTAKaGljb2wyOiAjYWU1NTc2CmhpY29sMzogI2YwNzI5YQplcnJjb2w6ICNlZDIyNjEK",
-	"themes/clrs":                           "Ymdjb2w6ICNmZmZmZmYKZmdjb2w6ICMyNjI2MjYKaGljb2w6ICNiM2IzYjMKaGljb2wyOiAjZmM0ZjViCmhpY29sMzogI2ZiMDQxNgplcnJjb2w6ICNmODI4MmEK",
-	"themes/liquid-carbon":                  "Ymdjb2w6ICMzMDMwMzAKZmdjb2w6ICNhZmMyYzIKaGljb2w6ICNiY2NjY2MKaGljb2wyOiAjYzAyZjJmCmhpY29sMzogI2ZmMzAzMAplcnJjb2w6ICNmZjMwMzAK",
-	"themes/neopolitan":                     "Ymdjb2w6ICMyNzFmMTkKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNmOGY4ZjgKaGljb2wyOiAjNjUwOTA3CmhpY29sMzogIzgwMDAwMAplcnJjb2w6ICM4MDAwMDAK",
-	"themes/pencil-dark":                    "Ymdjb2w6ICMyMTIxMjEKZmdjb2w6ICNmMWYxZjEKaGljb2w6ICNkOWQ5ZDkKaGljb2wyOiAjYjkwOTVmCmhpY29sMzogI2ZiMDA3YQplcnJjb2w6ICNjMzA3NzEK",
-	"themes/ibm3270":                        "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNGREZERkQKaGljb2w6ICNBNUE1QTUKaGljb2wyOiAjYTc1YjViCmhpY29sMzogI0VGODM4MwplcnJjb2w6ICNGMDE4MTgK",
-	"themes/teerb":                          "Ymdjb2w6ICMyNjI2MjYKZmdjb2w6ICNkMGQwZDAKaGljb2w6ICNkMGQwZDAKaGljb2wyOiAjYTE2OTY5CmhpY29sMzogI2Q2ODY4NgplcnJjb2w6ICNkNjg2ODYK",
-	"themes/wombat":                         "Ymdjb2w6ICMxNzE3MTcKZmdjb2w6ICNkZWRhY2YKaGljb2w6ICNkZWRhY2YKaGljb2wyOiAjYjI2ODYwCmhpY29sMzogI2Y1OGM4MAplcnJjb2w6ICNmZjYxNWEK",
-	"themes/red-alert":                      "Ymdjb2w6ICM3NjI0MjMKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNkNmQ2ZDYKaGljb2wyOiAjYzAyNDQ0CmhpY29sMzogI2UwMjU1MwplcnJjb2w6ICNkNjJlNGUK",
-	"themes/dimmed-monokai":                 "Ymdjb2w6ICMxZjFmMWYKZmdjb2w6ICNiOWJjYmEKaGljb2w6ICNiOWJjYmEKaGljb2wyOiAjYjkwOTFmCmhpY29sMzogI2ZiMDAxZgplcnJjb2w6ICNiZTNmNDgK",
-	"themes/elic":                           "Ymdjb2w6ICM0QTQ1M0UKZmdjb2w6ICNmMmYyZjIKaGljb2w6ICMyYWE3ZTcKaGljb2wyOiAjYzgzYTI3CmhpY29sMzogI2ZmMzYxZQplcnJjb2w6ICNlMTMyMWEK",
-	"themes/ocean-dark":                     "Ymdjb2w6ICMxQzFGMjcKZmdjb2w6ICM5NzlDQUMKaGljb2w6ICNFRUVERUUKaGljb2wyOiAjODIzZDQ4CmhpY29sMzogI0FGNEI1NwplcnJjb2w6ICNBRjRCNTcK",
-	"themes/thayer-bright":                  "Ymdjb2w6ICMxYjFkMWUKZmdjb2w6ICNmOGY4ZjgKaGljb2w6ICNjY2NjYzYKaGljb2wyOiAjYmE0NzcxCmhpY29sMzogI2ZmNTk5NQplcnJjb2w6ICNmOTI2NzIK",
-	"themes/twilight":                       "Ymdjb2w6ICMxNDE0MTQKZmdjb2w6ICNmZmZmZDQKaGljb2w6ICNmZmZmZDQKaGljb2wyOiAjYTE1YzNiCmhpY29sMzogI2RlN2M0YwplcnJjb2w6ICNjMDZkNDQK",
-	"themes/_base":                          "Ymdjb2w6ICMyNjAzNDYKZmdjb2w6ICNEQURBREEKaGljb2w6ICNDOUNDQ0QKaGljb2wyOiAjYWQzNjNmCmhpY29sMzogI0U3NEMzQwplcnJjb2w6ICNDNTQxMzMK",
-	"themes/chalk":                          "Ymdjb2w6ICMyRDJEMkQKZmdjb2w6ICNENEQ0RDQKaGljb2w6ICNENEQ0RDQKaGljb2wyOiAjYjk3MDcwCmhpY29sMzogI0Y1OEU4RQplcnJjb2w6ICNGNThFOEUK",
-	"themes/cobalt2":                        "Ymdjb2w6ICMxMzI3MzgKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYjAxNTIwCmhpY29sMzogI2Y0MGUxNwplcnJjb2w6ICNmZjAwMDAK",
-	"themes/maia":                           "Ymdjb2w6ICMzMTM2M0IKZmdjb2w6ICNCRFgzQzcKaGljb2w6ICNFMEUwRTAKaGljb2wyOiAjOWQzNjMwCmhpY29sMzogI0NDMzcyQwplcnJjb2w6ICNCQTI5MjIK",
-	"themes/mono-yellow":                    "Ymdjb2w6ICMyQjI0MDAKZmdjb2w6ICNGRkQzMDAKaGljb2w6ICNGRkQzMDAKaGljb2wyOiAjYmY5ZTAwCmhpY29sMzogI0ZGRDMwMAplcnJjb2w6ICNGRkQzMDAK",
-	"themes/wild-cherry":                    "Ymdjb2w6ICMxZjE3MjYKZmdjb2w6ICNkYWZhZmYKaGljb2w6ICNmZmY4ZGUKaGljb2wyOiAjYTE1MTgzCmhpY29sMzogI2RhNmJhYwplcnJjb2w6ICNkOTQwODUK",
-	"themes/afterglow":                      "Ymdjb2w6ICMyMjIyMjIKZmdjb2w6ICNkMGQwZDAKaGljb2w6ICNkMGQwZDAKaGljb2wyOiAjN2QzNDIyCmhpY29sMzogI2E1M2MyMwplcnJjb2w6ICNhNTNjMjMK",
-	"themes/tomorrow-night":                 "Ymdjb2w6ICMxRDFGMjEKZmdjb2w6ICNDNUM4QzYKaGljb2w6ICNGRkZFRkUKaGljb2wyOiAjOTc1MDUxCmhpY29sMzogI0NDNjY2NgplcnJjb2w6ICNDQzY2NjYK",
-	"themes/pali":                           "Ymdjb2w6ICMyMzJFMzcKZmdjb2w6ICNkOWU2ZjIKaGljb2w6ICNGMkYyRjIKaGljb2wyOiAjYmQyMjU1CmhpY29sMzogI0ZGMUQ2MgplcnJjb2w6ICNhYjhmNzQK",
-	"themes/elemental":                      "Ymdjb2w6ICMyMjIxMWQKZmdjb2w6ICM4MDdhNzQKaGljb2w6ICM4MDc5NzQKaGljb2wyOiAjYTY0MTI2CmhpY29sMzogI2UwNTAyYQplcnJjb2w6ICM5ODI5MGYK",
-	"themes/mona-lisa":                      "Ymdjb2w6ICMxMjBiMGQKZmdjb2w6ICNmN2Q2NmEKaGljb2w6ICNmN2Q3NWMKaGljb2wyOiAjYjczMjI2CmhpY29sMzogI2ZmNDMzMQplcnJjb2w6ICM5YjI5MWMK",
-	"themes/neon-night":                     "Ymdjb2w6ICMyMDI0MmQKZmdjb2w6ICNDN0M4RkYKaGljb2w6ICNDOUNDQ0QKaGljb2wyOiAjYmM2ZTcwCmhpY29sMzogI0ZGOEU4RQplcnJjb2w6ICNGRjhFOEUK",
-	"themes/shel":                           "Ymdjb2w6ICMyYTIwMWYKZmdjb2w6ICM0ODgyY2QKaGljb2w6ICM5MTg5ODgKaGljb2wyOiAjYjg2ODhhCmhpY29sMzogI2Y1ODhiOQplcnJjb2w6ICNhYjI0NjMK",
-	"themes/bim":                            "Ymdjb2w6ICMwMTI4NDkKZmdjb2w6ICNhOWJlZDgKaGljb2w6ICM5MTg5ODgKaGljb2wyOiAjYWI2MDkyCmhpY29sMzogI2Y1NzliMgplcnJjb2w6ICNmNTU3YTAK",
-	"themes/google-light":                   "Ymdjb2w6ICNGRkZGRkYKZmdjb2w6ICMzNzNCNDEKaGljb2w6ICMzNzNCNDEKaGljb2wyOiAjZGI3MDZhCmhpY29sMzogI0NDMzQyQgplcnJjb2w6ICNDQzM0MkIK",
-	"themes/smyck":                          "Ymdjb2w6ICMyNDI0MjQKZmdjb2w6ICNGN0Y3RjcKaGljb2w6ICNCMEIwQjAKaGljb2wyOiAjYTc3MzZmCmhpY29sMzogI0UwOTY5MAplcnJjb2w6ICNDNzU2NDYK",
-	"themes/spacegray-eighties":             "Ymdjb2w6ICMyMjIyMjIKZmdjb2w6ICNiZGJhYWUKaGljb2w6ICNlZmVjZTcKaGljb2wyOiAjYmM1MzVhCmhpY29sMzogI2ZmNjk3MwplcnJjb2w6ICNlYzVmNjcK",
-	"themes/red-sands":                      "Ymdjb2w6ICM3YTI1MWUKZmdjb2w6ICNkN2M5YTcKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYTcwYjA5CmhpY29sMzogI2JiMDAwMAplcnJjb2w6ICNmZjNmMDAK",
-	"themes/freya":                          "Ymdjb2w6ICMyNTJlMzIKZmdjb2w6ICM5NGEzYTUKaGljb2w6ICM5NGEzYTUKaGljb2wyOiAjOTk0MjFlCmhpY29sMzogI2NiNGIxNgplcnJjb2w6ICNkYzMyMmYK",
-	"themes/solarized-dark":                 "Ymdjb2w6ICMwMDJCMzYKZmdjb2w6ICM4Mzk0OTYKaGljb2w6ICNFRUU4RDUKaGljb2wyOiAjOTc2MTY0CmhpY29sMzogI0Q4Nzk3OQplcnJjb2w6ICNEQzMyMkYK",
-	"themes/spacedust":                      "Ymdjb2w6ICMwYTFlMjQKZmdjb2w6ICNlY2YwYzEKaGljb2w6ICNmMGYxY2UKaGljb2wyOiAjYjU2OTMzCmhpY29sMzogI2ZmOGEzYQplcnJjb2w6ICNlMzViMDAK",
-	"themes/blazer":                         "Ymdjb2w6ICMwZDE5MjYKZmdjb2w6ICNkOWU2ZjIKaGljb2w6ICNkOWQ5ZDkKaGljb2wyOiAjOWQ4YjhmCmhpY29sMzogI2RiYmRiZAplcnJjb2w6ICNiODdhN2EK",
-	"themes/argonaut":                       "Ymdjb2w6ICMwZTEwMTkKZmdjb2w6ICNmZmZhZjQKaGljb2w6ICNmZmZmZmYKaGljb2wyOiAjYjYyMDM0CmhpY29sMzogI2ZmMjc0MAplcnJjb2w6ICNmZjAwMGYK",
-	"themes/hardcore":                       "Ymdjb2w6ICMxMjEyMTIKZmdjb2w6ICNhMGEwYTAKaGljb2w6ICNjY2NjYzYKaGljb2wyOiAjYjc0YzczCmhpY29sMzogI2ZmNjY5ZAplcnJjb2w6ICNmOTI2NzIK",
-	"themes/nightlion-v2":                   "Ymdjb2w6ICMxNzE3MTcKZmdjb2w6ICNiYmJiYmIKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYjk0MjQyCmhpY29sMzogI2ZmNTU1NQplcnJjb2w6ICNiYjAwMDAK",
-	"themes/nightlion-v1":                   "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNiYmJiYmIKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYjIzYjNiCmhpY29sMzogI2ZmNTU1NQplcnJjb2w6ICNiYjAwMDAK",
-	"themes/aco":                            "Ymdjb2w6ICMxZjEzMDUKZmdjb2w6ICNiNGUxZmQKaGljb2w6ICNiZWJlYmUKaGljb2wyOiAjYmIxYTY0CmhpY29sMzogI2ZmMWU4ZQplcnJjb2w6ICNmZjA4ODMK",
-	"themes/dark-pastel":                    "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNiYmJiYmIKaGljb2wyOiAjYjIzYjNiCmhpY29sMzogI2ZmNTU1NQplcnJjb2w6ICNmZjU1NTUK",
-	"themes/idle-toes":                      "Ymdjb2w6ICMzMjMyMzIKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNlZWVlZWMKaGljb2wyOiAjYjc1ZDVkCmhpY29sMzogI2YwNzA3MAplcnJjb2w6ICNkMjUyNTIK",
-	"themes/wez":                            "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNiM2IzYjMKaGljb2w6ICNjY2NjY2MKaGljb2wyOiAjYjIzYjNiCmhpY29sMzogI2ZmNTU1NQplcnJjb2w6ICNjYzU1NTUK",
-	"themes/nord":                           "Ymdjb2w6ICMyRTM0NDAKZmdjb2w6ICNEOERFRTkKaGljb2w6ICNFNUU5RjAKaGljb2wyOiAjOTM1MzVkCmhpY29sMzogI0JGNjE2QQplcnJjb2w6ICNCRjYxNkEK",
-	"themes/adventuretime":                  "Ymdjb2w6ICMxZjFkNDUKZmdjb2w6ICNmOGRjYzAKaGljb2w6ICNmOGRjYzAKaGljb2wyOiAjYjk0YjUzCmhpY29sMzogI2ZjNWY1YQplcnJjb2w6ICNiZDAwMTMK",
-	"themes/tin":                            "Ymdjb2w6ICMyZTJlMzUKZmdjb2w6ICNmZmZmZmYKaGljb2w6ICNmZmZmZmYKaGljb2wyOiAjOGM2NTYzCmhpY29sMzogI2I1N2Q3OAplcnJjb2w6ICM4ZDUzNGUK",
-	"themes/nep":                            "Ymdjb2w6ICM3NTg0ODAKZmdjb2w6ICMyMzQ3NmEKaGljb2w6ICNmMmYyZjIKaGljb2wyOiAjZDVhOTc3CmhpY29sMzogI2ZmYjk3NAplcnJjb2w6ICNkZDZmMDAK",
-	"themes/spring":                         "Ymdjb2w6ICMwYTFlMjQKZmdjb2w6ICNlY2YwYzEKaGljb2w6ICNmZmZmZmYKaGljb2wyOiAjYjUwOTIxCmhpY29sMzogI2ZmMDAyMQplcnJjb2w6ICNmZjRkODMK",
-	"themes/jackie-brown":                   "Ymdjb2w6ICMyYzFkMTYKZmdjb2w6ICNmZmNjMmYKaGljb2w6ICNiZmJmYmYKaGljb2wyOiAjYWQwODA2CmhpY29sMzogI2U1MDAwMAplcnJjb2w6ICNlZjU3MzQK",
-	"themes/google-dark":                    "Ymdjb2w6ICMxRDFGMjEKZmdjb2w6ICNCNEI3QjQKaGljb2w6ICNDNUM4QzYKaGljb2wyOiAjOTcyZDI4CmhpY29sMzogI0NDMzQyQgplcnJjb2w6ICNDQzM0MkIK",
-	"themes/nighty":                         "Ymdjb2w6ICMyRjJGMkYKZmdjb2w6ICNERkRGREYKaGljb2w6ICM4MjgyODIKaGljb2wyOiAjOWY0OTUwCmhpY29sMzogI0QwNTU1RgplcnJjb2w6ICM5QjNFNDYK",
-	"themes/peppermint":                     "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICNDN0M3QzcKaGljb2w6ICNCM0IzQjMKaGljb2wyOiAjOWY1ZDZiCmhpY29sMzogI0U0ODU5QQplcnJjb2w6ICNFNjQ1NjkK",
-	"themes/homebrew":                       "Ymdjb2w6ICMwMDAwMDAKZmdjb2w6ICMwMGZmMDAKaGljb2w6ICNiZmJmYmYKaGljb2wyOiAjYTAwMDAwCmhpY29sMzogI2U1MDAwMAplcnJjb2w6ICM5OTAwMDAK",
-	"themes/harper":                         "Ymdjb2w6ICMwMTAxMDEKZmdjb2w6ICNhOGE0OWQKaGljb2w6ICNhOGE0OWQKaGljb2wyOiAjYWQ3ZjJjCmhpY29sMzogI2Y4YjYzZgplcnJjb2w6ICNmOGI2M2YK",
-	"themes/papercolor-light":               "Ymdjb2w6ICNFRUVFRUUKZmdjb2w6ICM0NDQ0NDQKaGljb2w6ICM0NDQ0NDQKaGljb2wyOiAjZGQ0NzQ3CmhpY29sMzogI0Q3MDAwMAplcnJjb2w6ICNBRjAwMDAK",
-	"words/fr":                              "bm91cwptYWlzCnBvdXZvaXIKYXZlYwp0b3V0CmFsbGVyCnZvaXIKZW4KYmllbgpvw7kKc2Fucwpob21tZQp0dQpvdQpsZXVyCnNpCmRldXgKbWFyaQptb2kKdm91bG9pcgpmZW1tZQpncmFuZApxdWFuZAp2ZW5pcgpjZWx1aQpub3RyZQpkZXZvaXIKbMOgCmpvdXIKcHJlbmRyZQptw6ptZQp2b3RyZQpyaWVuCnBldGl0CmVuY29yZQphdXNzaQpxdWVscXVlCmRvbnQKbWVyCnRyb3V2ZXIKZG9ubmVyCnRlbXBzCsOnYQpwZXUKbcOqbWUKZmFsbG9pcgpzb3VzCnBhcmxlcgphbG9ycwptYWluCmNob3NlCnRvbgptZXR0cmUKdmllCnNhdm9pcgp5ZXV4CnBhc3Nlcgptb3J0CnJldmVuaXIKbm9pcgptYWludGVuYW50Cm5vdXZlYXUKdmlsbGUKcnVlCmVuZmluCmFwcGVsZXIKc29pcgpjaGFtYnJlCm1vdXJpcgpwYXMKcGFydGlyCmNpbnEKZXNwcml0CnNvbGVpbApkZXJuaWVyCmpldGVyCmRpeApyb2kKw6l0YXQKY29ycHMKYmVhdWNvdXAKc3VpdnJlCmJyYXMKw6ljcmlyZQpibGFuYwptb250cmVyCnRvbWJlcgpwbGFjZQpvdXZyaXIKYWgKcGFydGkKYXNzZXoKbGV1cgpjaGVyCnZvaWzDoAphbm7DqWUKbG9pbgpwb2ludAp2aXNhZ2UKYnJ1aXQKbGV0dHJlCmZyYW5jCmZvbmQKZm9yY2UKYXJyw6p0ZXIKcGVyZHJlCmF1dHJlCmFwcsOocwpyZWdhcmRlcgp0b3Vqb3VycwpqYW1haXMKY2VsYQphaW1lcgpub24KaGV1cmUKY3JvaXJlCmNlbnQKbW9uZGUKZG9uYwplbmZhbnQKZm9pcwpzZXVsCmF1dHJlCmVudHJlCnZlcnMKY2hlegpkZW1hbmRlcgpqZXVuZQpqdXNxdWUKdHLDqHMKbW9tZW50CnJlc3Rlcgpyw6lwb25kcmUKdMOqdGUKcMOocmUKZmlsbGUKbWlsbGUKcHJlbWllcgpjYXIKZW50ZW5kcmUKbmkKYm9uCnRyb2lzCmFpbnNpCmFuCnF1YXRyZQp1bgp0ZXJyZQpjb250cmUKZGlldQptb25zaWV1cgp2b2l4CnBlbnNlcgpxdWVsCmFycml2ZXIKbWFpc29uCmRldmFudApjb3VwCmJlYXUKY29ubmHDrnRyZQpkZXZlbmlyCmFpcgptb3QKbnVpdApzZW50aXIKZWF1CnZpZXV4CnNlbWJsZXIKbW9pbnMKdGVuaXIKaWNpCmNvbXByZW5kcmUKb3VpCnJlbmRyZQp0b2kKdmluZ3QKZGVwdWlzCmF0dGVuZHJlCnNvcnRpcgphbWkKdHJvcApwb3J0ZQpsZXF1ZWwKY2hhcXVlCmFtb3VyCnBlbmRhbnQKZMOpasOgCnBpZWQKdGFudApnZW5zCm5vbQp2aXZyZQpyZXByZW5kcmUKZW50cmVyCnBvcnRlcgpwYXlzCmNpZWwKYXZhbnQKcmVnYXJkCmV1eA==",
-	"words/de":                              "ZGllCmRlcgp1bmQKaW4KenUKZGVuCmRhcwpuaWNodAp2b24Kc2llCmlzdApkZXMKc2ljaAptaXQKZGVtCmRhc3MKZXIKZXMKZWluCmljaAphdWYKc28KZWluZQphdWNoCmFscwphbgpuYWNoCndpZQppbQpmw7xyCm1hbgphYmVyCmF1cwpkdXJjaAp3ZW5uCm51cgp3YXIKbm9jaAp3ZXJkZW4KYmVpCmhhdAp3aXIKd2FzCndpcmQKc2VpbgplaW5lbgp3ZWxjaGUKc2luZApvZGVyCnp1cgp1bQpoYWJlbgplaW5lcgptaXIKw7xiZXIKaWhtCmRpZXNlCmVpbmVtCmlocgp1bnMKZGEKenVtCmthbm4KZG9jaAp2b3IKZGllc2VyCm1pY2gKaWhuCmR1CmhhdHRlCnNlaW5lCm1laHIKYW0KZGVubgpudW4KdW50ZXIKc2VocgpzZWxic3QKc2Nob24KaGllcgpiaXMKaGFiZQppaHJlCmRhbm4KaWhuZW4Kc2VpbmVyCmFsbGUKd2llZGVyCm1laW5lClplaXQKZ2VnZW4Kdm9tCmdhbnoKZWluemVsbmVuCndvCm11c3MKb2huZQplaW5lcwprw7ZubmVuCnNlaQpqYQp3dXJkZQpqZXR6dAppbW1lcgpzZWluZW4Kd29obApkaWVzZXMKaWhyZW4Kd8O8cmRlCmRpZXNlbgpzb25kZXJuCndlaWwKd2VsY2hlcgpuaWNodHMKZGllc2VtCmFsbGVzCndhcmVuCndpbGwKSGVycgp2aWVsCm1laW4KYWxzbwpzb2xsCndvcmRlbgpsYXNzZW4KZGllcwptYWNoZW4KaWhyZXIKd2VpdGVyCkxlYmVuCnJlY2h0CmV0d2FzCmtlaW5lCnNlaW5lbQpvYgpkaXIKYWxsZW4KZ3Jvw59lbgpKYWhyZQpXZWlzZQptw7xzc2VuCndlbGNoZXMKd8OkcmUKZXJzdAplaW5tYWwKTWFubgpow6R0dGUKendlaQpkaWNoCmFsbGVpbgpIZXJyZW4Kd8OkaHJlbmQKUGFyYWdyYXBoCmFuZGVycwpMaWViZQprZWluCmRhbWl0CmdhcgpIYW5kCkhlcnJuCmV1Y2gKc29sbHRlCmtvbm50ZQplcnN0ZW4KZGVyZW4Kendpc2NoZW4Kd29sbGVuCmRlbmVuCmRlc3NlbgpzYWdlbgpiaW4KTWVuc2NoZW4KZ3V0CmRhcmF1Zgp3dXJkZW4Kd2Vpw58KZ2V3ZXNlbgpTZWl0ZQpiYWxkCndlaXQKZ3Jvw59lCnNvbGNoZQpoYXR0ZW4KZWJlbgphbmRlcm4KYmVpZGVuCm1hY2h0CnNlaGVuCmdhbnplCmFuZGVyZW4KbGFuZ2UKd2VyCmlocmVtCnp3YXIKZ2VtYWNodApkb3J0CmtvbW1lbgpXZWx0CmhldXRlCkZyYXUKd2VyZGUKZGVyc2VsYmVuCmdhbnplbgpkZXV0c2NoZW4KbMOkc3N0CnZpZWxsZWljaHQKbWVpbmVy",
-	"words/pl":                              "c2nEmQppCncKbmllCm5hCnoKZG8KdG8KxbxlCmEKbwpqYWsKYWxlCnBvCmNvCnRhawp6YQpvZApnbwpqdcW8CmplZ28KamVqCmN6eQpwcnplegp0eWxrbwp0ZWdvCnNvYmllCmplc3pjemUKbW/FvGUKemUKa2llZHkKcGFuCmljaApkbGEKYnkKZ2R5CnRlcmF6CmphCnRlbgprdMOzcnkKbmF3ZXQKYmFyZHpvCnByemVkCnR1CmplZG5hawpwb2QKY2/Fmwp0YW0Kd3N6eXN0a28KcHJ6eQp3acSZYwpuaWMKYm8KbmltCsW8ZWJ5Cm1pYcWCCm9uCmJ5xIcKcG90ZW0KdGXFvApqZcWbbGkKYmV6Cm5hZApnZHppZQpsZWN6CnNpZWJpZQpuaWdkeQphbmkKd8WCYcWbbmllCnNhbQp1CmRvYnJ6ZQpuacW8Cmpha2J5CmFieQp0eQpvY3p5Cnphd3N6ZQpyYXoKYnnFgnkKbm8KYWxibwpnZHlieQphxbwKd3RlZHkKcHJ6ZWNpZcW8Cm9uYQpkcnp3aQpqYWtvCmNoeWJhCm5hZ2xlCndzenlzY3kKamVkZW4KY3p5bQprdG8Kc3Bvc8OzYgpjemFzCmtpbGthCmRsYWN6ZWdvCnJhemVtCnRha8W8ZQptw7NqCm5pa3QKY2hvxIcKd2llbGUKZHdhCmt0b8WbCmx1Ygp0cnplYmEKbmllY2gKa3UKdHdhcnoKa3TDs3JlZ28Kd2UKem5vd3UKY3rFgm93aWVrCmpha2nFmwp0dXRhagpzenlia28KdHlsZQpnxYJvcwptacSZZHp5CndyZXN6Y2llCnLDs3duaWXFvArFvHljaWUKb2N6eXdpxZtjaWUKem7Ds3cKc3dvamUKZGxhdGVnbwp6Ynl0CmNpZWJpZQp6dXBlxYJuaWUKdGFraQpjemVnbwppxbwKZG9waWVybwpwb3dpZWR6aWXEhwpvYm9rCnByYXdpZQpwb3phCnphxZsKd2NpxIXFvApqZcW8ZWxpCm1vamUKcHJhd2RhCnRyenkKZHppZcWECm1pZWpzY2UKbWltbwpwb25pZXdhxbwKemFyYXoKZMWCdWdvCmNvcmF6CnBvZGN6YXMKbmF0eWNobWlhc3QKemFuaW0KY8OzxbwKa2HFvGR5Cnpyb2JpxIcKb2pjaWVjCm15CmRvxZvEhwpvcmF6Cmpha2kKc3RhbmllCndjYWxlCnfFm3LDs2QKbWllxIcKenJlc3p0xIUKZHppxZsKaWxlCmNob2NpYcW8CmdkecW8CmtpZWR5xZsKc3fDs2oKamVkeW5pZQpwZXdubwpuaWVjbwpuaWVtYWwKZ2R6aWXFmwpqZWRubwp3b2vDs8WCCnBvd29saQp3cmHFvGVuaWUKbWF0a2EKxZt3aWF0CmtvYmlldGEKc2vEhWQKbXnFm2wKc3RhcnkKZHXFvG8KZHJvZ2kKbmFkYWwKZHJ1Z2kKYm93aWVtCnByenluYWptbmllagpwZXduaWUKY3rEmXN0bwpyYXp5Cm3EmcW8Y3p5em5hCmRva8WCYWRuaWUKbmljemVnbwptw7N3acSHCmR6acSZa2kKcGV3aWVuCndpZGHEhwpjaWNobwp3xYJhxZtjaXdpZQpyemVjegp3b2xubwpvdG8KY2lhxYJvCmN6YXNlbQp3aWVkemllxIcKc3TEhWQKd2tyw7N0Y2UKZMOzxYIKcMOzxYIKbm9jCmNhxYJraWVtCnfDs3djemFzCmRvbQp3enJvawptb2Nubwp0cnVkbm8KZHppZXdjenluYQpkemllY2tvCnNrb3JvCndvYmVjCsWbbWllcsSHCnJ6ZWN6eXdpxZtjaWUKa29uaWVjCnR1xbwKZGFsZWtvCnBpxJnEhwpzZXJjZQpzcG9rb2puaWUKY3plZ2/Fmwp3aWVsa2kKZMWCb8WECmN6xJnFm8SHCm5hanBpZXJ3Cm1hxYJvCnRlbWF0CnfFgm9zeQp3cmF6CnVzdGEKd2lkb2sKcsOzd25pZQpwb25hZA==",
-	"words/pt":                              "Y29udHJhCnNpc3RlbWEKZm9pCsOgCmVsZXMKcmlvCmZhemVyCmVzdGF2YQpKb3PDqQptZXJjYWRvCnF1YWwKc3VsCnRlcgpzw7MKQ2FybG9zCmFub3MKZHVhcwpvdXRyYQpkaWEKcHJlc2lkZW50ZQplZGl0b3JpYQplc3BlY2lhbApubwpob2plCmFjb3Jkbwp2YWkKZGl6CmRpYXMKRmVybmFuZG8KZXN0w6NvCmVsZQphcwptaWxow7Vlcwpmb3JhbQpvdXRybwpiYW5jbwphc3NpbQpzdWEKdHJhYmFsaG8KY290aWRpYW5vCmdyYW5kZQpkaW5oZWlybwpwbGFubwpvCmUKbmFkYQpwcmXDp29zCmVsYQphb3MKc2VuZG8KZXN0YWRvCmRvCmdydXBvCnF1YW5kbwpsb2NhbApldQphbnRlcwrDoHMKZXN0ZQpwb2RlCnN1YXMKZXN0w6EKbsO6bWVybwphcGVuYXMKc2V1CnBvcnF1ZQpkdXJhbnRlCmNvbQpzZWd1bmRvCm1lbm9zCmFnb3JhCmZpbmFsCnJlcG9ydGFnZW0KYWZpcm1vdQptw6pzCnBhcmEKZW1wcmVzYQpwZWxvCmRlCmRhCm9udGVtCnPDo28KdGFtYsOpbQpwYXJ0ZQpzZW1hbmEKZG9pcwpzZW0KcHJpbWVpcmEKUGF1bG8KdW0KbWVzbW8KY2FuZGlkYXRvCmVzc2UKdGVtcG8KdmV6CkJyYXNpbApuZW0KZGlzc2UKbWFzCnR1ZG8KbWFpcwpvdXRyb3MKb3UKYXTDqQpjZXJjYQp1bWEKdmlkYQpkZXNkZQptZWxob3IKYQp0b2RvCnDDoWdpbmEKcGVzc29hcwpkb3MKcXVhdHJvCnNldXMKY2lkYWRlCm11aXRvCmNhc28KdG9kb3MKc2VqYQpqw6EKdGluaGEKY2FzYQpnb3Zlcm5vCmFpbmRhCmxlaQpzZW1wcmUKZXhlbXBsbwpwYXJ0aXIKbWFpb3IKb3MKZmF6CmNvbW8KdGVtCmVyYQpuYQpyZWFsCnByb2Nlc3NvCnBvZGVtCnNlcgpwb2zDrWNpYQp0w6ptCnByb2dyYW1hCmFubwpub3ZhCmVtcHJlc2FzCmjDoQpkZXZlCsOpCm1lCm11bmRvCnF1ZW0KcGHDrXMKZXNzYQpqb2dvCmVxdWlwZQpub3ZvCm9uZGUKYmVtCnBlbG9zCnRyw6pzCnBvcgpkZXBvaXMKcXVhbHF1ZXIKYWxndW5zCm5hY2lvbmFsCmVzcG9ydGUKc2Vyw6EKaXNzbwpmb2xoYQplbnRyZQplbQpuw6NvCmVzdGEKcXVlCm1pbAphcMOzcwpzb2JyZQpzZQpwcmltZWlybwphbwpjYWRhCnBvdWNvCmFsw6ltCmJyYXNpbGVpcmEKdm9jw6oKZm9ybWEKZGFzCnBlbGEKc2FtYmEKYW1pemFkZQpnb2xwZQpsaW1pdGFudGUKZXNxdWFkcsOjbwp4w61jYXJhCmNhZsOpCnByb2Zpc3Npb25hbAphZG9yw6F2ZWwKY2h1dmEKbGliZXJkYWRlCmluZmluaXRvCmRpdmVyc2lkYWRlCmFydGlnb3MKY294aW5oYQpww6NvCnZhemlvCmx1egppcnJlZ3VsYXIKcmVsw7NnaW8KcG9ydGEKZ2F0bwpjZXN0YQp2ZWxhCnBsYW50YXMKcGVkcmEKY8OpdQphdXRvbcOzdmVsCmJpY2ljbGV0YQpjYW5lY2EKcGVzc29hCmNvbWVyYW0KcHVsZWkKYW1hbmjDowpjb3JkYQptb3RvcmlzdGEKcXVlYnJlaQpjYW1pbmhhdmFtCnNpbXBsaWNpZGFkZQplbW/Dp8O1ZXMKb2ZlbnNpdm8KZXNxdWVjZXUKdHJpc3RlcwpkaXNwb27DrXZlbApjaW5jbwpwYXN0ZWwKcG/Dp28KbW/Dp2EKZ2Fyb3RvCmNlcnRvCnRhbHZlegpwaW9yCmJyYW5jbwpib3JyYWNoYQpyb2RhCmJhw7oKY2lyY28KY3JpYQpzaW1wbGVzbWVudGUKYW1hcmVp",
-	"words/sv":                              "aQpvY2gKYXR0CmRldApzb20KZW4KcMOlCsOkcgphdgpmw7ZyCm1lZAp0aWxsCmRlbgpoYXIKZGUKaW50ZQpvbQpldHQKaGFuCm1lbgp2YXIKamFnCnNpZwpmcsOlbgp2aQpzw6UKa2FuCm1hbgpuw6RyCsOlcgpzw6RnZXIKaG9uCnVuZGVyCm9ja3PDpQplZnRlcgplbGxlcgpudQpzaW4KZMOkcgp2aWQKbW90CnNrYQpza3VsbGUKa29tbWVyCnV0CmbDpXIKZmlubnMKdmFyYQpoYWRlCmFsbGEKYW5kcmEKbXlja2V0CsOkbgpow6RyCmTDpQpzZWRhbgrDtnZlcgpiYXJhCmluCmJsaXIKdXBwCsOkdmVuCnZhZApmw6UKdHbDpQp2aWxsCmhhCm3DpW5nYQpodXIKbWVyCmfDpXIKc3ZlcmlnZQprcm9ub3IKZGV0dGEKbnlhCnByb2NlbnQKc2thbGwKaGFucwp1dGFuCnNpbmEKbsOlZ290CnN2ZW5za2EKYWxsdApmw7Zyc3RhCmZpY2sKbcOlc3RlCm1lbGxhbgpibGV2CmJsaQpkYWcKbsOlZ29uCm7DpWdyYQpzaXR0CnN0b3JhCnZhcml0CmRlbQpibGFuZAprbApicmEKdHJlCnRhCmdlbm9tCmRlbApoZWxhCmFubmF0CmZyYW0KZ8O2cgppbmdlbgpzdG9ja2hvbG0KZ8O2cmEKZW5saWd0Cm1pZwpyZWRhbgppbm9tCmtvbQpkdQpoZWx0Cmp1CnNhbW1hCmthbnNrZQpzasOkbHYKb3NzCnRpZGlnYXJlCnNlCm1pbGpvbmVyCmRvY2sKZGVubmEKYsOlZGUKdGlkCmt1bm5hCmbDpXR0CnN0b3IKb2xpa2EKc2VyCmZsZXJhCnBsYXRzCmt1bmRlCmfDpQp1cgpnw6RsbGVyCmhvbm9tCmFsZHJpZwpiYXJuCnZhcmplCmxpdGUKc8OkdHQKanVzdAp2w6RsCnRhcgrDpXQKbWVzdApwZXIKc3TDpXIKZmVtCnRyb3IKcsOkdHQKZGVzc2EKZ8OlbmcKZMOkcmbDtnIKZnlyYQpueQpnaWNrCmhvcwpkZXNzdXRvbQpnZXIKbGlrYQplZnRlcnNvbQp2aWxrZXQKdHJvdHMKdHlja2VyCm3DpG5uaXNrb3IKbGlnZ2VyCnZldAprdmFyCmLDpHR0cmUKZ2pvcmRlCsOkbmTDpQppbmbDtnIKcmVnZXJpbmdlbgpzZW5hc3RlCnNhbXRpZGlndAphbm5hbgrDpG5udQp0aGUKYmxpdml0CmZhbGwKdGFsZXQKZXhlbXBlbApnYW1sYQpkZXJhcwp0aWRlbgptaW4KaGVubmVzCnNpc3RhCmtvbW1hCnN0w7ZycmUKdmlzYXIKc2VuYXJlCnRvZwpuw6RzdGEKZ8O2dGVib3JnCmdlCm1pbmRyZQpnam9ydAp1c2EKaW5uYW4KYWxsdGlkCnBlbmdhcgpzYWRlCmbDtnJzdApzdMOkbGxldAp2w6VyCmbDtnJlCnN2ZW5zawp0aWxsYmFrYQpuZXIKbm9nCnNhbXQKb2Z0YQpzasOkbHZhCmluZ2V0CmZsZXIKc8OkZ2EKZWdlbgpqb2hhbnNzb24KaWdlbgp0aWxsc2FtbWFucwpydW50Cm7DpHN0YW4KbG9wcApmw7ZycmEKw6VyZXQKbcOlbApsw6RuZ3JlCnN2w6VydApiw6RzdGEKYW5kZXJzc29uCmFuZGVycwpoYW5kbGFyCmRhZ2Vucwpsw6RuZ2UKc3RvcnQKcGV0ZXIKZnLDpWdhbgpzcGVsYXIKZW5kYQpsaXYKZm9ydGZhcmFuZGUKbWVkYW4KYmFrb20KaGFmdAptaW5zdApiZXLDpHR0YXIKZmFzdApsYXJzCnBlcnNvbmVyCmLDtnJqYW4KcHJvYmxlbQphbGx0c8OlCmJvcnQKdmFyZsO2cgphbnNlcgp2w6VyYQpmw7ZyZXRhZwptaXR0CmRlc3MKbnl0dAp0aW8KaW5nYQpmYW5ucwplZ25hCnV0YW5mw7ZyCnZpbGxlCmtyCmzDpW5ndApmcmFtZsO2cgpiw6VkYQpiZWjDtnZlcgpwYXIKbmVqCm1pbGphcmRlcgpzdMO2cnN0YQpwb2xpc2VuCnbDpHJsZGVuCmRpcmVrdApmb2xrCmJvcmRlCnbDpGcKaW5uZWLDpHIKc2V4CmtsYXJ0CmfDtnJhbgpiw7ZyCnZpZGFyZQptZW5hcgpvZGRzCmjDpWxsZXIKbMOkdHQKeXR0ZXJsaWdhcmUKcGVyc3NvbgpoZW0KZ8OldHQKc3RvY2tob2xtcwprdmlubm9yCmZsZXN0YQpqYQpoYW5kCnPDpHJza2lsdApkw6RybWVkCmNpcmthCnR2CmxhbmRldApoZWxsZXIKZ8OlbmdlbgrDpXJlbgpiw7ZyamFkZQpkw6RyZW1vdApzdmVyaWdlcwpzZXR0Cmhlbm5lCmpvYmIKa3JpbmcKa8Okbm5lcgpsaXRlbgpiZXNsdXQKZWdlbnRsaWdlbgpsw6RuZGVyCmLDtnJqYXIKcG9saXRpc2thCm5pCnbDpGRlcgpoasOkbHAKb3JkZsO2cmFuZGUKdHJlZGplCnZpbGthCnRhbGFyCmV1cm9wYQpzaWRhbgpyaWt0aWd0CnNtw6UKdGhvbWFzCmV1CmFyYmV0ZQp2aXNzYQpza3JpdmVyCnPDpWcKc2x1dAppYmxhbmQKbmVkCmZyw6VnYQpzYQpow6VsbGEKdW5nYQpkcnlndApqYW4KZW1vdAptYWdudXMKbXVzaWsKbsOkcmEKdHlza2xhbmQKZ8OlbmdlcgpnYXYKZmVsCnRhZ2l0Cm5yCmVrb25vbWlza2EKbmFtbgpla29ueWhldGVyCnN0ZWcKa29tbWl0CmhlbHN0Cmlob3AKbGlrc29tCm5pbHNzb24KYW1lcmlrYW5za2EKc8OlZGFuCmbDtnJzbGFnCnZpbGtlbgpsaXZldApkYWdhcgpzdGVmYW4KdmFubgrDpXJzCnNwZWxhCmpvaGFuCm9mCnPDpGtlcnQKdmFyYW5kcmEKZ2Fuc2thCnZlY2thbgp2ZW0KZW5zCnZpc3N0CmzDpW5nCm1ldGVyCmFsbApuZXYKcmVnaQpodXMKc2l0dGVyCmZvcm0KbGlsbGEKYmFybmVuCnNuYWJidAptw6RuCmdydW5kCmhlbW1hCnNuYXJ0Cm3DtmpsaWd0CmzDpWcKZG4Ka2xhcmEKZnLDpWdvcgp2ZXJrbGlnZW4KcHJlY2lzCmFtZXJpa2Fuc2sKcHVibGlrCnNrb2xhbgpzdMO2ZApzdmVuc3NvbgppbnRlcm5hdGlvbmVsbGEKZGVsYXIKaMO2ZwpmaWxtCmxhcnNzb24KbWF0cwpibwpmYWt0aXNrdApsYW5kCmdvdHQKZ8Okcm5hCmjDtmdyZQp2w6VydApnb2QKZGFnZW4Kw7Z2cmlnYQpiZXRhbGEKbGFnCmJqw7ZybgpzbHV0ZXQKZmlubGFuZAp2ZXJrYXIKc2FndAprcmF2CnN0b2QKb21rcmluZwp2aWt0aWd0Cm9yZApob3BwYXMKa29ydApoaXR0aWxscwptaW51dGVyCnZpc2EKZWdldApzw6VkYW5hCnTDpG5rZXIKZnJhbmtyaWtlCnNqdQptYXRjaGVuCnR5Y2tzCm5hdHVybGlndHZpcwpib2sKZW5kYXN0CmFubmFycwphbnRhbAplbmtlbHQKbWFsbcO2CmbDtnJzw7ZrZXIKcnlzc2xhbmQKdGFsCmpvbmFzCm1hbm5lbgpiZW5ndAp0b3RhbHQKdXRlCnZpc2FkZQpsw6V0ZXIKYW5zdMOkbGxkYQp2w6RnZW4KZG9sbGFyCnNwZWwKdsOkbGRpZ3QKbm9yZ2UKc3TDpQplcmlrCsOldHRhCsOlcmV0cwp2aWEKc2F0dApyb2xsCmJydWthcgpuw6RtbGlnZW4KZm9ydHPDpHR0ZXIKdW5nZWbDpHIKZnJhbXRpZGVuCmLDpHN0CmjDpG5kZXIKYm9yCmjDpXJ0CnNwZWxhcmUKZnLDpG1zdApwcm9ncmFtCmthbGxhZGUKcmFkCmbDtnJzdMOlcwp2w6RybGRlbnMKaMO2Z2EKbcOlbmFkZXIKa3bDpGxsCmZhbWlsamVuCmlnZW5vbQpow7ZsbAptYXJ0aW4Ka8Okbm5zCnN0YXRlbgpwb8OkbmcKYW50YWxldApza2FwYQpoaXN0b3JpYQp0w6Rua2EKdmQKaGV0ZXIKYWxscwpow7ZyCmvDtnBhCmRpdgpzdnQKb3MKZsO2cmV0YWdldApzYW1ow6RsbGV0CnNpZGEKc8OlZGFudApmcmVkcmlrCm1hcmlhCm1pbmEKZXh0cmEKZXJpa3Nzb24Ka3LDpHZlcgpnb2RhCmzDpW5nYQpoaXR0YQpzdmQKw6V0bWluc3RvbmUKbnVtZXJhCnRpbW1hcgp2ZWNrYQprbmFwcGFzdApkaWcKbWlrYWVsCnNrcmV2CnBldHRlcnNzb24Kdm9yZQpueWhldGVyCmzDpHIKY2FybAp2ZXRhCmJldHlkZXIKZ3J1cHAKbGV2ZXIKc3BlbGFkZQpiZXR5ZGxpZ3QKZ2FtbWFsCmFyYmV0YXIKdHlza2EKw6RsZHJlCmRlbHMKcGVyc29uCmxhZ2V0CnR0CmNoZWYKYWxsZGVsZXMKa29tbXVuZW4Kc3RhcmsKdmFsZXQKaWZyw6VuCmxlZGVyCmt2aW5uYQrDtmthcgplbXUKaMOkdmRhcgpsw6RnZ2EKdWxmCmd1bm5hcgpza8OkbApsw6V0YQphbnN2YXIKc2VuCnZpc3NlcmxpZ2VuCnBvbGl0aWtlcgphbnbDpG5kYQpmcmFuc2thCnJhcHBvcnQKa2FybHNzb24KbWVyYQp2ZWNrb3IKZmwKc8OldsOkbApkw6RyZWZ0ZXIKam9obgp2aXNzCmxhbmRldHMKdGVhdGVyCnVuZ2RvbWFyCnBsw7Z0c2xpZ3QKcnlza2EKa3VubmF0Cm55bGlnZW4KbcO2amxpZ2hldApyb2xsZXJuYQptb3Jnb24KZW5hCm1hcnMKc2FtYmFuZArDtmthCmJ5Z2dhCmxpdGV0CmLDtnJqYQp2w6RubmVyCmFpawpwcmVzaWRlbnQKbWFya25hZGVuCmJlaMO2dnMKc3RhZGVuCmjDtmdzdGEKw7ZrYXQKdXR2ZWNrbGluZwp0YWxhCnBlcmlvZGVuCnN0w6RsbGVyCmbDtnJzw7ZrYQpzdGFya3QKc29jaWFsZGVtb2tyYXRlcm5hCmV4ZW1wZWx2aXMKaMOlbGwKcmVzdWx0YXRldApzw6R0dGEKZGl0CmFyYmV0ZXQKdmVya3NhbWhldApsw6RtbmEKYW5uYQpjaHJpc3RlcgppdGFsaWVuCmJpbGQKdmFycwpldmEKcGFydGlldAppbnRyZXNzZQpzYW1hcmJldGUKbsOkcm1hcmUKamFudWFyaQpzZXB0ZW1iZXIKdXBwZ2lmdGVyCmxvbmRvbgpzYWsKZnVsbHQKZ8O2dGVib3JncwptaWNoYWVsCnBlbmdhcm5hCnBhcmlzCm9sc3NvbgpyZXN1bHRhdApib2tlbgpzw7ZkcmEKanVuaQpmb3J0c8OkdHRhCmRyYQppbmdlbnRpbmcKc2FtdGxpZ2EKYmVyb3IKdsOkcmxkCmFsbHRmw7ZyCmRhbm1hcmsKZsO2cnPDtmsKcnVtCnR1cgpwb2xpdGlrCmZyaQpmw7Zya2xhcmFyCmJpbGRlcgpzdmFyCsO2cHBuYQpkw7ZkCnRybwptYWoKdHlja3RlCnZhdHRlbgpzYWtlcgp2YWwKZW5iYXJ0CmlubmUKdXRiaWxkbmluZwpldXMKaW5mb3JtYXRpb24KYXJiZXRhCnZpbGphCmNlbnRydW0KZXVyb3BlaXNrYQp2aW5uYQrDtmthZGUKbGVuYQpsZXZhCm7DpHJtYXN0ZQpsZWRuaW5nZW4KdGlkaWd0CnLDtnIKc2tlcgpyb2JlcnQKYmlsYXIKYm9sYWdldAp0b21teQpvbG9mCmFyYmV0c2zDtnNoZXRlbgpzZW5hc3QKaMO2Z3QKasOkbWbDtnJ0CmhlbApza3JpdmEKdmlrdGlnYQrDtnZyaWd0CmRlY2VtYmVyCmvDpG5uYQppbmfDpXIKbWF0Y2gKZGVsZW4Kc2xhZ3MKbGVkbmluZwpkcmFyCmxlbm5hcnQKa29uc3RhdGVyYXIKYmlsCmZpbm5hcwpmw7Zyw6RsZHJhcgpiaWxlbgplbmdlbHNrYQpwdWJsaWtlbgptw6VsZXQKa2xhcgpzbG9nCnRleHR0dgpyw6RrbmFyCmFuZHJlYXMKZG9tCmtuYXBwdAprcsOkdnMKa29zdGFyCmzDpGdyZQpmaWxtZW4KdmlrdGlnCmtsb2NrYW4KZW1lbGxlcnRpZAprcml0aWsKc3TDpGxsYQpzbMOlcgpsw6V0CnPDpGxqYQrDtnBwZXQKaHVzZXQKbGFnZW4KcsOlZAp0YXMKa29tbXVuCm5vcnNrYQp1cHBnaWZ0Cm3DtmpsaWdoZXRlcgp0b21hcwpzdGFya2EKaGVucmlrCmxlaWYKeW9yawpow6RsZnRlbgphbGxyYQpkanVyZ8OlcmRlbgptZWRsZW1tYXIKYmVzbHV0ZXQKbWFtbWEKc3RhZApibApzbMOlCm9tcsOlZGV0CmbDtnJ1dG9tCmZhbWlsagpkZWJhdHQKdsOkbnRhcgpiZWhvdgprcmFmdAp1dHZlY2tsaW5nZW4KYXZnw7ZyYW5kZQpow7ZzdGVuCmFubGVkbmluZwp2YW5saWdhCnByb2JsZW1ldAp2w6RsamEKdHJvZGRlCmZ1bGwKcGF0cmlrCnZvbHZvCmbDtnJzbGFnZXQKbG9rYWxhCmzDpHNhCmJyZXYKc25hcmFyZQpla29ub21pCm5pbwpzdHJ1a2VuCmFrdGllcgptYXQKcmlrc2RhZ2VuCnZhcmtlbgpzdmFyYXIKdW5kYW4KZWxldmVyCmRhZ3MKZGF2aWQKZmpvbApvZmZlbnRsaWdhCnLDpGNrZXIKc3ZlbgpiZXR5ZGVsc2UKdml0YQptw6VuYWQKdXBwZHJhZwrDpWthCmJvcnRhCnRvbgp0YWcKcmVudApmw7ZsbApmw7Zycgp2b24Ka29udGFrdAp0aWxscwpyZWdlcmluZ2VucwphdWd1c3RpCmZhbGxldApsw6RnZ2VyCmzDpHJhCnZlcmsKYsO2Y2tlcgpzdMOkbGxuaW5nCnNwZWxhcwpzb21tYXJlbgpub3JyYQprw7ZyCnR2aW5nYXMKZnVuZ2VyYXIKbMOkcmFyZQpsaWtuYW5kZQpzZWdlcgpicm90dAprYWxsYXMKbWluc2thCnNha25hcgp2YW5saWd0CnJlc2EKc3ZhcnRhCnVuaXZlcnNpdGV0Cm5pdsOlCnRhbmtlCmFuZApzdMOkbmRpZ3QKdmFyZQpwcml2YXRhCmJ5Z2dlcgpjaGFucwpzw7ZrZXIKc8OkdHRlcgpmw7Zyc3TDpQpzbGFnCnZpa3RpZ2FzdGUKZsO2bGplcgpreXJrYW4Ka8OkbmRlCmxlZGFyZQpzdGFuCnByaXNldApwcm9qZWt0CmFwcmlsCnN2ZW5za2FyCnRpZG5pbmdlbgpudXZhcmFuZGUKc3RyYXgKdXBwc2FsYQp2aXNhdApzZW50CmzDpHNlcgpseWNrYWRlcwp0aWxscsOkY2tsaWd0Cmx5Y2thcwpuw6RybWFzdApzdAp1dGzDpG5kc2thCmLDtnJqYXQKc2FtbWFuCmbDtnJmYXR0YXJlCmTDpWxpZ3QKcGFwcGEKZsO2cmV0YWdlbgpwcm9ncmFtbWV0CnZtCmjDpWthbgprdmlubmFuCmtsYXJhcgpwcmlzCnZlcmtzYW1oZXRlbgp2w6RsamVyCmxlZGEKbsOlCnRleHRhdA==",
-	"words/1000en":                          "YXMKSQpoaXMKdGhhdApoZQp3YXMKZm9yCm9uCmFyZQp3aXRoCnRoZXkKYmUKYXQKb25lCmhhdmUKdGhpcwpmcm9tCmJ5CmhvdAp3b3JkCmJ1dAp3aGF0CnNvbWUKaXMKaXQKeW91Cm9yCmhhZAp0aGUKb2YKdG8KYW5kCmEKaW4Kd2UKY2FuCm91dApvdGhlcgp3ZXJlCndoaWNoCmRvCnRoZWlyCnRpbWUKaWYKd2lsbApob3cKc2FpZAphbgplYWNoCnRlbGwKZG9lcwpzZXQKdGhyZWUKd2FudAphaXIKd2VsbAphbHNvCnBsYXkKc21hbGwKZW5kCnB1dApob21lCnJlYWQKaGFuZApwb3J0CmxhcmdlCnNwZWxsCmFkZApldmVuCmxhbmQKaGVyZQptdXN0CmJpZwpoaWdoCnN1Y2gKZm9sbG93CmFjdAp3aHkKYXNrCm1lbgpjaGFuZ2UKd2VudApsaWdodApraW5kCm9mZgpuZWVkCmhvdXNlCnBpY3R1cmUKdHJ5CnVzCmFnYWluCmFuaW1hbApwb2ludAptb3RoZXIKd29ybGQKbmVhcgpidWlsZApzZWxmCmVhcnRoCmZhdGhlcgphbnkKbmV3CndvcmsKcGFydAp0YWtlCmdldApwbGFjZQptYWRlCmxpdmUKd2hlcmUKYWZ0ZXIKYmFjawpsaXR0bGUKb25seQpyb3VuZAptYW4KeWVhcgpjYW1lCnNob3cKZXZlcnkKZ29vZAptZQpnaXZlCm91cgp1bmRlcgpuYW1lCnZlcnkKdGhyb3VnaApqdXN0CmZvcm0Kc2VudGVuY2UKZ3JlYXQKdGhpbmsKc2F5CmhlbHAKbG93CmxpbmUKZGlmZmVyCnR1cm4KY2F1c2UKbXVjaAptZWFuCmJlZm9yZQptb3ZlCnJpZ2h0CmJveQpvbGQKdG9vCnNhbWUKc2hlCmFsbAp0aGVyZQp3aGVuCnVwCnVzZQp5b3VyCndheQphYm91dAptYW55CnRoZW4KdGhlbQp3cml0ZQp3b3VsZApsaWtlCnNvCnRoZXNlCmhlcgpsb25nCm1ha2UKdGhpbmcKc2VlCmhpbQp0d28KaGFzCmxvb2sKbW9yZQpkYXkKY291bGQKZ28KY29tZQpkaWQKbnVtYmVyCnNvdW5kCm5vCm1vc3QKcGVvcGxlCm15Cm92ZXIKa25vdwp3YXRlcgp0aGFuCmNhbGwKZmlyc3QKd2hvCm1heQpkb3duCnNpZGUKYmVlbgpub3cKZmluZApoZWFkCnN0YW5kCm93bgpwYWdlCnNob3VsZApjb3VudHJ5CmZvdW5kCmFuc3dlcgpzY2hvb2wKZ3JvdwpzdHVkeQpzdGlsbApsZWFybgpwbGFudApjb3Zlcgpmb29kCnN1bgpmb3VyCmJldHdlZW4Kc3RhdGUKa2VlcApleWUKbmV2ZXIKbGFzdApsZXQKdGhvdWdodApjaXR5CnRyZWUKY3Jvc3MKZmFybQpoYXJkCnN0YXJ0Cm1pZ2h0CnN0b3J5CnNhdwpmYXIKc2VhCmRyYXcKbGVmdApsYXRlCnJ1bgpkb24ndAp3aGlsZQpwcmVzcwpjbG9zZQpuaWdodApyZWFsCmxpZmUKZmV3Cm5vcnRoCmJvb2sKY2FycnkKdG9vawpzY2llbmNlCmVhdApyb29tCmZyaWVuZApiZWdhbgppZGVhCmZpc2gKbW91bnRhaW4Kc3RvcApvbmNlCmJhc2UKaGVhcgpob3JzZQpjdXQKc3VyZQp3YXRjaApjb2xvcgpmYWNlCndvb2QKbWFpbgpvcGVuCnNlZW0KdG9nZXRoZXIKbmV4dAp3aGl0ZQpjaGlsZHJlbgpiZWdpbgpnb3QKd2FsawpleGFtcGxlCmVhc2UKcGFwZXIKZ3JvdXAKYWx3YXlzCm11c2ljCnRob3NlCmJvdGgKbWFyawpvZnRlbgpsZXR0ZXIKdW50aWwKbWlsZQpyaXZlcgpjYXIKZmVldApjYXJlCnNlY29uZAplbm91Z2gKcGxhaW4KZ2lybAp1c3VhbAp5b3VuZwpyZWFkeQphYm92ZQpldmVyCnJlZApsaXN0CnRob3VnaApmZWVsCnRhbGsKYmlyZApzb29uCmJvZHkKZG9nCmZhbWlseQpkaXJlY3QKcG9zZQpsZWF2ZQpzb25nCm1lYXN1cmUKZG9vcgpwcm9kdWN0CmJsYWNrCnNob3J0Cm51bWVyYWwKY2xhc3MKd2luZApxdWVzdGlvbgpoYXBwZW4KY29tcGxldGUKc2hpcAphcmVhCmhhbGYKcm9jawpvcmRlcgpmaXJlCnNvdXRoCnByb2JsZW0KcGllY2UKdG9sZAprbmV3CnBhc3MKc2luY2UKdG9wCndob2xlCmtpbmcKc3RyZWV0CmluY2gKbXVsdGlwbHkKbm90aGluZwpjb3Vyc2UKc3RheQp3aGVlbApmdWxsCmZvcmNlCmJsdWUKb2JqZWN0CmRlY2lkZQpzdXJmYWNlCmRlZXAKbW9vbgppc2xhbmQKZm9vdApzeXN0ZW0KYnVzeQp0ZXN0CnJlY29yZApib2F0CmNvbW1vbgpnb2xkCnBvc3NpYmxlCnBsYW5lCnN0ZWFkCmRyeQp3b25kZXIKbGF1Z2gKdGhvdXNhbmQKYWdvCnJhbgpjaGVjawpnYW1lCnNoYXBlCmVxdWF0ZQpob3QKbWlzcwpicm91Z2h0CmhlYXQKc25vdwp0aXJlCmJyaW5nCnllcwpkaXN0YW50CmZpbGwKZWFzdApwYWludApsYW5ndWFnZQphbW9uZwp1bml0CnBvd2VyCnRvd24KZmluZQpjZXJ0YWluCmZseQpmYWxsCmxlYWQKY3J5CmRhcmsKbWFjaGluZQpub3RlCndhaXQKcGxhbgpmaWd1cmUKc3Rhcgpib3gKbm91bgpmaWVsZApyZXN0CmNvcnJlY3QKYWJsZQpwb3VuZApkb25lCmJlYXV0eQpkcml2ZQpzdG9vZApjb250YWluCmZyb250CnRlYWNoCndlZWsKZmluYWwKZ2F2ZQpncmVlbgpvaApxdWljawpkZXZlbG9wCm9jZWFuCndhcm0KZnJlZQptaW51dGUKc3Ryb25nCnNwZWNpYWwKbWluZApiZWhpbmQKY2xlYXIKdGFpbApwcm9kdWNlCmZhY3QKc3BhY2UKaGVhcmQKYmVzdApob3VyCmJldHRlcgp0cnVlCmR1cmluZwpodW5kcmVkCmZpdmUKcmVtZW1iZXIKc3RlcAplYXJseQpob2xkCndlc3QKZ3JvdW5kCmludGVyZXN0CnJlYWNoCmZhc3QKdmVyYgpzaW5nCmxpc3RlbgpzaXgKdGFibGUKdHJhdmVsCmxlc3MKbW9ybmluZwp0ZW4Kc2ltcGxlCnNldmVyYWwKdm93ZWwKdG93YXJkCndhcgpsYXkKYWdhaW5zdApwYXR0ZXJuCnNsb3cKY2VudGVyCmxvdmUKcGVyc29uCm1vbmV5CnNlcnZlCmFwcGVhcgpyb2FkCm1hcApyYWluCnJ1bGUKZ292ZXJuCnB1bGwKY29sZApub3RpY2UKdm9pY2UKZW5lcmd5Cmh1bnQKcHJvYmFibGUKYmVkCmJyb3RoZXIKZWdnCnJpZGUKY2VsbApiZWxpZXZlCnBlcmhhcHMKcGljawpzdWRkZW4KY291bnQKc3F1YXJlCnJlYXNvbgpsZW5ndGgKcmVwcmVzZW50CmFydApzdWJqZWN0CnJlZ2lvbgpzaXplCnZhcnkKc2V0dGxlCnNwZWFrCndlaWdodApnZW5lcmFsCmljZQptYXR0ZXIKY2lyY2xlCnBhaXIKaW5jbHVkZQpkaXZpZGUKc3lsbGFibGUKZmVsdApncmFuZApiYWxsCnlldAp3YXZlCmRyb3AKaGVhcnQKYW0KcHJlc2VudApoZWF2eQpkYW5jZQplbmdpbmUKcG9zaXRpb24KYXJtCndpZGUKc2FpbAptYXRlcmlhbApmcmFjdGlvbgpmb3Jlc3QKc2l0CnJhY2UKd2luZG93CnN0b3JlCnN1bW1lcgp0cmFpbgpzbGVlcApwcm92ZQpsb25lCmxlZwpleGVyY2lzZQp3YWxsCmNhdGNoCm1vdW50Cndpc2gKc2t5CmJvYXJkCmpveQp3aW50ZXIKc2F0CndyaXR0ZW4Kd2lsZAppbnN0cnVtZW50CmtlcHQKZ2xhc3MKZ3Jhc3MKY293CmpvYgplZGdlCnNpZ24KdmlzaXQKcGFzdApzb2Z0CmZ1bgpicmlnaHQKZ2FzCndlYXRoZXIKbW9udGgKbWlsbGlvbgpiZWFyCmZpbmlzaApoYXBweQpob3BlCmZsb3dlcgpjbG90aGUKc3RyYW5nZQpnb25lCnRyYWRlCm1lbG9keQp0cmlwCm9mZmljZQpyZWNlaXZlCnJvdwptb3V0aApleGFjdApzeW1ib2wKZGllCmxlYXN0CnRyb3VibGUKc2hvdXQKZXhjZXB0Cndyb3RlCnNlZWQKdG9uZQpqb2luCnN1Z2dlc3QKY2xlYW4KYnJlYWsKbGFkeQp5YXJkCnJpc2UKYmFkCmJsb3cKb2lsCmJsb29kCnRvdWNoCmdyZXcKY2VudAptaXgKdGVhbQp3aXJlCmNvc3QKbG9zdApicm93bgp3ZWFyCmdhcmRlbgplcXVhbApzZW50CmNob29zZQpmZWxsCmZpdApmbG93CmZhaXIKYmFuawpjb2xsZWN0CnNhdmUKY29udHJvbApkZWNpbWFsCmVhcgplbHNlCnF1aXRlCmJyb2tlCmNhc2UKbWlkZGxlCmtpbGwKc29uCmxha2UKbW9tZW50CnNjYWxlCmxvdWQKc3ByaW5nCm9ic2VydmUKY2hpbGQKc3RyYWlnaHQKY29uc29uYW50Cm5hdGlvbgpkaWN0aW9uYXJ5Cm1pbGsKc3BlZWQKbWV0aG9kCm9yZ2FuCnBheQphZ2UKc2VjdGlvbgpkcmVzcwpjbG91ZApzdXJwcmlzZQpxdWlldApzdG9uZQp0aW55CmNsaW1iCmNvb2wKZGVzaWduCnBvb3IKbG90CmV4cGVyaW1lbnQKYm90dG9tCmtleQppcm9uCnNpbmdsZQpzdGljawpmbGF0CnR3ZW50eQpza2luCnNtaWxlCmNyZWFzZQpob2xlCmp1bXAKYmFieQplaWdodAp2aWxsYWdlCm1lZXQKcm9vdApidXkKcmFpc2UKc29sdmUKbWV0YWwKd2hldGhlcgpwdXNoCnNldmVuCnBhcmFncmFwaAp0aGlyZApzaGFsbApoZWxkCmhhaXIKZGVzY3JpYmUKY29vawpmbG9vcgplaXRoZXIKcmVzdWx0CmJ1cm4KaGlsbApzYWZlCmNhdApjZW50dXJ5CmNvbnNpZGVyCnR5cGUKbGF3CmJpdApjb2FzdApjb3B5CnBocmFzZQpzaWxlbnQKdGFsbApzYW5kCnNvaWwKcm9sbAp0ZW1wZXJhdHVyZQpmaW5nZXIKaW5kdXN0cnkKdmFsdWUKZmlnaHQKbGllCmJlYXQKZXhjaXRlCm5hdHVyYWwKdmlldwpzZW5zZQpjYXBpdGFsCndvbid0CmNoYWlyCmRhbmdlcgpmcnVpdApyaWNoCnRoaWNrCnNvbGRpZXIKcHJvY2VzcwpvcGVyYXRlCnByYWN0aWNlCnNlcGFyYXRlCmRpZmZpY3VsdApkb2N0b3IKcGxlYXNlCnByb3RlY3QKbm9vbgpjcm9wCm1vZGVybgplbGVtZW50CmhpdApzdHVkZW50CmNvcm5lcgpwYXJ0eQpzdXBwbHkKd2hvc2UKbG9jYXRlCnJpbmcKY2hhcmFjdGVyCmluc2VjdApjYXVnaHQKcGVyaW9kCmluZGljYXRlCnJhZGlvCnNwb2tlCmF0b20KaHVtYW4KaGlzdG9yeQplZmZlY3QKZWxlY3RyaWMKZXhwZWN0CmJvbmUKcmFpbAppbWFnaW5lCnByb3ZpZGUKYWdyZWUKdGh1cwpnZW50bGUKd29tYW4KY2FwdGFpbgpndWVzcwpuZWNlc3NhcnkKc2hhcnAKd2luZwpjcmVhdGUKbmVpZ2hib3IKd2FzaApiYXQKcmF0aGVyCmNyb3dkCmNvcm4KY29tcGFyZQpwb2VtCnN0cmluZwpiZWxsCmRlcGVuZAptZWF0CnJ1Ygp0dWJlCmZhbW91cwpkb2xsYXIKc3RyZWFtCmZlYXIKc2lnaHQKdGhpbgp0cmlhbmdsZQpwbGFuZXQKaHVycnkKY2hpZWYKY29sb255CmNsb2NrCm1pbmUKdGllCmVudGVyCm1ham9yCmZyZXNoCnNlYXJjaApzZW5kCnllbGxvdwpndW4KYWxsb3cKcHJpbnQKZGVhZApzcG90CmRlc2VydApzdWl0CmN1cnJlbnQKbGlmdApyb3NlCmFycml2ZQptYXN0ZXIKdHJhY2sKcGFyZW50CnNob3JlCmRpdmlzaW9uCnNoZWV0CnN1YnN0YW5jZQpmYXZvcgpjb25uZWN0CnBvc3QKc3BlbmQKY2hvcmQKZmF0CmdsYWQKb3JpZ2luYWwKc2hhcmUKc3RhdGlvbgpkYWQKYnJlYWQKY2hhcmdlCnByb3BlcgpiYXIKb2ZmZXIKc2VnbWVudApzbGF2ZQpkdWNrCmluc3RhbnQKbWFya2V0CmRlZ3JlZQpwb3B1bGF0ZQpjaGljawpkZWFyCmVuZW15CnJlcGx5CmRyaW5rCm9jY3VyCnN1cHBvcnQKc3BlZWNoCm5hdHVyZQpyYW5nZQpzdGVhbQptb3Rpb24KcGF0aApsaXF1aWQKbG9nCm1lYW50CnF1b3RpZW50CnRlZXRoCnNoZWxsCm5lY2sKb3h5Z2VuCnN1Z2FyCmRlYXRoCnByZXR0eQpza2lsbAp3b21lbgpzZWFzb24Kc29sdXRpb24KbWFnbmV0CnNpbHZlcgp0aGFuawpicmFuY2gKbWF0Y2gKc3VmZml4CmVzcGVjaWFsbHkKZmlnCmFmcmFpZApodWdlCnNpc3RlcgpzdGVlbApkaXNjdXNzCmZvcndhcmQKc2ltaWxhcgpndWlkZQpleHBlcmllbmNlCnNjb3JlCmFwcGxlCmJvdWdodApsZWQKcGl0Y2gKY29hdAptYXNzCmNhcmQKYmFuZApyb3BlCnNsaXAKd2luCmRyZWFtCmV2ZW5pbmcKY29uZGl0aW9uCmZlZWQKdG9vbAp0b3RhbApiYXNpYwpzbWVsbAp2YWxsZXkKbm9yCmRvdWJsZQpzZWF0CmNvbnRpbnVlCmJsb2NrCmNoYXJ0CmhhdApzZWxsCnN1Y2Nlc3MKY29tcGFueQpzdWJ0cmFjdApldmVudApwYXJ0aWN1bGFyCmRlYWwKc3dpbQp0ZXJtCm9wcG9zaXRlCndpZmUKc2hvZQpzaG91bGRlcgpzcHJlYWQKYXJyYW5nZQpjYW1wCmludmVudApjb3R0b24KYm9ybgpkZXRlcm1pbmUKcXVhcnQKbmluZQp0cnVjawpub2lzZQpsZXZlbApjaGFuY2UKZ2F0aGVyCnNob3AKc3RyZXRjaAp0aHJvdwpzaGluZQpwcm9wZXJ0eQpjb2x1bW4KbW9sZWN1bGUKc2VsZWN0Cndyb25nCmdyYXkKcmVwZWF0CnJlcXVpcmUKYnJvYWQKcHJlcGFyZQpzYWx0Cm5vc2UKcGx1cmFsCmFuZ2VyCmNsYWltCmNvbnRpbmVudAo=",
-	"words/it":                              "Y29tZQppbwppbApzdW8KY2hlCmx1aQplcmEKcGVyCnN1CnNvbm8KY29uCmVzc2kKZXNzZXJlCmEKdW5vCmF2ZXJlCnF1ZXN0bwpkYQpjYWxkbwpwYXJvbGEKbWEKY29zYQphbGN1bmkKw6gKZXNzbwp2b2kKbwphdmV2YQppbApkaQphCmUKdW4KaW4Kbm9pCmxhdHRpbmEKZnVvcmkKYWx0cm8KZXJhbm8KY2hlCmZhcmUKbG9ybwp0ZW1wbwpzZQp2b2xvbnTDoApjb21lCnN1ZGRldHRvCnVuCm9nbmkKZGlyZQpmYQpzZXQKdHJlCmRlc2lkZXJhcmUKYXJpYQpiZW5lCmFuY2hlCmdpb2NhcmUKcGljY29sbwpmaW5lCm1ldHRlcmUKY2FzYQpsZWdnZXJlCm1hbm8KcG9ydG8KZ3JhbmRlCmNvbXBpdGFyZQphZ2dpdW5nZXJlCmFuY2hlCnRlcnJhCnF1aQptb3N0bwpncmFuZGUKYWx0bwp0YWxlCnNlZ3VpcmUKYXR0bwpwZXJjaMOpCmNoaWVkZXJlCm1hc2NoaQpjYW1iaWFtZW50bwrDqAphbmRhdG8KbHVjZQp0aXBvCnNwZW50bwpiaXNvZ25vCmNhc2EKaW1tYWdpbmUKcHJvdmFyZQpub2kKZGkKbnVvdm8KYW5pbWFsZQpwdW50bwptYWRyZQptb25kbwp2aWNpbm8KY29zdHJ1aXJlCnNlCnN0ZXNzbwp0ZXJyYQpwYWRyZQpxdWFsc2lhc2kKbnVvdm8KbGF2b3JvCnBhcnRlCnByZW5kZXJlCm90dGVuZXJlCnBvc3RvCmZhdHRvCnZpdmVyZQpkb3ZlCmRvcG8KaW5kaWV0cm8KcG9jbwpzb2xvCnR1cm5vCnVvbW8KYW5ubwrDqAp2ZW51dG8Kc3BldHRhY29sbwpvZ25pCmJ1b25vCm1lCmRhcmUKaWwKbm9zdHJvCnNvdHRvCm5vbWUKbW9sdG8KYXR0cmF2ZXJzbwpzb2xvCmZvcm1hCmZyYXNlCmdyYW5kZQpwZW5zYXJlCmRpcmUKYWl1dGFyZQpiYXNzbwpsaW5lYQpkaWZmZXJpcmUKdHVybm8KY2F1c2EKbW9sdG8KZGlyZQpwcmltYQpzcG9zdGFyZQpkaXJpdHRvCnJhZ2F6em8KdmVjY2hpbwp0cm9wcG8Kc3Rlc3NvCmxlaQp0dXR0bwpjaQpxdWFuZG8Kc3UKdXNvCmlsCnR1bwptb2RvCmNpcmNhCm1vbHRpCmFsbG9yYQpsb3JvCnNjcml2ZXJlCnNhcmViYmUKY29tZQpjb3PDrApxdWVzdGUKbGVpCmx1bmdvCnJlbmRlcmUKY29zYQp2ZWRlcmUKbHVpCmR1ZQpoYQpndWFyZGFyZQpkaQpwacO5Cmdpb3Jubwpwb3R1dG8KYW5kYXJlCnZlbmlyZQpoYQpmYXR0bwpudW1lcm8Kc3Vvbm8Kbm8KcGnDuQpwZXJzb25lCmlsCm1pbwpvbHRyZQpzYXBlcmUKYWNxdWEKZGkKY2hpYW1hdGEKcHJpbW8KY2hlCnB1w7IKZ2nDuQpsYXRvCnN0YXRvCm9yYQp0cm92YXJl",
-	"words/fi":                              "a3VpbgptaW7DpApow6RuZW4KZXR0w6QKaMOkbgpvbGkKdmFydGVuCnDDpMOkbGxlCm9sZW1tZQprYW5zc2EKbmUKb2xsYQprbG8KeWtzaQpvbGxhCnTDpG3DpAphbGthZW4KbXVrYWFuCmt1dW1hCnNhbmEKbXV0dGEKbWl0w6QKam90a3V0Cm9uCnNlCnNpbnVhCnRhaQpvbGkKcMOkw6RsbGUKam9zCmphCnRlaGTDpApzaXPDpMOkbgptZQp2b2lkYQp1bG9zCm11dXQKb2xpdmF0Cmpva2EKdGVoZMOkCm5paWRlbgphaWthCmpvcwp0YWh0b2EKbWl0ZW4Kc2Fub2kKcGllbmkKa3VraW4Ka2VydG9hCnRla2VlCnNldHRpCmtvbG1lCmhhbHV0YQppbG1hCmh5dmluCm15w7ZzCnBlbGF0YQpwaWVuaQpww6TDpApsYWl0dGFhCmtvdGkKbHVlCmvDpHNpCnBvcnR0aQpzdXVyaQpvaWtlaW5raXJqb2l0dWtzZW4KbGlzw6R0w6QKam9wYQptYWEKdMOkw6RsbMOkCmlzbwprb3JrZWEKbmlpbgpzZXVyYXRhCnPDpMOkZMO2cwptaWtzaQpreXN5w6QKbWllaGV0Cm11dXRvcwptZW5pCnZhbG8KcG9pcwp0YXJ2aXRzZXZhdAp0YWxvCmt1dmEKeXJpdHTDpMOkCm1laWxsZQpqw6RsbGVlbgplbMOkaW4Ka29odGEKw6RpdGkKbWFhaWxtYQpsw6RoZWxsw6QKcmFrZW50YWEKaXRzZQptYWEKaXPDpAprYWlra2kKdXVzaQp0ecO2Cm9zYQpvdHRhYQpzYWFkYQpwYWlra2EKdGVodHkKZWzDpMOkCmpvc3NhCmrDpGxrZWVuCnRha2Fpc2luCnbDpGjDpG4KdmFpbgpwecO2cmXDpAptaWVzCnZ1b3NpCnR1bGkKam9rYQpoeXbDpAptaW51YQphbnRhYQptZWlkw6RuCmFsbGUKbmltaQpoeXZpbgprYXV0dGEKdmFpbgpsb21ha2UKdmlya2UKc3V1cmkKYWphdGVsbGEKc2Fub2EKYXV0dGFhCmFsaGFpbmVuCmxpbmphCmVyb3RhCnZ1b3JvCnN5eQpwYWxqb24KdGFya29pdHRhYQplbm5lbgpsaWlra3VhCm9pa2VhCnBvaWthCnZhbmhhCmxpaWFuCnNhbWEKaMOkbgprYWlra2kKc2llbGzDpAprdW4KeWzDtnMKa8OkeXR0w6TDpApzaW51bgp0YXBhCm5vaW4KbW9uZXQKc2l0dGVuCm5paXTDpApraXJqb2l0dGFhCm9saXNpCmt1dGVuCm5paW4KbsOkbcOkCmjDpG5lbgpwaXRrw6QKdGVoZMOkCmFzaWEKbsOkaGTDpApow6RudMOkCmtha3NpCm9uCmthdHNvCmxpc8Okw6QKcMOkaXbDpAp2b2lzaQptZW5uw6QKdHVsZXZhdAp0ZWtpCm51bWVybwrDpMOkbmkKZWkKZW5pdGVuCmlobWlzZXQKbWludW4KeWxpCnRpZXTDpMOkCnZlc2kKa3VpbgpwdWhlbHUKZW5zaW1tw6RpbmVuCmpvdGthCnNhYXR0YWEKYWxhcwpwdW9saQpvbGx1dApueXQKbMO2eXTDpMOkCnDDpMOkCnNlaXN0w6QKb21hCnNpdnU=",
-	"words/200en":                           "dGhlCmJlCm9mCmFuZAphCnRvCmluCmhlCmhhdmUKaXQKdGhhdApmb3IKdGhleQpJCndpdGgKYXMKbm90Cm9uCnNoZQphdApieQp0aGlzCndlCnlvdQpkbwpidXQKZnJvbQpvcgp3aGljaApvbmUKd291bGQKYWxsCndpbGwKdGhlcmUKc2F5CndobwptYWtlCndoZW4KY2FuCm1vcmUKaWYKbm8KbWFuCm91dApvdGhlcgpzbwp3aGF0CnRpbWUKdXAKZ28KYWJvdXQKdGhhbgppbnRvCmNvdWxkCnN0YXRlCm9ubHkKbmV3CnllYXIKc29tZQp0YWtlCmNvbWUKdGhlc2UKa25vdwpzZWUKdXNlCmdldApsaWtlCnRoZW4KZmlyc3QKYW55CndvcmsKbm93Cm1heQpzdWNoCmdpdmUKb3Zlcgp0aGluawptb3N0CmV2ZW4KZmluZApkYXkKYWxzbwphZnRlcgp3YXkKbWFueQptdXN0Cmxvb2sKYmVmb3JlCmdyZWF0CmJhY2sKdGhyb3VnaApsb25nCndoZXJlCm11Y2gKc2hvdWxkCndlbGwKcGVvcGxlCmRvd24Kb3duCmp1c3QKYmVjYXVzZQpnb29kCmVhY2gKdGhvc2UKZmVlbApzZWVtCmhvdwpoaWdoCnRvbwpwbGFjZQpsaXR0bGUKd29ybGQKdmVyeQpzdGlsbApuYXRpb24KaGFuZApvbGQKbGlmZQp0ZWxsCndyaXRlCmJlY29tZQpoZXJlCnNob3cKaG91c2UKYm90aApiZXR3ZWVuCm5lZWQKbWVhbgpjYWxsCmRldmVsb3AKdW5kZXIKbGFzdApyaWdodAptb3ZlCnRoaW5nCmdlbmVyYWwKc2Nob29sCm5ldmVyCnNhbWUKYW5vdGhlcgpiZWdpbgp3aGlsZQpudW1iZXIKcGFydAp0dXJuCnJlYWwKbGVhdmUKbWlnaHQKd2FudApwb2ludApmb3JtCm9mZgpjaGlsZApmZXcKc21hbGwKc2luY2UKYWdhaW5zdAphc2sKbGF0ZQpob21lCmludGVyZXN0CmxhcmdlCnBlcnNvbgplbmQKb3BlbgpwdWJsaWMKZm9sbG93CmR1cmluZwpwcmVzZW50CndpdGhvdXQKYWdhaW4KaG9sZApnb3Zlcm4KYXJvdW5kCnBvc3NpYmxlCmhlYWQKY29uc2lkZXIKd29yZApwcm9ncmFtCnByb2JsZW0KaG93ZXZlcgpsZWFkCnN5c3RlbQpzZXQKb3JkZXIKZXllCnBsYW4KcnVuCmtlZXAKZmFjZQpmYWN0Cmdyb3VwCnBsYXkKc3RhbmQKaW5jcmVhc2UKZWFybHkKY291cnNlCmNoYW5nZQpoZWxwCmxpbmU=",
-	"words/es":                              "ZGUKbGEKcXVlCmVsCmVuCnkKYQpsb3MKc2UKZGVsCmxhcwp1bgpwb3IKY29uCm5vCnVuYQpzdQpwYXJhCmVzCmFsCmxvCmNvbW8KbcOhcwpvCnBlcm8Kc3VzCmxlCmhhCm1lCnNpCnNpbgpzb2JyZQplc3RlCnlhCmVudHJlCmN1YW5kbwp0b2RvCmVzdGEKc2VyCnNvbgpkb3MKdGFtYmnDqW4KZnVlCmhhYsOtYQplcmEKbXV5CmHDsW9zCmhhc3RhCmRlc2RlCmVzdMOhCm1pCnBvcnF1ZQpxdcOpCnPDs2xvCmhhbgp5bwpoYXkKdmV6CnB1ZWRlCnRvZG9zCmFzw60Kbm9zCm5pCnBhcnRlCnRpZW5lCsOpbAp1bm8KZG9uZGUKYmllbgp0aWVtcG8KbWlzbW8KZXNlCmFob3JhCmNhZGEKZQp2aWRhCm90cm8KZGVzcHXDqXMKdGUKb3Ryb3MKYXVucXVlCmVzYQplc28KaGFjZQpvdHJhCmdvYmllcm5vCnRhbgpkdXJhbnRlCnNpZW1wcmUKZMOtYQp0YW50bwplbGxhCnRyZXMKc8OtCmRpam8Kc2lkbwpncmFuCnBhw61zCnNlZ8O6bgptZW5vcw==",
-	"words/de-ch":                           "ZGllCmRlcgp1bmQKaW4KenUKZGVuCmRhcwpuaWNodAp2b24Kc2llCmlzdApkZXMKc2ljaAptaXQKZGVtCmRhc3MKZXIKZXMKZWluCmljaAphdWYKc28KZWluZQphdWNoCmFscwphbgpuYWNoCndpZQppbQpmw7xyCm1hbgphYmVyCmF1cwpkdXJjaAp3ZW5uCm51cgp3YXIKbm9jaAp3ZXJkZW4KYmVpCmhhdAp3aXIKd2FzCndpcmQKc2VpbgplaW5lbgp3ZWxjaGUKc2luZApvZGVyCnp1cgp1bQpoYWJlbgplaW5lcgptaXIKw7xiZXIKaWhtCmRpZXNlCmVpbmVtCmlocgp1bnMKZGEKenVtCmthbm4KZG9jaAp2b3IKZGllc2VyCm1pY2gKaWhuCmR1CmhhdHRlCnNlaW5lCm1laHIKYW0KZGVubgpudW4KdW50ZXIKc2VocgpzZWxic3QKc2Nob24KaGllcgpiaXMKaGFiZQppaHJlCmRhbm4KaWhuZW4Kc2VpbmVyCmFsbGUKd2llZGVyCm1laW5lClplaXQKZ2VnZW4Kdm9tCmdhbnoKZWluemVsbmVuCndvCm11c3MKb2huZQplaW5lcwprw7ZubmVuCnNlaQpqYQp3dXJkZQpqZXR6dAppbW1lcgpzZWluZW4Kd29obApkaWVzZXMKaWhyZW4Kd8O8cmRlCmRpZXNlbgpzb25kZXJuCndlaWwKd2VsY2hlcgpuaWNodHMKZGllc2VtCmFsbGVzCndhcmVuCndpbGwKSGVycgp2aWVsCm1laW4KYWxzbwpzb2xsCndvcmRlbgpsYXNzZW4KZGllcwptYWNoZW4KaWhyZXIKd2VpdGVyCkxlYmVuCnJlY2h0CmV0d2FzCmtlaW5lCnNlaW5lbQpvYgpkaXIKYWxsZW4KSmFocmUKV2Vpc2UKbcO8c3Nlbgp3ZWxjaGVzCnfDpHJlCmVyc3QKZWlubWFsCk1hbm4KaMOkdHRlCnp3ZWkKZGljaAphbGxlaW4KSGVycmVuCnfDpGhyZW5kClBhcmFncmFwaAphbmRlcnMKTGllYmUKa2VpbgpkYW1pdApnYXIKSGFuZApIZXJybgpldWNoCnNvbGx0ZQprb25udGUKZXJzdGVuCmRlcmVuCnp3aXNjaGVuCndvbGxlbgpkZW5lbgpkZXNzZW4Kc2FnZW4KYmluCk1lbnNjaGVuCmd1dApkYXJhdWYKd3VyZGVuCmdld2VzZW4KU2VpdGUKYmFsZAp3ZWl0CnNvbGNoZQpoYXR0ZW4KZWJlbgphbmRlcm4KYmVpZGVuCm1hY2h0CnNlaGVuCmdhbnplCmFuZGVyZW4KbGFuZ2UKd2VyCmlocmVtCnp3YXIKZ2VtYWNodApkb3J0CmtvbW1lbgpXZWx0CmhldXRlCkZyYXUKd2VyZGUKZGVyc2VsYmVuCmdhbnplbgpkZXV0c2NoZW4KbMOkc3N0CnZpZWxsZWljaHQKbWVpbmVy",
-	"words/ru":                              "0LPQvtC0CtGH0LXQu9C+0LLQtdC6CtCy0YDQtdC80Y8K0LTQtdC70L4K0LbQuNC30L3RjArQtNC10L3RjArRgNGD0LrQsArRgNCw0LcK0YDQsNCx0L7RgtCwCtGB0LvQvtCy0L4K0LzQtdGB0YLQvgrQu9C40YbQvgrQtNGA0YPQswrQs9C70LDQtwrQstC+0L/RgNC+0YEK0LTQvtC8CtGB0YLQvtGA0L7QvdCwCtGB0YLRgNCw0L3QsArQvNC40YAK0YHQu9GD0YfQsNC5CtCz0L7Qu9C+0LLQsArRgNC10LHQtdC90L7QugrRgdC40LvQsArQutC+0L3QtdGGCtCy0LjQtArRgdC40YHRgtC10LzQsArRh9Cw0YHRgtGMCtCz0L7RgNC+0LQK0L7RgtC90L7RiNC10L3QuNC1CtC20LXQvdGJ0LjQvdCwCtC00LXQvdGM0LPQuArQt9C10LzQu9GPCtC80LDRiNC40L3QsArQstC+0LTQsArQvtGC0LXRhgrQv9GA0L7QsdC70LXQvNCwCtGH0LDRgQrQv9GA0LDQstC+CtC90L7Qs9CwCtGA0LXRiNC10L3QuNC1CtC00LLQtdGA0YwK0L7QsdGA0LDQtwrQuNGB0YLQvtGA0LjRjwrQstC70LDRgdGC0YwK0LfQsNC60L7QvQrQstC+0LnQvdCwCtCx0L7QswrQs9C+0LvQvtGBCtGC0YvRgdGP0YfQsArQutC90LjQs9CwCtCy0L7Qt9C80L7QttC90L7RgdGC0YwK0YDQtdC30YPQu9GM0YLQsNGCCtC90L7Rh9GMCtGB0YLQvtC7CtC40LzRjwrQvtCx0LvQsNGB0YLRjArRgdGC0LDRgtGM0Y8K0YfQuNGB0LvQvgrQutC+0LzQv9Cw0L3QuNGPCtC90LDRgNC+0LQK0LbQtdC90LAK0LPRgNGD0L/Qv9CwCtGA0LDQt9Cy0LjRgtC40LUK0L/RgNC+0YbQtdGB0YEK0YHRg9C0CtGD0YHQu9C+0LLQuNC1CtGB0YDQtdC00YHRgtCy0L4K0L3QsNGH0LDQu9C+CtGB0LLQtdGCCtC/0L7RgNCwCtC/0YPRgtGMCtC00YPRiNCwCtGD0YDQvtCy0LXQvdGMCtGE0L7RgNC80LAK0YHQstGP0LfRjArQvNC40L3Rg9GC0LAK0YPQu9C40YbQsArQstC10YfQtdGACtC60LDRh9C10YHRgtCy0L4K0LzRi9GB0LvRjArQtNC+0YDQvtCz0LAK0LzQsNGC0YwK0LTQtdC50YHRgtCy0LjQtQrQvNC10YHRj9GGCtCz0L7RgdGD0LTQsNGA0YHRgtCy0L4K0Y/Qt9GL0LoK0LvRjtCx0L7QstGMCtCy0LfQs9C70Y/QtArQvNCw0LzQsArQstC10LoK0YjQutC+0LvQsArRhtC10LvRjArQvtCx0YnQtdGB0YLQstC+CtC00LXRj9GC0LXQu9GM0L3QvtGB0YLRjArQvtGA0LPQsNC90LjQt9Cw0YbQuNGPCtC/0YDQtdC30LjQtNC10L3RggrQutC+0LzQvdCw0YLQsArQv9C+0YDRj9C00L7QugrQvNC+0LzQtdC90YIK0YLQtdCw0YLRgArQv9C40YHRjNC80L4K0YPRgtGA0L4K0L/QvtC80L7RidGMCtGB0LjRgtGD0LDRhtC40Y8K0YDQvtC70YwK0YDRg9Cx0LvRjArRgdC80YvRgdC7CtGB0L7RgdGC0L7Rj9C90LjQtQrQutCy0LDRgNGC0LjRgNCwCtC+0YDQs9Cw0L0K0LLQvdC40LzQsNC90LjQtQrRgtC10LvQvgrRgtGA0YPQtArRgdGL0L0K0LzQtdGA0LAK0YHQvNC10YDRgtGMCtGA0YvQvdC+0LoK0L/RgNC+0LPRgNCw0LzQvNCwCtC30LDQtNCw0YfQsArQv9GA0LXQtNC/0YDQuNGP0YLQuNC1CtC+0LrQvdC+CtGA0LDQt9Cz0L7QstC+0YAK0L/RgNCw0LLQuNGC0LXQu9GM0YHRgtCy0L4K0YHQtdC80YzRjwrQv9GA0L7QuNC30LLQvtC00YHRgtCy0L4K0LjQvdGE0L7RgNC80LDRhtC40Y8K0L/QvtC70L7QttC10L3QuNC1CtGG0LXQvdGC0YAK0L7RgtCy0LXRggrQvNGD0LYK0LDQstGC0L7RgArRgdGC0LXQvdCwCtC40L3RgtC10YDQtdGBCtGE0LXQtNC10YDQsNGG0LjRjwrQv9GA0LDQstC40LvQvgrRg9C/0YDQsNCy0LvQtdC90LjQtQrQvNGD0LbRh9C40L3QsArQuNC00LXRjwrQv9Cw0YDRgtC40Y8K0YHQvtCy0LXRggrRgdGH0LXRggrRgdC10YDQtNGG0LUK0LTQstC40LbQtdC90LjQtQrQstC10YnRjArQvNCw0YLQtdGA0LjQsNC7CtC90LXQtNC10LvRjwrRh9GD0LLRgdGC0LLQvgrQs9C70LDQstCwCtC90LDRg9C60LAK0YDRj9C0CtCz0LDQt9C10YLQsArQv9GA0LjRh9C40L3QsArQv9C70LXRh9C+CtGG0LXQvdCwCtC/0LvQsNC9CtGA0LXRh9GMCtGC0L7Rh9C60LAK0L7RgdC90L7QstCwCtGC0L7QstCw0YDQuNGJCtC60YPQu9GM0YLRg9GA0LAK0LTQsNC90L3Ri9C1CtC80L3QtdC90LjQtQrQtNC+0LrRg9C80LXQvdGCCtC40L3RgdGC0LjRgtGD0YIK0YXQvtC0CtC/0YDQvtC10LrRggrQstGB0YLRgNC10YfQsArQtNC40YDQtdC60YLQvtGACtGB0YDQvtC6CtC/0LDQu9C10YYK0L7Qv9GL0YIK0YHQu9GD0LbQsdCwCtGB0YPQtNGM0LHQsArQtNC10LLRg9GI0LrQsArQvtGH0LXRgNC10LTRjArQu9C10YEK0YHQvtGB0YLQsNCyCtGH0LvQtdC9CtC60L7Qu9C40YfQtdGB0YLQstC+CtGB0L7QsdGL0YLQuNC1CtC+0LHRitC10LrRggrQt9Cw0LsK0YHQvtC30LTQsNC90LjQtQrQt9C90LDRh9C10L3QuNC1CtC/0LXRgNC40L7QtArRiNCw0LMK0LHRgNCw0YIK0LjRgdC60YPRgdGB0YLQstC+CtGB0YLRgNGD0LrRgtGD0YDQsArQvdC+0LzQtdGACtC/0YDQuNC80LXRgArQuNGB0YHQu9C10LTQvtCy0LDQvdC40LUK0LPRgNCw0LbQtNCw0L3QuNC9CtC40LPRgNCwCtC90LDRh9Cw0LvRjNC90LjQugrRgNC+0YHRggrRgtC10LzQsArQv9GA0LjQvdGG0LjQvwrQvNC10YLQvtC0CtGC0LjQvwrRhNC40LvRjNC8CtC60YDQsNC5CtCz0L7RgdGC0YwK0LLQvtC30LTRg9GFCtGF0LDRgNCw0LrRgtC10YAK0LHQvtGA0YzQsdCwCtC40YHQv9C+0LvRjNC30L7QstCw0L3QuNC1CtGA0LDQt9C80LXRgArQvtCx0YDQsNC30L7QstCw0L3QuNC1CtC80LDQu9GM0YfQuNC6CtC60YDQvtCy0YwK0YDQsNC50L7QvQrQvdC10LHQvgrQsNGA0LzQuNGPCtC60LvQsNGB0YEK0L/RgNC10LTRgdGC0LDQstC40YLQtdC70YwK0YPRh9Cw0YHRgtC40LUK0LTQtdCy0L7Rh9C60LAK0L/QvtC70LjRgtC40LrQsArQs9C10YDQvtC5CtC60LDRgNGC0LjQvdCwCtC00L7Qu9C70LDRgArRgdC/0LjQvdCwCtGC0LXRgNGA0LjRgtC+0YDQuNGPCtC/0L7QuwrQv9C+0LvQtQrQuNC30LzQtdC90LXQvdC40LUK0L3QsNC/0YDQsNCy0LvQtdC90LjQtQrRgNC40YHRg9C90L7QugrRgtC10YfQtdC90LjQtQrRhtC10YDQutC+0LLRjArQsdCw0L3QugrRgdGG0LXQvdCwCtC90LDRgdC10LvQtdC90LjQtQrQsdC+0LvRjNGI0LjQvdGB0YLQstC+CtC80YPQt9GL0LrQsArQv9GA0LDQstC00LAK0YHQstC+0LHQvtC00LAK0L/QsNC80Y/RgtGMCtC60L7QvNCw0L3QtNCwCtGB0L7RjtC3CtCy0YDQsNGHCtC00L7Qs9C+0LLQvtGACtC00LXRgNC10LLQvgrRhNCw0LrRggrRhdC+0LfRj9C40L0K0L/RgNC40YDQvtC00LAK0YPQs9C+0LsK0YLQtdC70LXRhNC+0L0K0L/QvtC30LjRhtC40Y8K0LTQstC+0YAK0L/QuNGB0LDRgtC10LvRjArRgdCw0LzQvtC70LXRggrQvtCx0YrQtdC8CtGA0L7QtArRgdC+0LvQvdGG0LUK0LLQtdGA0LAK0LHQtdGA0LXQswrRgdC/0LXQutGC0LDQutC70YwK0YTQuNGA0LzQsArRgdC/0L7RgdC+0LEK0LfQsNCy0L7QtArRhtCy0LXRggrQttGD0YDQvdCw0LsK0YDRg9C60L7QstC+0LTQuNGC0LXQu9GMCtGB0L/QtdGG0LjQsNC70LjRgdGCCtC+0YbQtdC90LrQsArRgNC10LPQuNC+0L0K0L/QtdGB0L3RjwrQv9GA0L7RhtC10L3RggrRgNC+0LTQuNGC0LXQu9GMCtC80L7RgNC1CtGC0YDQtdCx0L7QstCw0L3QuNC1CtC+0YHQvdC+0LLQsNC90LjQtQrQv9C+0LvQvtCy0LjQvdCwCtGA0L7QvNCw0L0K0LrRgNGD0LMK0LDQvdCw0LvQuNC3CtGB0YLQuNGF0LgK0LDQstGC0L7QvNC+0LHQuNC70YwK0Y3QutC+0L3QvtC80LjQutCwCtC70LjRgtC10YDQsNGC0YPRgNCwCtCx0YPQvNCw0LPQsArQv9C+0Y3RggrRgdGC0LXQv9C10L3RjArQs9C+0YHQv9C+0LTQuNC9CtC90LDQtNC10LbQtNCwCtC/0YDQtdC00LzQtdGCCtCy0LDRgNC40LDQvdGCCtC80LjQvdC40YHRgtGACtCz0YDQsNC90LjRhtCwCtC00YPRhQrQvNC+0LTQtdC70YwK0L7Qv9C10YDQsNGG0LjRjwrQv9Cw0YDQsArRgdC+0L0K0L3QsNC30LLQsNC90LjQtQrRg9C8CtC/0L7QstC+0LQK0YHRgtCw0YDQuNC6CtC80LjQu9C70LjQvtC9CtGD0YHQv9C10YUK0YHRh9Cw0YHRgtGM0LUK0YDQtdCx0Y/RgtCwCtC60LDQsdC40L3QtdGCCtC80LDQs9Cw0LfQuNC9CtC/0YDQvtGB0YLRgNCw0L3RgdGC0LLQvgrQstGL0YXQvtC0CtGD0LTQsNGACtCx0LDQt9CwCtC30L3QsNC90LjQtQrRgtC10LrRgdGCCtC30LDRidC40YLQsArRgNGD0LrQvtCy0L7QtNGB0YLQstC+CtC/0LvQvtGJ0LDQtNGMCtGB0L7Qt9C90LDQvdC40LUK0LLQvtC30YDQsNGB0YIK0YPRh9Cw0YHRgtC90LjQugrRg9GH0LDRgdGC0L7QugrQv9GD0L3QutGCCtC70LjQvdC40Y8K0LbQtdC70LDQvdC40LUK0L/QsNC/0LAK0LTQvtC60YLQvtGACtCz0YPQsdCwCtC00L7Rh9GMCtGB0YDQtdC00LAK0L/RgNC10LTRgdC10LTQsNGC0LXQu9GMCtC/0YDQtdC00YHRgtCw0LLQu9C10L3QuNC1CtGB0L7Qu9C00LDRggrRhdGD0LTQvtC20L3QuNC6CtCy0L7Qu9C+0YEK0L7RgNGD0LbQuNC1CtGB0L7QvtGC0LLQtdGC0YHRgtCy0LjQtQrQstC10YLQtdGACtC/0LDRgNC10L3RjArQt9GA0LXQvdC40LUK0LPQtdC90LXRgNCw0LsK0L7Qs9C+0L3RjArQv9C+0L3Rj9GC0LjQtQrRgdGC0YDQvtC40YLQtdC70YzRgdGC0LLQvgrRg9GF0L4K0LPRgNGD0LTRjArQvdC+0YEK0YHRgtGA0LDRhQrRg9GB0LvRg9Cz0LAK0YHQvtC00LXRgNC20LDQvdC40LUK0YDQsNC00L7RgdGC0YwK0LHQtdC30L7Qv9Cw0YHQvdC+0YHRgtGMCtC/0YDQvtC00YPQutGCCtC60L7QvNC/0LvQtdC60YEK0LHQuNC30L3QtdGBCtGB0LDQtArRgdC+0YLRgNGD0LTQvdC40LoK0LvQtdGC0L4K0LrRg9GA0YEK0L/RgNC10LTQu9C+0LbQtdC90LjQtQrRgNC+0YIK0YLQtdGF0L3QvtC70L7Qs9C40Y8K0YDQtdGE0L7RgNC80LAK0L7RgtGB0YPRgtGB0YLQstC40LUK0YHQvtCx0LDQutCwCtC60LDQvNC10L3RjArQsdGD0LTRg9GJ0LXQtQrRgNCw0YHRgdC60LDQtwrQutC+0L3RgtGA0L7Qu9GMCtGA0LXQutCwCtC/0YDQvtC00YPQutGG0LjRjwrRgdGD0LzQvNCwCtGC0LXRhdC90LjQutCwCtC30LTQsNC90LjQtQrRgdGE0LXRgNCwCtC90LXQvtCx0YXQvtC00LjQvNC+0YHRgtGMCtGE0L7QvdC0CtC/0L7QtNCz0L7RgtC+0LLQutCwCtC70LjRgdGCCtGA0LXRgdC/0YPQsdC70LjQutCwCtGF0L7Qt9GP0LnRgdGC0LLQvgrQstC+0LvRjwrQsdGO0LTQttC10YIK0YHQvdC10LMK0LTQtdGA0LXQstC90Y8K0LzRg9C20LjQugrRjdC70LXQvNC10L3RggrQvtCx0YHRgtC+0Y/RgtC10LvRjNGB0YLQstC+CtC90LXQvNC10YYK0L/QvtCx0LXQtNCwCtC40YHRgtC+0YfQvdC40LoK0LfQstC10LfQtNCwCtCy0YvQsdC+0YAK0LzQsNGB0YHQsArQuNGC0L7QswrRgdC10YHRgtGA0LAK0L/RgNCw0LrRgtC40LrQsArQv9GA0L7QstC10LTQtdC90LjQtQrQutCw0YDQvNCw0L0K0YHQu9Cw0LLQsArQutGD0YXQvdGPCtC+0L/RgNC10LTQtdC70LXQvdC40LUK0YTRg9C90LrRhtC40Y8K0LLQvtC50YHQutC+CtC60L7QvNC40YHRgdC40Y8K0L/RgNC40LzQtdC90LXQvdC40LUK0LrQsNC/0LjRgtCw0L0K0YDQsNCx0L7RgtC90LjQugrQvtCx0LXRgdC/0LXRh9C10L3QuNC1CtC+0YTQuNGG0LXRgArRhNCw0LzQuNC70LjRjwrQv9GA0LXQtNC10LsK0LLRi9Cx0L7RgNGLCtGD0YfQtdC90YvQuQrQsdGD0YLRi9C70LrQsArQsdC+0LkK0YLQtdC+0YDQuNGPCtC30L7QvdCwCtC+0YLQtNC10LsK0LfRg9CxCtGA0LDQt9GA0LDQsdC+0YLQutCwCtC70LjRh9C90L7RgdGC0YwK0LPQvtGA0LAK0YLQvtCy0LDRgArQvNC10YLRgArQv9GA0LDQt9C00L3QuNC6CtCy0LvQuNGP0L3QuNC1CtGH0LjRgtCw0YLQtdC70YwK0YPQtNC+0LLQvtC70YzRgdGC0LLQuNC1CtCw0LrRgtC10YAK0YHQu9C10LfQsArQvtGC0LLQtdGC0YHRgtCy0LXQvdC90L7RgdGC0YwK0YPRh9C40YLQtdC70YwK0LDQutGCCtCx0L7Qu9GMCtC80L3QvtC20LXRgdGC0LLQvgrQvtGB0L7QsdC10L3QvdC+0YHRgtGMCtC/0L7QutCw0LfQsNGC0LXQu9GMCtC60L7RgNCw0LHQu9GMCtC30LLRg9C6CtCy0L/QtdGH0LDRgtC70LXQvdC40LUK0YfQsNGB0YLQvdC+0YHRgtGMCtC00LXRgtGB0YLQstC+CtCy0YvQstC+0LQK0L/RgNC+0YTQtdGB0YHQvtGACtC00L7Qu9GPCtC90L7RgNC80LAK0L/RgNC+0YjQu9C+0LUK0LrQvtC80LDQvdC00LjRgArQutC+0YDQuNC00L7RgArQv9C+0LTQtNC10YDQttC60LAK0YDQsNC80LrQsArQstGA0LDQswrRjdGC0LDQvwrRh9C10YDRggrQtNC10LQK0YHQvtCx0YDQsNC90LjQtQrQv9GA0LjQtdC8CtCx0L7Qu9C10LfQvdGMCtC60LvQtdGC0LrQsArQutC+0LbQsArQt9Cw0Y/QstC70LXQvdC40LUK0L/QvtC/0YvRgtC60LAK0YHRgNCw0LLQvdC10L3QuNC1CtGA0LDRgdGH0LXRggrQtNC10L/Rg9GC0LDRggrQutC+0LzQuNGC0LXRggrQt9C90LDQugrQtNGP0LTRjwrRg9GH0LXRggrRhdC70LXQsQrRh9Cw0LkK0YDQtdC20LjQvArRhtC10LvQvtC1CtCy0LjRgNGD0YEK0LLRi9GA0LDQttC10L3QuNC1CtC30LTQvtGA0L7QstGM0LUK0LfQuNC80LAK0LTQtdGB0Y/RgtC+0LoK0LPQu9GD0LHQuNC90LAK0YHQtdGC0YwK0YHRgtGD0LTQtdC90YIK0YHQtdC60YPQvdC00LAK0YHQutC+0YDQvtGB0YLRjArQv9C+0LjRgdC6CtGB0YPRgtGMCtC90LDQu9C+0LMK0L7RiNC40LHQutCwCtC00L7RhdC+0LQK0YDQtdC20LjRgdGB0LXRgArQv9C+0LLQtdGA0YXQvdC+0YHRgtGMCtC+0YnRg9GJ0LXQvdC40LUK0LrQsNGA0YLQsArQutC70YPQsQrRgdGC0LDQvdGG0LjRjwrRgNC10LLQvtC70Y7RhtC40Y8K0LrQvtC70LXQvdC+CtC80LjQvdC40YHRgtC10YDRgdGC0LLQvgrRgdGC0LXQutC70L4K0Y3RgtCw0LYK0LLRi9GB0L7RgtCwCtCx0LDQsdGD0YjQutCwCtGC0YDRg9Cx0LrQsArQs9Cw0LcK0LzQsNGB0YLQtdGACtC/0L7QstC10LTQtdC90LjQtQrRgdGC0L7Qu9C40YbQsArQvNC10YXQsNC90LjQt9C8CtC/0LXRgNC10LTQsNGH0LAK0YHQv9C+0YHQvtCx0L3QvtGB0YLRjArQv9C+0LTRhdC+0LQK0Y3QvdC10YDQs9C40Y8K0YHRg9GJ0LXRgdGC0LLQvtCy0LDQvdC40LUK0LjRgdC/0L7Qu9C90LXQvdC40LUK0LrQuNC90L4K0YHQvtC20LDQu9C10L3QuNC1CtC30LDQvNC10YHRgtC40YLQtdC70YwK0YDQtdGB0YPRgNGBCtCw0LrRhtC40Y8K0YDQvtC20LTQtdC90LjQtQrQsNC00LzQuNC90LjRgdGC0YDQsNGG0LjRjwrRgdGC0L7QuNC80L7RgdGC0YwK0YPQu9GL0LHQutCwCtCw0YDRgtC40YHRggrRgdC+0YHQtdC0CtGE0YDQsNC30LAK0YTQuNCz0YPRgNCwCtGB0YPQsdGK0LXQutGCCtGA0LXQsNC60YbQuNGPCtGB0L/QuNGB0L7QugrRhNC+0YLQvtCz0YDQsNGE0LjRjwrQttGD0YDQvdCw0LvQuNGB0YIK0LzQsNC5CtC90LDRgNGD0YjQtdC90LjQtQrQt9Cw0YHQtdC00LDQvdC40LUK0YLQvtC70L/QsArQsdC+0LvRjNC90LjRhtCwCtGB0YPRidC10YHRgtCy0L4K0YHQstC+0LnRgdGC0LLQvgrQtNC+0LvQswrQv9C+0LrQvtC70LXQvdC40LUK0LbQuNCy0L7RgtC90L7QtQrRgdGF0LXQvNCwCtGD0YHQuNC70LjQtQrQvtGC0LvQuNGH0LjQtQrQvtGB0YLRgNC+0LIK0L/RgNC+0YLQuNCy0L3QuNC6CtCy0L7Qu9C90LAK0YDQtdCw0LvQuNC30LDRhtC40Y8K0YHRgtGA0LDQvdC40YbQsArRhNC+0YDQvNC40YDQvtCy0LDQvdC40LUK0LbQuNGC0LXQu9GMCtC60YDQsNGB0L7RgtCwCtC/0YLQuNGG0LAK0YDQsNGB0YLQtdC90LjQtQrRgtC10L3RjArRj9Cy0LvQtdC90LjQtQrRhdGA0LDQvArQt9Cw0L/QsNGFCtCy0L7QtNC60LAK0L3QsNC70LjRh9C40LUK0YPQttCw0YEK0L7QtNC10LbQtNCwCtC60YDQtdGB0LvQvgrQsdC+0LvRjNC90L7QuQrQv9C+0LXQt9C0CtGD0L3QuNCy0LXRgNGB0LjRgtC10YIK0YLRgNCw0LTQuNGG0LjRjwrQsNC00YDQtdGBCtC00LXQutCw0LHRgNGMCtC70LDQtNC+0L3RjArRgdCy0LXQtNC10L3QuNC1CtGG0LLQtdGC0L7QugrQu9C40LTQtdGACtC+0LrRgtGP0LHRgNGMCtC30LDQvdGP0YLQuNC1CtGB0LXQvdGC0Y/QsdGA0YwK0L/QvtC80LXRidC10L3QuNC1CtGP0L3QstCw0YDRjArQt9GA0LjRgtC10LvRjArRgNC10LTQsNC60YbQuNGPCtGB0YLQuNC70YwK0LLQtdGB0L3QsArRhNCw0LrRgtC+0YAK0LDQstCz0YPRgdGCCtC40LfQstC10YHRgtC40LUK0LfQsNCy0LjRgdC40LzQvtGB0YLRjArQvtGF0YDQsNC90LAK0L7QsdC+0YDRg9C00L7QstCw0L3QuNC1CtC60L7QvdGG0LXRgNGCCtC+0YLQtNC10LvQtdC90LjQtQrRgNCw0YHRhdC+0LQK0LLRi9GB0YLQsNCy0LrQsArQvNC40LvQuNGG0LjRjwrQv9C10YDQtdGF0L7QtArRjdC/0L7RhdCwCtC30LDQv9Cw0LQK0L/RgNC+0LjQt9Cy0LXQtNC10L3QuNC1CtGA0L7QtNC40L3QsArRgdC+0LHRgdGC0LLQtdC90L3QvtGB0YLRjArRgtCw0LnQvdCwCtGC0YDQsNCy0LAK0LvQsNCz0LXRgNGMCtC40LzRg9GJ0LXRgdGC0LLQvgrQutGA0L7QstCw0YLRjArQsNC/0L/QsNGA0LDRggrRgdC10YDQtdC00LjQvdCwCtC80LDRgNGCCtC60LvQuNC10L3RggrQtNCw0LzQsArRhNGA0L7QvdGCCtC+0YLRgNCw0YHQu9GMCtGB0YLRg9C7CtCx0LXRgdC10LTQsArQt9Cw0LrQvtC90L7QtNCw0YLQtdC70YzRgdGC0LLQvgrQv9GA0L7QtNCw0LbQsArQv9C+0LLRi9GI0LXQvdC40LUK0LzRg9C30LXQuQrRgdC70LXQtArQv9C+0LvQutC+0LLQvdC40LoK0YHQvtC80L3QtdC90LjQtQrQv9C+0L3QuNC80LDQvdC40LUK0LDQv9GA0LXQu9GMCtC60L3Rj9C30YwK0YDRi9Cx0LAK0LTRg9C80LAK0LrQvtC00LXQutGBCtGB0YPRgtC60LgK0YfRg9C00L4K0YjQtdGPCtGB0YPQtNGM0Y8K0LrRgNGL0YjQsArQvdCw0YHRgtGA0L7QtdC90LjQtQrQv9C+0YLQvtC6CtC00L7Qu9C20L3QvtGB0YLRjArQv9GA0LXRgdGC0YPQv9C70LXQvdC40LUK0LzQvtC30LMK0YfQtdGB0YLRjArQv9C+0YHRggrQtdCy0YDQtdC5CtC40Y7QvdGMCtGB0L7RgtC90Y8K0LTQvtC20LTRjArQu9C10YHRgtC90LjRhtCwCtC00LDRh9CwCtGD0YHRgtCw0L3QvtCy0LrQsArQv9C+0Y/QstC70LXQvdC40LUK0L/QvtC70YPRh9C10L3QuNC1CtC+0LHRgNCw0LfQtdGGCtGC0YDRg9Cx0LAK0LPQu9Cw0LLQvdC+0LUK0L7RgdC10L3RjArQutC+0YHRgtGO0LwK0LHQsNCx0LAK0YbQtdC90L3QvtGB0YLRjArQvtCx0Y/Qt9Cw0L3QvdC+0YHRgtGMCtC/0YzQtdGB0LAK0YLQsNCx0LvQuNGG0LAK0LLQuNC90L4K0LLQvtGB0L/QvtC80LjQvdCw0L3QuNC1CtC70L7RiNCw0LTRjArQutC+0LvQu9C10LPQsArQvtGA0LPQsNC90LjQt9C8CtGD0YfQtdC90LjQugrRg9GH0YDQtdC20LTQtdC90LjQtQrQvtGC0LrRgNGL0YLQuNC1CtGC0L7QvArRh9C10YDRgtCwCtGF0LDRgNCw0LrRgtC10YDQuNGB0YLQuNC60LAK0LLRi9C/0L7Qu9C90LXQvdC40LUK0L7QsdC+0YDQvtC90LAK0LLRi9GB0YLRg9C/0LvQtdC90LjQtQrRgtC10LzQv9C10YDQsNGC0YPRgNCwCtC/0LXRgNGB0L/QtdC60YLQuNCy0LAK0L/QvtC00YDRg9Cz0LAK0L/RgNC40LrQsNC3CtC20LXRgNGC0LLQsArRgNC10YHRgtC+0YDQsNC9CtC60LjQu9C+0LzQtdGC0YAK0YHQv9C+0YAK0LLQutGD0YEK0L/RgNC40LfQvdCw0LoK0L/RgNC+0LzRi9GI0LvQtdC90L3QvtGB0YLRjArQsNC80LXRgNC40LrQsNC90LXRhgrQu9C+0LEK0LfQsNC60LvRjtGH0LXQvdC40LUK0LLQvtGB0YLQvtC6CtC40YHQutC70Y7Rh9C10L3QuNC1CtC60LvRjtGHCtC/0L7RgdGC0LDQvdC+0LLQu9C10L3QuNC1CtGB0LvQvtC5CtCx0L7QugrQuNGO0LvRjArQv9C10YDQtdCy0L7QtArRgdC10LrRgNC10YLQsNGA0YwK0LrRg9GB0L7QugrRgdC70YPRhQrQv9C+0LvRjNC30LAK0LfQstC+0L3QvtC6CtC+0LHRgdGC0LDQvdC+0LLQutCwCtGH0LjQvdC+0LLQvdC40LoK0YHQvtCz0LvQsNGI0LXQvdC40LUK0LTQtdGC0LDQu9GMCtGA0YPRgdGB0LrQuNC5CtGC0LjRiNC40L3QsArQt9Cw0YDQv9C70LDRgtCwCtCx0LjQu9C10YIK0L/QvtC00LDRgNC+0LoK0YLRjtGA0YzQvNCwCtGP0YnQuNC6CtC60L7QvdC60YPRgNGBCtC60L3QuNC20LrQsArQuNC30YPRh9C10L3QuNC1CtC/0YDQvtGB0YzQsdCwCtGG0LDRgNGMCtC/0YPQsdC70LjQutCwCtGB0LzQtdGFCtGB0L7QvtCx0YnQtdC90LjQtQrRg9Cz0YDQvtC30LAK0LHQtdC00LAK0LHQu9C+0LoK0LTQvtGB0YLQuNC20LXQvdC40LUK0L3QsNC30L3QsNGH0LXQvdC40LUK0YDQtdC60LvQsNC80LAK0L/QvtGA0YLRgNC10YIK0LzQsNGB0LvQvgrRgdGC0LDQutCw0L0K0YPRgNC+0LoK0YfQsNGB0YsK0LrRgNC40LoK0YLQstC+0YDRh9C10YHRgtCy0L4K0YLQtdC70LXQstC40LfQvtGACtC40L3RgdGC0YDRg9C80LXQvdGCCtC60L7QvdGG0LXQv9GG0LjRjwrQu9C10LnRgtC10L3QsNC90YIK0Y3QutGA0LDQvQrQtNC90L4K0YDQtdCw0LvRjNC90L7RgdGC0YwK0LrQsNC90LDQuwrQvNGP0YHQvgrQt9C90LDQutC+0LzRi9C5CtGJ0LXQutCwCtC60L7QvdGE0LvQuNC60YIK0L/QtdGA0LXQs9C+0LLQvtGA0YsK0LfQsNC/0LjRgdGMCtCy0LDQs9C+0L0K0L/Qu9C+0YnQsNC00LrQsArQv9C+0YHQu9C10LTRgdGC0LLQuNC1CtGB0L7RgtGA0YPQtNC90LjRh9C10YHRgtCy0L4K0LfQtdGA0LrQsNC70L4K0YLQvtC9CtCw0LrQsNC00LXQvNC40Y8K0L/QsNC70LDRgtCwCtC/0L7RgtGA0LXQsdC90L7RgdGC0YwK0L3QvtGP0LHRgNGMCtGD0LLQtdC70LjRh9C10L3QuNC1CtC00YPRgNCw0LoK0L/QvtC10LfQtNC60LAK0L7QsdC10LQK0L/QvtGC0LXRgNGPCtGE0LXQstGA0LDQu9GMCtC80LXRgNC+0L/RgNC40Y/RgtC40LUK0L/QsNGA0LoK0L/RgNC40L3Rj9GC0LjQtQrRg9GB0YLRgNC+0LnRgdGC0LLQvgrQstC10YnQtdGB0YLQstC+CtC60LDRgtC10LPQvtGA0LjRjwrRgdC10LfQvtC9CtCz0L7RgdGC0LjQvdC40YbQsArQuNC30LTQsNC90LjQtQrQvtCx0YrQtdC00LjQvdC10L3QuNC1CtGC0LXQvNC90L7RgtCwCtGH0LXQu9C+0LLQtdGH0LXRgdGC0LLQvgrQutC+0LvQtdGB0L4K0L7Qv9Cw0YHQvdC+0YHRgtGMCtGA0LDQt9GA0LXRiNC10L3QuNC1CtCy0L7Qt9C00LXQudGB0YLQstC40LUK0LrQvtC70LvQtdC60YLQuNCyCtC60LDQvNC10YDQsArQt9Cw0L/QsNGBCtGB0LvQtdC00YHRgtCy0LjQtQrQtNC70LjQvdCwCtC60YDRi9C70L4K0L7QutGA0YPQswrRhNC+0L0K0LrQsNC90LTQuNC00LDRggrRgNC+0LTRgdGC0LLQtdC90L3QuNC6CtC00LDQstC70LXQvdC40LUK0L/RgNC40YHRg9GC0YHRgtCy0LjQtQrQstC30LDQuNC80L7QtNC10LnRgdGC0LLQuNC1CtC00L7RgdC60LAK0L/QsNGA0YLQvdC10YAK0LTQstC40LPQsNGC0LXQu9GMCtGI0YPQvArQtNC+0YHRgtC+0LjQvdGB0YLQstC+CtCz0YDQtdGFCtC90L7QtgrQv9C+0LvQtdGCCtGB0YLRgNCw0YHRgtGMCtC40YHQv9GL0YLQsNC90LjQtQpUUlVFCtC+0L/Qu9Cw0YLQsArRgNCw0LfQvdC40YbQsArQstC+0LTQuNGC0LXQu9GMCtC/0LDQutC10YIK0YHQvdC40LbQtdC90LjQtQrRhNC+0YDQvNGD0LvQsArQttC40LLQvtGCCtC60LDQv9C40YLQsNC7CtC80L7RgdGCCtC90L7QstC+0YHRgtGMCtGN0YTRhNC10LrRggrQstGF0L7QtArQs9GD0LHQtdGA0L3QsNGC0L7RgArQtNC+0LrQu9Cw0LQK0YHQvNC10L3QsArRg9Cx0LjQudGB0YLQstC+CtGN0LrRgdC/0LXRgNGCCtCw0LLRgtC+0LHRg9GBCtC/0LvQsNGC0YzQtQrQutCw0LTRgArRgtC10YLRjwrQvtCx0YnQtdC90LjQtQrQv9GB0LjRhdC+0LvQvtCz0LjRjwrQu9C10LIK0L/QvtGA0L7QswrQv9GA0L7QstC10YDQutCwCtC/0YDQvtGG0LXQtNGD0YDQsArRgNCw0LHQvtGH0LjQuQrRgNC10LzQvtC90YIK0L7QsdGA0LDRidC10L3QuNC1CtC+0LHRg9GH0LXQvdC40LUK0L7QttC40LTQsNC90LjQtQrQv9Cw0LzRj9GC0L3QuNC6CtC60L7RgNC10L3RjArQvdCw0LHQu9GO0LTQtdC90LjQtQrQsdGD0LrQstCwCtC00L7QutCw0LfQsNGC0LXQu9GM0YHRgtCy0L4K0L/RgNC40LfQvdCw0L3QuNC1CtC/0L7RgdGC0LXQu9GMCtGI0YLQsNCxCtCy0LvQsNC00LXQu9C10YYK0LrQvtC80L/RjNGO0YLQtdGACtC40L3QttC10L3QtdGACtGB0YLQsNGA0YPRhdCwCtC70L7QtNC60LAK0YDQsNC60LXRgtCwCtGB0LXRgNC40Y8K0YjRg9GC0LrQsArQstC10YDRiNC40L3QsArQstGL0L/Rg9GB0LoK0LrRg9C70LDQugrQu9C10LQK0YLQvtGA0LPQvtCy0LvRjwrQvdC10YTRgtGMCtC80L7Qu9C+0LTQtdC20YwK0YbQuNGE0YDQsArQutC+0YDQv9GD0YEK0L3QtdC00L7RgdGC0LDRgtC+0LoK0YHQsNC/0L7QswrRgdGD0YnQvdC+0YHRgtGMCtGC0LDQu9Cw0L3RggrRjdGE0YTQtdC60YLQuNCy0L3QvtGB0YLRjArQutC+0YTQtQrQv9C+0LvQvtGB0LAK0L7RgdC90L7QstC90L7QtQrRgNCw0YHRgdC80L7RgtGA0LXQvdC40LUK0YHQsdC+0YAK0YjRgtCw0YIK0YHQu9C10LTQvtCy0LDRgtC10LvRjArQttC40LvRjNC1CtC80LXRiNC+0LoK0L7Qv9C40YHQsNC90LjQtQrQutGD0YHRggrQvtGC0LrQsNC3CtC30LDQvNC+0LoK0YDQtdC00LDQutGC0L7RgArQtNCy0L7RgNC10YYK0LfQsNCx0L7RgtCwCtC/0LjQstC+CtC00LjQstCw0L0K0YHRgtC+0LvQuNC6CtGN0LrRgdC/0LXRgNC40LzQtdC90YIK0L/QtdGH0LDRgtGMCtC60L7Qu9GM0YbQvgrQv9C40YHRgtC+0LvQtdGCCtCy0L7RgdC/0LjRgtCw0L3QuNC1CtC90LDRh9Cw0LvRjNGB0YLQstC+CtC/0YDQvtGE0LXRgdGB0LjRjwrQstC+0YDQvtGC0LAK0LTQvtCx0YDQvgrQtNGA0YPQttCx0LAK0L/QvtC60L7QuQrRgNC40YHQugrQvtC60L7QvdGH0LDQvdC40LUK0LTRi9C8CtCx0YDQsNC6CtCy0LXQu9C40YfQuNC90LAK0LfQsNC/0LjRgdC60LAK0LjQvdC40YbQuNCw0YLQuNCy0LAK0YHQvtCy0LXRgdGC0YwK0LDQutGC0LjQstC90L7RgdGC0YwK0LrQvtGB0YLRjArRgdC/0L7RgNGCCtC60YDQtdC00LjRggrQs9C+0YHQv9C+0LTRjArQvNCw0LnQvtGACtC60L7QvdGE0LXRgNC10L3RhtC40Y8K0L/QvtGC0L7Qu9C+0LoK0LHQuNCx0LvQuNC+0YLQtdC60LAK0L/QvtC80L7RidC90LjQugrQutC+0L3RgdGC0YDRg9C60YbQuNGPCtC+0YLQtNGL0YUK0YDRg9GH0LrQsArQvNC10YLQsNC70LsK0LzQvtC70L7QutC+CtC/0YDQvtC60YPRgNC+0YAK0YLRgNCw0L3RgdC/0L7RgNGCCtC/0L7RjdC30LjRjwrRgdC+0LXQtNC40L3QtdC90LjQtQrQutGA0LDRgdC60LAK0YDQsNGB0YHRgtC+0Y/QvdC40LUK0LzQtdGH0YLQsArRgdC10LvQvgrQtdC00LAK0LfQu9C+CtC/0L7QtNGA0LDQt9C00LXQu9C10L3QuNC1CtGB0Y7QttC10YIK0YDRg9Cx0LXQtgrRgdC40LPQvdCw0LsK0LDRgtC80L7RgdGE0LXRgNCwCtC60YDQtdGB0YIK0LLQtdGBCtCy0LfRgNGL0LIK0LrQvtC90YLQsNC60YIK0YHQuNCz0LDRgNC10YLQsArQstC+0YHRgtC+0YDQswrQt9C+0LvQvtGC0L4K0L/QvtGH0LLQsArQv9GA0LXQvNC40Y8K0LrQvtGA0L7Qu9GMCtC/0L7QtNGK0LXQt9C0CtGI0LDQvdGBCtCw0LLRgtC+0LzQsNGCCtC30LDQutCw0LcK0LzQsNC70YzRh9C40YjQutCwCtC+0YfQutC4CtC80LjQswrRiNGC0YPQutCwCtGH0YLQtdC90LjQtQrQv9C+0YHQtdC70L7QugrRgdCy0LjQtNC10YLQtdC70YwK0YHRgtCw0LLQutCwCtGB0YPQvNC60LAK0YPQtNC40LLQu9C10L3QuNC1CtGF0LLQvtGB0YIK0L/QtdGB0L7QugrQv9C+0LLQvtGA0L7RggrQstC+0LfQstGA0LDRidC10L3QuNC1CtC80LPQvdC+0LLQtdC90LjQtQrRgdGC0LDRgtGD0YEK0L7Qt9C10YDQvgrRgdGC0YDQvtC5CtC/0LDRgNCw0LzQtdGC0YAK0YHQutCw0LfQutCwCtGC0LXQvdC00LXQvdGG0LjRjwrQstC40L3QsArQtNGL0YXQsNC90LjQtQrQstC10YDRgdC40Y8K0LzQsNGB0YjRgtCw0LEK0LzQvtC90LDRgdGC0YvRgNGMCtGF0L7Qt9GP0LnQutCwCtC00L7Rh9C60LAK0YLQsNC90LXRhgrRjdC60YHQv9C70YPQsNGC0LDRhtC40Y8K0LrQvtC80LzRg9C90LjRgdGCCtC/0LXQvdGB0LjRjwrQv9GA0LjRj9GC0LXQu9GMCtC+0LHRitGP0YHQvdC10L3QuNC1CtC90LDQsdC+0YAK0L/RgNC+0LjQt9Cy0L7QtNC40YLQtdC70YwK0L/Ri9C70YwK0YTQuNC70L7RgdC+0YTQuNGPCtC80L7RidC90L7RgdGC0YwK0L7QsdGP0LfQsNGC0LXQu9GM0YHRgtCy0L4K0YPRhdC+0LQK0LPQvtGA0LvQvgrQutGA0LjQt9C40YEK0YPQutCw0LfQsNC90LjQtQrQv9C70LDRgtCwCtGP0LHQu9C+0LrQvgrQv9GA0LXQv9Cw0YDQsNGCCtC00LXQudGB0YLQstC40YLQtdC70YzQvdC+0YHRgtGMCtC80L7RgdC60LLQuNGHCtC+0YHRgtCw0YLQvtC6CtC40LfQvtCx0YDQsNC20LXQvdC40LUK0YHQtNC10LvQutCwCtGB0L7Rh9C40L3QtdC90LjQtQrQv9C+0LrRg9C/0LDRgtC10LvRjArRgtCw0L3QugrQt9Cw0YLRgNCw0YLQsArRgdGC0YDQvtC60LAK0LXQtNC40L3QuNGG0LAK0L7QsdGA0LDQsdC+0YLQutCwCtGH0LXQvNC/0LjQvtC90LDRgg==",
-	"quotes/en":                             "WwogIHsKICAgICJ0ZXh0IjogIktpbmQgd29yZHMgZG8gbm90IGNvc3QgbXVjaC4gWWV0IHRoZXkgYWNjb21wbGlzaCBtdWNoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmxhaXNlIFBhc2NhbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFyZSBlaXRoZXIgcHJvZ3Jlc3Npbmcgb3IgcmV0cm9ncmFkaW5nIGFsbCB0aGUgd2hpbGUuIFRoZXJlIGlzIG5vIHN1Y2ggdGhpbmcgYXMgcmVtYWluaW5nIHN0YXRpb25hcnkgaW4gdGhpcyBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmFtZXMgRnJlZW1hbiBDbGFya2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFcnJvciBpcyBkaXNjaXBsaW5lIHRocm91Z2ggd2hpY2ggd2UgYWR2YW5jZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoYW5uaW5nIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXNuJ3Qgd2hlcmUgeW91IGNvbWUgZnJvbSwgaXQncyB3aGVyZSB5b3UncmUgZ29pbmcgdGhhdCBjb3VudHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGxhIEZpdHpnZXJhbGQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZXdhcmUgb2YgbWlzc2luZyBjaGFuY2VzOyBvdGhlcndpc2UgaXQgbWF5IGJlIGFsdG9nZXRoZXIgdG9vIGxhdGUgc29tZSBkYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmFueiBMaXN6dCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGRvZXMgbm90IG1hdHRlciBob3cgc2xvd2x5IHlvdSBnbyBhcyBsb25nIGFzIHlvdSBkbyBub3Qgc3RvcC4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSdsbCBub3Qgc2V0dGxlIGZvciBhbnl0aGluZyBsZXNzIHRoYW4geW91ciBiZXN0LCB5b3Ugd2lsbCBiZSBhbWF6ZWQgYXQgd2hhdCB5b3UgY2FuIGFjY29tcGxpc2ggaW4geW91ciBsaXZlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlZpbmNlIExvbWJhcmRpIgogIH0sCiAgewogICAgInRleHQiOiAiRm9ybXVsYSBmb3Igc3VjY2VzczogdW5kZXIgcHJvbWlzZSBhbmQgb3ZlciBkZWxpdmVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVG9tIFBldGVycyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGFyZSBwZW9wbGUgd2hvIGhhdmUgbW9uZXkgYW5kIHBlb3BsZSB3aG8gYXJlIHJpY2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb2NvIENoYW5lbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFueWJvZHkgY2FuIG1ha2UgaGlzdG9yeS4gT25seSBhIGdyZWF0IG1hbiBjYW4gd3JpdGUgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPc2NhciBXaWxkZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBtdXN0IHRyYWluIHlvdXIgaW50dWl0aW9uIC0geW91IG11c3QgdHJ1c3QgdGhlIHNtYWxsIHZvaWNlIGluc2lkZSB5b3Ugd2hpY2ggdGVsbHMgeW91IGV4YWN0bHkgd2hhdCB0byBzYXksIHdoYXQgdG8gZGVjaWRlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSW5ncmlkIEJlcmdtYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJSZXNwZWN0IGlzIG5vdCBzb21ldGhpbmcgdGhhdCB5b3UgY2FuIGFzayBmb3IsIGJ1eSBvciBib3Jyb3cuIFJlc3BlY3QgaXMgd2hhdCB5b3UgZWFybiBmcm9tIGVhY2ggcGVyc29uIG5vIG1hdHRlciB0aGVpciBiYWNrZ3JvdW5kIG9yIHN0YXR1cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgbmV2ZXIgd2FzIGEgZ29vZCBrbmlmZSBtYWRlIG9mIGJhZCBzdGVlbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJlbmphbWluIEZyYW5rbGluIgogIH0sCiAgewogICAgInRleHQiOiAiTWVuIG9mIHBlcnZlcnNlIG9waW5pb24gZG8gbm90IGtub3cgdGhlIGV4Y2VsbGVuY2Ugb2Ygd2hhdCBpcyBpbiB0aGVpciBoYW5kcywgdGlsbCBzb21lIG9uZSBkYXNoIGl0IGZyb20gdGhlbS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNvcGhvY2xlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRob3VzYW5kcyBvZiBjYW5kbGVzIGNhbiBiZSBsaXQgZnJvbSBhIHNpbmdsZSwgYW5kIHRoZSBsaWZlIG9mIHRoZSBjYW5kbGUgd2lsbCBub3QgYmUgc2hvcnRlbmVkLiBIYXBwaW5lc3MgbmV2ZXIgZGVjcmVhc2VzIGJ5IGJlaW5nIHNoYXJlZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhcHBpbmVzcyBpcyB0aGUgcmV3YXJkIHdlIGdldCBmb3IgbGl2aW5nIHRvIHRoZSBoaWdoZXN0IHJpZ2h0IHdlIGtub3cuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJNZWRpdGF0aW9uIGlzIHRoZSBkaXNzb2x1dGlvbiBvZiB0aG91Z2h0cyBpbiBldGVybmFsIGF3YXJlbmVzcyBvciBQdXJlIGNvbnNjaW91c25lc3Mgd2l0aG91dCBvYmplY3RpZmljYXRpb24sIGtub3dpbmcgd2l0aG91dCB0aGlua2luZywgbWVyZ2luZyBmaW5pdHVkZSBpbiBpbmZpbml0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIlZvbHRhaXJlIgogIH0sCiAgewogICAgInRleHQiOiAiU3RheWluZyBpbiBvbmUgcGxhY2UgaXMgdGhlIGJlc3QgcGF0aCB0byBiZSB0YWtlbiBvdmVyIGFuZCBzdXJwYXNzZWQgYnkgbWFueS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiSSB3aWxsIHByZXBhcmUgYW5kIHNvbWUgZGF5IG15IGNoYW5jZSB3aWxsIGNvbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBYnJhaGFtIExpbmNvbG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgeWVhcnMgdGVhY2ggbXVjaCB3aGljaCB0aGUgZGF5cyBuZXZlciBrbm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vIG1hbiB3YXMgZXZlciB3aXNlIGJ5IGNoYW5jZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNlbmVjYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhcHBpbmVzcyBjb21lcyB3aGVuIHlvdXIgd29yayBhbmQgd29yZHMgYXJlIG9mIGJlbmVmaXQgdG8geW91cnNlbGYgYW5kIG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxldCB1cyBiZSBncmF0ZWZ1bCB0byBwZW9wbGUgd2hvIG1ha2UgdXMgaGFwcHk7IHRoZXkgYXJlIHRoZSBjaGFybWluZyBnYXJkZW5lcnMgd2hvIG1ha2Ugb3VyIHNvdWxzIGJsb3Nzb20uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJjZWwgUHJvdXN0IgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnkgdGltZSB5b3Ugc21pbGUgYXQgc29tZW9uZSwgaXQgaXMgYW4gYWN0aW9uIG9mIGxvdmUsIGEgZ2lmdCB0byB0aGF0IHBlcnNvbiwgYSBiZWF1dGlmdWwgdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNb3RoZXIgVGVyZXNhIgogIH0sCiAgewogICAgInRleHQiOiAiVGFrZSB0aGluZ3MgYXMgdGhleSBhcmUuIFB1bmNoIHdoZW4geW91IGhhdmUgdG8gcHVuY2guIEtpY2sgd2hlbiB5b3UgaGF2ZSB0byBraWNrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJ1Y2UgTGVlIgogIH0sCiAgewogICAgInRleHQiOiAiTG9vayBmb3J3YXJkIHRvIHNwcmluZyBhcyBhIHRpbWUgd2hlbiB5b3UgY2FuIHN0YXJ0IHRvIHNlZSB3aGF0IG5hdHVyZSBoYXMgdG8gb2ZmZXIgb25jZSBhZ2Fpbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgYXJlIHdoYXQgd2UgcmVwZWF0ZWRseSBkby4gRXhjZWxsZW5jZSwgdGhlbiwgaXMgbm90IGFuIGFjdCwgYnV0IGEgaGFiaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBcmlzdG90bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgY29tZSB0byBhIGZvcmsgaW4gdGhlIHJvYWQsIHRha2UgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJLZWVwIHlvdXIgZXllcyBvbiB0aGUgc3RhcnMgYW5kIHlvdXIgZmVldCBvbiB0aGUgZ3JvdW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhlb2RvcmUgUm9vc2V2ZWx0IgogIH0sCiAgewogICAgInRleHQiOiAiRmFpbHVyZSBkb2Vzbid0IG1lYW4geW91IGFyZSBhIGZhaWx1cmUgaXQganVzdCBtZWFucyB5b3UgaGF2ZW4ndCBzdWNjZWVkZWQgeWV0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IFNjaHVsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiS25vd2xlZGdlIHJlc3RzIG5vdCB1cG9uIHRydXRoIGFsb25lLCBidXQgdXBvbiBlcnJvciBhbHNvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybCBKdW5nIgogIH0sCiAgewogICAgInRleHQiOiAiQW5kIGFzIHdlIGxldCBvdXIgb3duIGxpZ2h0IHNoaW5lLCB3ZSB1bmNvbnNjaW91c2x5IGdpdmUgb3RoZXIgcGVvcGxlIHBlcm1pc3Npb24gdG8gZG8gdGhlIHNhbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOZWxzb24gTWFuZGVsYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgYW0gZ2xhZCB0aGF0IEkgcGFpZCBzbyBsaXR0bGUgYXR0ZW50aW9uIHRvIGdvb2QgYWR2aWNlOyBoYWQgSSBhYmlkZWQgYnkgaXQgSSBtaWdodCBoYXZlIGJlZW4gc2F2ZWQgZnJvbSBzb21lIG9mIG15IG1vc3QgdmFsdWFibGUgbWlzdGFrZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFZG5hIE1pbGxheSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1pbmQgaXMgZXZlcnl0aGluZzogbXVzY2xlLCBwaWVjZXMgb2YgcnViYmVyLiBBbGwgdGhhdCBJIGFtLCBJIGFtIGJlY2F1c2Ugb2YgbXkgbWluZC4iLAogICAgImF0dHJpYnV0aW9uIjogIlBhYXZvIE51cm1pIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgbm90IGVub3VnaCB0byBoYXZlIGEgZ29vZCBtaW5kOyB0aGUgbWFpbiB0aGluZyBpcyB0byB1c2UgaXQgd2VsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJlbmUgRGVzY2FydGVzIgogIH0sCiAgewogICAgInRleHQiOiAiQ29tcGFzc2lvbiBhbmQgaGFwcGluZXNzIGFyZSBub3QgYSBzaWduIG9mIHdlYWtuZXNzIGJ1dCBhIHNpZ24gb2Ygc3RyZW5ndGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiR2VudWluZSBzaW5jZXJpdHkgb3BlbnMgcGVvcGxlJ3MgaGVhcnRzLCB3aGlsZSBtYW5pcHVsYXRpb24gY2F1c2VzIHRoZW0gdG8gY2xvc2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWlzYWt1IElrZWRhIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgZG8gd2hhdCB3ZSBkbyBiZWNhdXNlIHdlIGJlbGlldmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSBkb24ndCBrbm93IHdoYXQgeW91IGJlbGlldmUsIGV2ZXJ5dGhpbmcgYmVjb21lcyBhbiBhcmd1bWVudC4gRXZlcnl0aGluZyBpcyBkZWJhdGFibGUuIEJ1dCB3aGVuIHlvdSBzdGFuZCBmb3Igc29tZXRoaW5nLCBkZWNpc2lvbnMgYXJlIG9idmlvdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBY3Rpb24gd2lsbCByZW1vdmUgdGhlIGRvdWJ0cyB0aGF0IHRoZW9yeSBjYW5ub3Qgc29sdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUZWh5aSBIc2llaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdXIgbWluZCB3aWxsIGFuc3dlciBtb3N0IHF1ZXN0aW9ucyBpZiB5b3UgbGVhcm4gdG8gcmVsYXggYW5kIHdhaXQgZm9yIHRoZSBhbnN3ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIEJ1cnJvdWdocyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBjdXJlIGZvciBib3JlZG9tIGlzIGN1cmlvc2l0eS4gVGhlcmUgaXMgbm8gY3VyZSBmb3IgY3VyaW9zaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxsZW4gUGFyciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluc3BpcmF0aW9uIGV4aXN0cywgYnV0IGl0IGhhcyB0byBmaW5kIHVzIHdvcmtpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQYWJsbyBQaWNhc3NvIgogIH0sCiAgewogICAgInRleHQiOiAiVGhleSBzYXkgdGhhdCB0aW1lIGNoYW5nZXMgdGhpbmdzLCBidXQgeW91IGFjdHVhbGx5IGhhdmUgdG8gY2hhbmdlIHRoZW0geW91cnNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbmR5IFdhcmhvbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgbGl0dGxlIG1vcmUgcGVyc2lzdGVuY2UsIGEgbGl0dGxlIG1vcmUgZWZmb3J0LCBhbmQgd2hhdCBzZWVtZWQgaG9wZWxlc3MgZmFpbHVyZSBtYXkgdHVybiB0byBnbG9yaW91cyBzdWNjZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxiZXJ0IEh1YmJhcmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUYWtlIG5vIHRob3VnaHQgb2Ygd2hvIGlzIHJpZ2h0IG9yIHdyb25nIG9yIHdobyBpcyBiZXR0ZXIgdGhhbi4gQmUgbm90IGZvciBvciBhZ2FpbnN0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJ1Y2UgTGVlIgogIH0sCiAgewogICAgInRleHQiOiAiV2Uga25vdyB3aGF0IHdlIGFyZSwgYnV0IGtub3cgbm90IHdoYXQgd2UgbWF5IGJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgaGF2ZSBhbiBldmVyeWRheSByZWxpZ2lvbiB0aGF0IHdvcmtzIGZvciBtZS4gTG92ZSB5b3Vyc2VsZiBmaXJzdCwgYW5kIGV2ZXJ5dGhpbmcgZWxzZSBmYWxscyBpbnRvIGxpbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMdWNpbGxlIEJhbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgaGF2ZSBtYWRlIG1pc3Rha2VzLCB0aGVyZSBpcyBhbHdheXMgYW5vdGhlciBjaGFuY2UgZm9yIHlvdS4gWW91IG1heSBoYXZlIGEgZnJlc2ggc3RhcnQgYW55IG1vbWVudCB5b3UgY2hvb3NlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyeSBQaWNrZm9yZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBiZXN0IHRlYWNoZXIgaXMgZXhwZXJpZW5jZSBsZWFybmVkIGZyb20gZmFpbHVyZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNraWxsIHRvIGRvIGNvbWVzIG9mIGRvaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNjaWVuY2UgaXMgb3JnYW5pemVkIGtub3dsZWRnZS4gV2lzZG9tIGlzIG9yZ2FuaXplZCBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSW1tYW51ZWwgS2FudCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtb3N0IGNvbXBsaWNhdGVkIGFjaGlldmVtZW50cyBvZiB0aG91Z2h0IGFyZSBwb3NzaWJsZSB3aXRob3V0IHRoZSBhc3Npc3RhbmNlIG9mIGNvbnNjaW91c25lc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTaWdtdW5kIEZyZXVkIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyZWF0ZXN0IHdheSB0byBsaXZlIHdpdGggaG9ub3IgaW4gdGhpcyB3b3JsZCBpcyB0byBiZSB3aGF0IHdlIHByZXRlbmQgdG8gYmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTb2NyYXRlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5ldmVyIHRlbGwgYSB5b3VuZyBwZXJzb24gdGhhdCBhbnl0aGluZyBjYW5ub3QgYmUgZG9uZS4gR29kIG1heSBoYXZlIGJlZW4gd2FpdGluZyBjZW50dXJpZXMgZm9yIHNvbWVvbmUgaWdub3JhbnQgZW5vdWdoIG9mIHRoZSBpbXBvc3NpYmxlIHRvIGRvIHRoYXQgdmVyeSB0aGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gSG9sbWVzIgogIH0sCiAgewogICAgInRleHQiOiAiTWlyYWNsZXMgY29tZSBpbiBtb21lbnRzLiBCZSByZWFkeSBhbmQgd2lsbGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSBpcyBhYmxlIHdobyB0aGlua3MgaGUgaXMgYWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5IG1hbiB0YWtlcyB0aGUgbGltaXRzIG9mIGhpcyBvd24gZmllbGQgb2YgdmlzaW9uIGZvciB0aGUgbGltaXRzIG9mIHRoZSB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFydGh1ciBTY2hvcGVuaGF1ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgaGF2ZSBwb3dlciBvdmVyIHlvdXIgbWluZCAtIG5vdCBvdXRzaWRlIGV2ZW50cy4gUmVhbGl6ZSB0aGlzLCBhbmQgeW91IHdpbGwgZmluZCBzdHJlbmd0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUncyBjaGFsbGVuZ2VzIGFyZSBub3Qgc3VwcG9zZWQgdG8gcGFyYWx5c2UgeW91LCB0aGV5J3JlIHN1cHBvc2VkIHRvIGhlbHAgeW91IGRpc2NvdmVyIHdobyB5b3UgYXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVybmljZSBSZWFnb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBuZXZlciB0b28gbGF0ZSB0byBiZSB3aGF0IHlvdSBtaWdodCBoYXZlIGJlZW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZ2UgRWxpb3QiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMZXQgdXMgYWx3YXlzIG1lZXQgZWFjaCBvdGhlciB3aXRoIHNtaWxlLCBmb3IgdGhlIHNtaWxlIGlzIHRoZSBiZWdpbm5pbmcgb2YgbG92ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vdGhlciBUZXJlc2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgb25seSBsaW1pdCB0byB5b3VyIGltcGFjdCBpcyB5b3VyIGltYWdpbmF0aW9uIGFuZCBjb21taXRtZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVG9ueSBSb2JiaW5zIgogIH0sCiAgewogICAgInRleHQiOiAiQmVpbmcgYW5ncnkgbmV2ZXIgc29sdmVzIGFueXRoaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2F0aGVyaW5lIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiT3VyIGRlZXBlc3Qgd2lzaGVzIGFyZSB3aGlzcGVycyBvZiBvdXIgYXV0aGVudGljIHNlbHZlcy4gV2UgbXVzdCBsZWFybiB0byByZXNwZWN0IHRoZW0uIFdlIG11c3QgbGVhcm4gdG8gbGlzdGVuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2FyYWggQnJlYXRobmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGNhbiBkbyBubyBncmVhdCB0aGluZ3MsIG9ubHkgc21hbGwgdGhpbmdzIHdpdGggZ3JlYXQgbG92ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vdGhlciBUZXJlc2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBub3RoaW5nIGluIGEgY2F0ZXJwaWxsYXIgdGhhdCB0ZWxscyB5b3UgaXQncyBnb2luZyB0byBiZSBhIGJ1dHRlcmZseS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1Y2ttaW5zdGVyIEZ1bGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBjYW5ub3QgZG8gZ3JlYXQgdGhpbmdzLCBkbyBzbWFsbCB0aGluZ3MgaW4gYSBncmVhdCB3YXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiTWFueSBwZW9wbGUgdGhpbmsgb2YgcHJvc3Blcml0eSB0aGF0IGNvbmNlcm5zIG1vbmV5IG9ubHkgdG8gZm9yZ2V0IHRoYXQgdHJ1ZSBwcm9zcGVyaXR5IGlzIG9mIHRoZSBtaW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3VyIHZpc2lvbiB3aWxsIGJlY29tZSBjbGVhciBvbmx5IHdoZW4geW91IGxvb2sgaW50byB5b3VyIGhlYXJ0LiBXaG8gbG9va3Mgb3V0c2lkZSwgZHJlYW1zLiBXaG8gbG9va3MgaW5zaWRlLCBhd2FrZW5zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybCBKdW5nIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCB3ZSBzZWUgaXMgbWFpbmx5IHdoYXQgd2UgbG9vayBmb3IuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBiZSBhYmxlIHRvIGdpdmUgYXdheSByaWNoZXMgaXMgbWFuZGF0b3J5IGlmIHlvdSB3aXNoIHRvIHBvc3Nlc3MgdGhlbS4gVGhpcyBpcyB0aGUgb25seSB3YXkgdGhhdCB5b3Ugd2lsbCBiZSB0cnVseSByaWNoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFodW1tYWQgQWxpIgogIH0sCiAgewogICAgInRleHQiOiAiTW9tZW50cyBvZiBjb21wbGV0ZSBhcGF0aHkgYXJlIHRoZSBiZXN0IGZvciBuZXcgY3JlYXRpb25zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGhpbGlwIEJyZWVkdmVsZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGxlYWQgcGVvcGxlIHdhbGsgYmVoaW5kIHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgYXJlIHR3byBraW5kcyBvZiBmYWlsdXJlczogdGhvc2Ugd2hvIHRob3VnaHQgYW5kIG5ldmVyIGRpZCwgYW5kIHRob3NlIHdobyBkaWQgYW5kIG5ldmVyIHRob3VnaHQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYXVyZW5jZSBKLiBQZXRlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdXIgZnV0dXJlIGRlcGVuZHMgb24gbWFueSB0aGluZ3MsIGJ1dCBtb3N0bHkgb24geW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbmsgVHlnZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb2JvZHkgY2FuIGRvIGV2ZXJ5dGhpbmcsIGJ1dCBldmVyeWJvZHkgY2FuIGRvIHNvbWV0aGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgZGF5IG9mIHdvcnJ5IGlzIG1vcmUgZXhoYXVzdGluZyB0aGFuIGEgZGF5IG9mIHdvcmsuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIEx1YmJvY2siCiAgfSwKICB7CiAgICAidGV4dCI6ICJMb2dpYyB3aWxsIGdldCB5b3UgZnJvbSBBIHRvIEIuIEltYWdpbmF0aW9uIHdpbGwgdGFrZSB5b3UgZXZlcnl3aGVyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWV0aW1lcyB0aGUgY2FyZHMgd2UgYXJlIGRlYWx0IGFyZSBub3QgYWx3YXlzIGZhaXIuIEhvd2V2ZXIgeW91IG11c3Qga2VlcCBzbWlsaW5nIGFuZCBtb3Zpbmcgb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb20gSmFja3NvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSdyZSBuZXZlciBhIGxvc2VyIHVudGlsIHlvdSBxdWl0IHRyeWluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1pa2UgRGl0a2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYmVzdCB3YXkgdG8gcGF5IGZvciBhIGxvdmVseSBtb21lbnQgaXMgdG8gZW5qb3kgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgd2F5IGlzIG5vdCBpbiB0aGUgc2t5LiBUaGUgd2F5IGlzIGluIHRoZSBoZWFydC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGJlIGJlYXV0aWZ1bCBtZWFucyB0byBiZSB5b3Vyc2VsZi4gWW91IGRvbid0IG5lZWQgdG8gYmUgYWNjZXB0ZWQgYnkgb3RoZXJzLiBZb3UgbmVlZCB0byBhY2NlcHQgeW91cnNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaGljaCBOaGF0IEhhbmgiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVyZXZlciBhIG1hbiB0dXJucyBoZSBjYW4gZmluZCBzb21lb25lIHdobyBuZWVkcyBoaW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgU2Nod2VpdHplciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZlYXIgb2YgZmFpbHVyZSBpcyBvbmUgYXR0aXR1ZGUgdGhhdCB3aWxsIGtlZXAgeW91IGF0IHRoZSBzYW1lIHBvaW50IGluIHlvdXIgbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiRnJpZW5kcyBhcmUgdGhvc2UgcmFyZSBwZW9wbGUgd2hvIGFzayBob3cgd2UgYXJlIGFuZCB0aGVuIHdhaXQgdG8gaGVhciB0aGUgYW5zd2VyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWQgQ3VubmluZ2hhbSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFyZSBhbGwgaW5jbGluZWQgdG8ganVkZ2Ugb3Vyc2VsdmVzIGJ5IG91ciBpZGVhbHM7IG90aGVycywgYnkgdGhlaXIgYWN0cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkhhcm9sZCBOaWNvbHNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkF0IHRoZSBjZW50ZXIgb2YgeW91ciBiZWluZyB5b3UgaGF2ZSB0aGUgYW5zd2VyOyB5b3Uga25vdyB3aG8geW91IGFyZSBhbmQgeW91IGtub3cgd2hhdCB5b3Ugd2FudC4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBcyBsb25nIGFzIHlvdXIgZ29pbmcgdG8gYmUgdGhpbmtpbmcgYW55d2F5LCB0aGluayBiaWcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb25hbGQgVHJ1bXAiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGb3IgZXZlcnkgZmFpbHVyZSwgdGhlcmUncyBhbiBhbHRlcm5hdGl2ZSBjb3Vyc2Ugb2YgYWN0aW9uLiBZb3UganVzdCBoYXZlIHRvIGZpbmQgaXQuIFdoZW4geW91IGNvbWUgdG8gYSByb2FkYmxvY2ssIHRha2UgYSBkZXRvdXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJ5IEtheSBBc2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBiZSBkaXNtYXllZCBieSBnb29kLWJ5ZXMuIEEgZmFyZXdlbGwgaXMgbmVjZXNzYXJ5IGJlZm9yZSB5b3UgY2FuIG1lZXQgYWdhaW4uIEFuZCBtZWV0aW5nIGFnYWluLCBhZnRlciBtb21lbnRzIG9yIGxpZmV0aW1lcywgaXMgY2VydGFpbiBmb3IgdGhvc2Ugd2hvIGFyZSBmcmllbmRzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHVuZGVydGFraW5nIG9mIGEgbmV3IGFjdGlvbiBicmluZ3MgbmV3IHN0cmVuZ3RoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBFdmFucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGF2b2lkIGNyaXRpY2lzbSwgZG8gbm90aGluZywgc2F5IG5vdGhpbmcsIGJlIG5vdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGJlcnQgSHViYmFyZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNob290IGZvciB0aGUgbW9vbi4gRXZlbiBpZiB5b3UgbWlzcywgeW91J2xsIGxhbmQgYW1vbmcgdGhlIHN0YXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGVzIEJyb3duIgogIH0sCiAgewogICAgInRleHQiOiAiWWVzdGVyZGF5IGlzIGhpc3RvcnkuIFRvbW9ycm93IGlzIGEgbXlzdGVyeS4gQW5kIHRvZGF5PyBUb2RheSBpcyBhIGdpZnQuIFRoYXQgaXMgd2h5IHdlIGNhbGwgaXQgdGhlIHByZXNlbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCYWJhdHVuZGUgT2xhdHVuamkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8gYW5nZXJzIHlvdSBjb25xdWVycyB5b3UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGl6YWJldGggS2VubnkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyB0aGUgcXVhbGl0eSBvZiBvdXIgd29yayB3aGljaCB3aWxsIHBsZWFzZSBHb2QsIG5vdCB0aGUgcXVhbnRpdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYWhhdG1hIEdhbmRoaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGdldCB0aGUgZnVsbCB2YWx1ZSBvZiBqb3kgeW91IG11c3QgaGF2ZSBzb21lb25lIHRvIGRpdmlkZSBpdCB3aXRoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyayBUd2FpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtb21lbnQgb25lIGdpdmVzIGNsb3NlIGF0dGVudGlvbiB0byBhbnl0aGluZywgZXZlbiBhIGJsYWRlIG9mIGdyYXNzLCBpdCBiZWNvbWVzIGEgbXlzdGVyaW91cywgYXdlc29tZSwgaW5kZXNjcmliYWJseSBtYWduaWZpY2VudCB3b3JsZCBpbiBpdHNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yeSBNaWxsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZWluZyBpbiBodW1hbmVuZXNzIGlzIGdvb2QuIElmIHdlIHNlbGVjdCBvdGhlciBnb29kbmVzcyBhbmQgdGh1cyBhcmUgZmFyIGFwYXJ0IGZyb20gaHVtYW5lbmVzcywgaG93IGNhbiB3ZSBiZSB0aGUgd2lzZT8iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gb25lIHR1Z3MgYXQgYSBzaW5nbGUgdGhpbmcgaW4gbmF0dXJlLCBoZSBmaW5kcyBpdCBhdHRhY2hlZCB0byB0aGUgcmVzdCBvZiB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIE11aXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNYW55IG9mIGxpZmUncyBmYWlsdXJlcyBhcmUgcGVvcGxlIHdobyBkaWQgbm90IHJlYWxpemUgaG93IGNsb3NlIHRoZXkgd2VyZSB0byBzdWNjZXNzIHdoZW4gdGhleSBnYXZlIHVwLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIEVkaXNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhlcmUgaXMgb25lIHF1YWxpdHkgdGhhdCBvbmUgbXVzdCBwb3NzZXNzIHRvIHdpbiwgYW5kIHRoYXQgaXMgZGVmaW5pdGVuZXNzIG9mIHB1cnBvc2UsIHRoZSBrbm93bGVkZ2Ugb2Ygd2hhdCBvbmUgd2FudHMsIGFuZCBhIGJ1cm5pbmcgZGVzaXJlIHRvIHBvc3Nlc3MgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiQSBnb29kIHBsYW4gdG9kYXkgaXMgYmV0dGVyIHRoYW4gYSBwZXJmZWN0IHBsYW4gdG9tb3Jyb3cuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYmVnaW5uaW5nIG9mIGtub3dsZWRnZSBpcyB0aGUgZGlzY292ZXJ5IG9mIHNvbWV0aGluZyB3ZSBkbyBub3QgdW5kZXJzdGFuZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkZyYW5rIEhlcmJlcnQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdXIgZGVzaXJlcyBhcmUgc3Ryb25nIGVub3VnaCB5b3Ugd2lsbCBhcHBlYXIgdG8gcG9zc2VzcyBzdXBlcmh1bWFuIHBvd2VycyB0byBhY2hpZXZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIGVhc2llciB0byBsaXZlIHRocm91Z2ggc29tZW9uZSBlbHNlIHRoYW4gdG8gYmVjb21lIGNvbXBsZXRlIHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmV0dHkgRnJpZWRhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxldHRpbmcgZ28gaXNuJ3QgdGhlIGVuZCBvZiB0aGUgd29ybGQ7IGl0J3MgdGhlIGJlZ2lubmluZyBvZiBhIG5ldyBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIGdyZWF0IG1lbiBhcmUgZ2lmdGVkIHdpdGggaW50dWl0aW9uLiBUaGV5IGtub3cgd2l0aG91dCByZWFzb25pbmcgb3IgYW5hbHlzaXMsIHdoYXQgdGhleSBuZWVkIHRvIGtub3cuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGV4aXMgQ2FycmVsIgogIH0sCiAgewogICAgInRleHQiOiAiQSBwcnVkZW50IHF1ZXN0aW9uIGlzIG9uZSBoYWxmIG9mIHdpc2RvbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkZyYW5jaXMgQmFjb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBnZXQgc29tZXRoaW5nIHlvdSBuZXZlciBoYWQsIHlvdSBoYXZlIHRvIGRvIHNvbWV0aGluZyB5b3UgbmV2ZXIgZGlkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHVuaXZlcnNlIGlzIHRyYW5zZm9ybWF0aW9uOyBvdXIgbGlmZSBpcyB3aGF0IG91ciB0aG91Z2h0cyBtYWtlIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyY3VzIEF1cmVsaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiQWNjZXB0IGNoYWxsZW5nZXMsIHNvIHRoYXQgeW91IG1heSBmZWVsIHRoZSBleGhpbGFyYXRpb24gb2YgdmljdG9yeS4iLAogICAgImF0dHJpYnV0aW9uIjogIkdlb3JnZSBQYXR0b24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJMZXQgZ28gb2YgeW91ciBhdHRhY2htZW50IHRvIGJlaW5nIHJpZ2h0LCBhbmQgc3VkZGVubHkgeW91ciBtaW5kIGlzIG1vcmUgb3Blbi4gWW91J3JlIGFibGUgdG8gYmVuZWZpdCBmcm9tIHRoZSB1bmlxdWUgdmlld3BvaW50cyBvZiBvdGhlcnMsIHdpdGhvdXQgYmVpbmcgY3JpcHBsZWQgYnkgeW91ciBvd24ganVkZ2VtZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggTWFyc3RvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRpbWUgaXMgdGhlIG1vc3QgdmFsdWFibGUgdGhpbmcgYSBtYW4gY2FuIHNwZW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhlb3BocmFzdHVzIgogIH0sCiAgewogICAgInRleHQiOiAiSSBiZWxpZXZlIGluIG9uZSB0aGluZyBvbmx5LCB0aGUgcG93ZXIgb2YgaHVtYW4gd2lsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvc2VwaCBTdGFsaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBubyBmYWlsdXJlIGV4Y2VwdCBpbiBubyBsb25nZXIgdHJ5aW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxiZXJ0IEh1YmJhcmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGluIGxpZmUgaXMgdG8gYmUgZmVhcmVkLiBJdCBpcyBvbmx5IHRvIGJlIHVuZGVyc3Rvb2QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJpZSBDdXJpZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4geW91IHNlZSBhIGdvb2QgcGVyc29uLCB0aGluayBvZiBiZWNvbWluZyBsaWtlIGhpbS4gV2hlbiB5b3Ugc2VlIHNvbWVvbmUgbm90IHNvIGdvb2QsIHJlZmxlY3Qgb24geW91ciBvd24gd2VhayBwb2ludHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTb29uZXIgb3IgbGF0ZXIsIHRob3NlIHdobyB3aW4gYXJlIHRob3NlIHdobyB0aGluayB0aGV5IGNhbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpY2hhcmQgQmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRydWUgaGFwcGluZXNzIG1lYW5zIGZvcmdpbmcgYSBzdHJvbmcgc3Bpcml0IHRoYXQgaXMgdW5kZWZlYXRlZCwgbm8gbWF0dGVyIGhvdyB0cnlpbmcgb3VyIGNpcmN1bXN0YW5jZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWlzYWt1IElrZWRhIgogIH0sCiAgewogICAgInRleHQiOiAiS2luZCB3b3JkcyB3aWxsIHVubG9jayBhbiBpcm9uIGRvb3IuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUdXJraXNoIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJJ20gbm90IGludGVyZXN0ZWQgaW4gYWdlLiBQZW9wbGUgd2hvIHRlbGwgbWUgdGhlaXIgYWdlIGFyZSBzaWxseS4gWW91J3JlIGFzIG9sZCBhcyB5b3UgZmVlbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkVsaXphYmV0aCBBcmRlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBncmVhdGVzdCBtaW5kcyBhcmUgY2FwYWJsZSBvZiB0aGUgZ3JlYXRlc3QgdmljZXMgYXMgd2VsbCBhcyBvZiB0aGUgZ3JlYXRlc3QgdmlydHVlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlJlbmUgRGVzY2FydGVzIgogIH0sCiAgewogICAgInRleHQiOiAiQXMgd2UgZXhwcmVzcyBvdXIgZ3JhdGl0dWRlLCB3ZSBtdXN0IG5ldmVyIGZvcmdldCB0aGF0IHRoZSBoaWdoZXN0IGFwcHJlY2lhdGlvbiBpcyBub3QgdG8gdXR0ZXIgd29yZHMsIGJ1dCB0byBsaXZlIGJ5IHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIEYuIEtlbm5lZHkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgaW5kdXN0cmlhbCBsYW5kc2NhcGUgaXMgYWxyZWFkeSBsaXR0ZXJlZCB3aXRoIHJlbWFpbnMgb2Ygb25jZSBzdWNjZXNzZnVsIGNvbXBhbmllcyB0aGF0IGNvdWxkIG5vdCBhZGFwdCB0aGVpciBzdHJhdGVnaWMgdmlzaW9uIHRvIGFsdGVyZWQgY29uZGl0aW9ucyBvZiBjb21wZXRpdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkFiZXJuYXRoeSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlN0cm9uZyBwZW9wbGUgbWFrZSBhcyBtYW55IG1pc3Rha2VzIGFzIHdlYWsgcGVvcGxlLiBEaWZmZXJlbmNlIGlzIHRoYXQgc3Ryb25nIHBlb3BsZSBhZG1pdCB0aGVpciBtaXN0YWtlcywgbGF1Z2ggYXQgdGhlbSwgbGVhcm4gZnJvbSB0aGVtLiBUaGF0IGlzIGhvdyB0aGV5IGJlY29tZSBzdHJvbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIE5lZWRoYW0iCiAgfSwKICB7CiAgICAidGV4dCI6ICJGcmVlZG9tIGlzIHRoZSByaWdodCB0byBsaXZlIGFzIHdlIHdpc2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbnR1aXRpb24gd2lsbCB0ZWxsIHRoZSB0aGlua2luZyBtaW5kIHdoZXJlIHRvIGxvb2sgbmV4dC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvbmFzIFNhbGsiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYmVzdCB0aGluZyBhYm91dCB0aGUgZnV0dXJlIGlzIHRoYXQgaXQgb25seSBjb21lcyBvbmUgZGF5IGF0IGEgdGltZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFicmFoYW0gTGluY29sbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1heGltIGZvciBsaWZlOiBZb3UgZ2V0IHRyZWF0ZWQgaW4gbGlmZSB0aGUgd2F5IHlvdSB0ZWFjaCBwZW9wbGUgdG8gdHJlYXQgeW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0J3MgZWFzaWVyIHRvIHNlZSB0aGUgbWlzdGFrZXMgb24gc29tZW9uZSBlbHNlJ3MgcGFwZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeXRoaW5nIGlzIHBlcmZlY3QgaW4gdGhlIHVuaXZlcnNlIC0gZXZlbiB5b3VyIGRlc2lyZSB0byBpbXByb3ZlIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdXIgYWN0aW9ucyBpbnNwaXJlIG90aGVycyB0byBkcmVhbSBtb3JlLCBsZWFybiBtb3JlLCBkbyBtb3JlIGFuZCBiZWNvbWUgbW9yZSwgeW91IGFyZSBhIGxlYWRlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gUXVpbmN5IEFkYW1zIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnkgZ3JlYXQgbWlzdGFrZSBoYXMgYSBoYWxmd2F5IG1vbWVudCwgYSBzcGxpdCBzZWNvbmQgd2hlbiBpdCBjYW4gYmUgcmVjYWxsZWQgYW5kIHBlcmhhcHMgcmVtZWRpZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQZWFybCBCdWNrIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gZGFyZSBpcyB0byBsb3NlIG9uZXMgZm9vdGluZyBtb21lbnRhcmlseS4gVG8gbm90IGRhcmUgaXMgdG8gbG9zZSBvbmVzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU29yZW4gS2llcmtlZ2FhcmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHbyB0byB5b3VyIGJvc29tOiBLbm9jayB0aGVyZSwgYW5kIGFzayB5b3VyIGhlYXJ0IHdoYXQgaXQgZG90aCBrbm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBoYXZlIHRvIHRoaW5rIGFueXdheSwgc28gd2h5IG5vdCB0aGluayBiaWc/IiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb25hbGQgVHJ1bXAiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUcnkgYW5kIGZhaWwsIGJ1dCBkb24ndCBmYWlsIHRvIHRyeS4iLAogICAgImF0dHJpYnV0aW9uIjogIlN0ZXBoZW4gS2FnZ3dhIgogIH0sCiAgewogICAgInRleHQiOiAiQWx3YXlzIHNlZWsgb3V0IHRoZSBzZWVkIG9mIHRyaXVtcGggaW4gZXZlcnkgYWR2ZXJzaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiT2cgTWFuZGlubyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGZvbGxvdywgd2l0aG91dCBoYWx0LCBvbmUgYWltOiBUaGVyZSBpcyB0aGUgc2VjcmV0IG9mIHN1Y2Nlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbm5hIFBhdmxvdmEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgcmVhc29uIG1vc3QgZ29hbHMgYXJlIG5vdCBhY2hpZXZlZCBpcyB0aGF0IHdlIHNwZW5kIG91ciB0aW1lIGRvaW5nIHNlY29uZCB0aGluZ3MgZmlyc3QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgTWNLYWluIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgYXJlIHR3byBwcmltYXJ5IGNob2ljZXMgaW4gbGlmZTogdG8gYWNjZXB0IGNvbmRpdGlvbnMgYXMgdGhleSBleGlzdCwgb3IgYWNjZXB0IHRoZSByZXNwb25zaWJpbGl0eSBmb3IgY2hhbmdpbmcgdGhlbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRlbmlzIFdhaXRsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJObyBwZXJzb24gaXMgeW91ciBmcmllbmQgd2hvIGRlbWFuZHMgeW91ciBzaWxlbmNlLCBvciBkZW5pZXMgeW91ciByaWdodCB0byBncm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxpY2UgV2Fsa2VyIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnl0aGluZyBpbiB0aGUgdW5pdmVyc2UgZ29lcyBieSBpbmRpcmVjdGlvbi4gVGhlcmUgYXJlIG5vIHN0cmFpZ2h0IGxpbmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBkZWVwZXN0IGNyYXZpbmcgb2YgaHVtYW4gbmF0dXJlIGlzIHRoZSBuZWVkIHRvIGJlIGFwcHJlY2lhdGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBKYW1lcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk15IHJlcHV0YXRpb24gZ3Jvd3Mgd2l0aCBldmVyeSBmYWlsdXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiR2VvcmdlIFNoYXciCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgbWluZCBpcyBldmVyeXRoaW5nLiBXaGF0IHlvdSB0aGluayB5b3UgYmVjb21lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiWWVhaCB3ZSBhbGwgc2hpbmUgb24sIGxpa2UgdGhlIG1vb24sIGFuZCB0aGUgc3RhcnMsIGFuZCB0aGUgc3VuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBMZW5ub24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBmYXRhbCB0byBlbnRlciBhbnkgd2FyIHdpdGhvdXQgdGhlIHdpbGwgdG8gd2luIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiR2VuZXJhbCBEb3VnbGFzIE1hY0FydGh1ciIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1vc3QgZm9sa3MgYXJlIGFib3V0IGFzIGhhcHB5IGFzIHRoZXkgbWFrZSB1cCB0aGVpciBtaW5kcyB0byBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFicmFoYW0gTGluY29sbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWUgcGVvcGxlIHRoaW5rIGl0J3MgaG9sZGluZyB0aGF0IG1ha2VzIG9uZSBzdHJvbmcgLSBzb21ldGltZXMgaXQncyBsZXR0aW5nIGdvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IHNwZW5kIHlvdXIgd2hvbGUgbGlmZSB3YWl0aW5nIGZvciB0aGUgc3Rvcm0sIHlvdSdsbCBuZXZlciBlbmpveSB0aGUgc3Vuc2hpbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNb3JyaXMgV2VzdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBoYXBwaW5lc3Mgb2YgYSBtYW4gaW4gdGhpcyBsaWZlIGRvZXMgbm90IGNvbnNpc3QgaW4gdGhlIGFic2VuY2UgYnV0IGluIHRoZSBtYXN0ZXJ5IG9mIGhpcyBwYXNzaW9ucy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsZnJlZCBUZW5ueXNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBkaWZmaWN1bHRpZXMgb2YgbGlmZSBhcmUgaW50ZW5kZWQgdG8gbWFrZSB1cyBiZXR0ZXIsIG5vdCBiaXR0ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBc2sgeW91cnNlbGYgdGhlIHNlY3JldCBvZiB5b3VyIHN1Y2Nlc3MuIExpc3RlbiB0byB5b3VyIGFuc3dlciwgYW5kIHByYWN0aWNlIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGNvbnRyb2xzIG90aGVycyBtYXkgYmUgcG93ZXJmdWwsIGJ1dCBoZSB3aG8gaGFzIG1hc3RlcmVkIGhpbXNlbGYgaXMgbWlnaHRpZXIgc3RpbGwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIHRydXRocyBhcmUgZWFzeSB0byB1bmRlcnN0YW5kIG9uY2UgdGhleSBhcmUgZGlzY292ZXJlZDsgdGhlIHBvaW50IGlzIHRvIGRpc2NvdmVyIHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHYWxpbGVvIEdhbGlsZWkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCb2RpbHkgZXhlcmNpc2UsIHdoZW4gY29tcHVsc29yeSwgZG9lcyBubyBoYXJtIHRvIHRoZSBib2R5OyBidXQga25vd2xlZGdlIHdoaWNoIGlzIGFjcXVpcmVkIHVuZGVyIGNvbXB1bHNpb24gb2J0YWlucyBubyBob2xkIG9uIHRoZSBtaW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGxhdG8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJWZXJ5IGxpdHRsZSBpcyBuZWVkZWQgdG8gbWFrZSBhIGhhcHB5IGxpZmU7IGl0IGlzIGFsbCB3aXRoaW4geW91cnNlbGYsIGluIHlvdXIgd2F5IG9mIHRoaW5raW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyY3VzIEF1cmVsaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiSSBhbSBub3QgYm90aGVyZWQgYnkgdGhlIGZhY3QgdGhhdCBJIGFtIHVua25vd24uIEkgYW0gYm90aGVyZWQgd2hlbiBJIGRvIG5vdCBrbm93IG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIHNsb3cgb2YgdG9uZ3VlIGFuZCBxdWljayBvZiBleWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDZXJ2YW50ZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbWFnaW5hdGlvbiBpcyB0aGUgbGl2aW5nIHBvd2VyIGFuZCBwcmltZSBhZ2VudCBvZiBhbGwgaHVtYW4gcGVyY2VwdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlNhbXVlbCBUYXlsb3IgQ29sZXJpZGdlIgogIH0sCiAgewogICAgInRleHQiOiAiQWx3YXlzIGJlYXIgaW4gbWluZCB0aGF0IHlvdXIgb3duIHJlc29sdXRpb24gdG8gc3VjY2VlZCBpcyBtb3JlIGltcG9ydGFudCB0aGFuIGFueSBvbmUgdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBYnJhaGFtIExpbmNvbG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGdvYWwgaXMgYSBkcmVhbSB3aXRoIGEgZGVhZGxpbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnkgbmV3IGRheSBpcyBhbm90aGVyIGNoYW5jZSB0byBjaGFuZ2UgeW91ciBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiRG8gb25lIHRoaW5nIGV2ZXJ5IGRheSB0aGF0IHNjYXJlcyB5b3UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGVhbm9yIFJvb3NldmVsdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgYmVsaWV2ZSB0aGF0IGEgc2ltcGxlIGFuZCB1bmFzc3VtaW5nIG1hbm5lciBvZiBsaWZlIGlzIGJlc3QgZm9yIGV2ZXJ5b25lLCBiZXN0IGJvdGggZm9yIHRoZSBib2R5IGFuZCB0aGUgbWluZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhvdyB3b25kZXJmdWwgaXQgaXMgdGhhdCBub2JvZHkgbmVlZCB3YWl0IGEgc2luZ2xlIG1vbWVudCBiZWZvcmUgc3RhcnRpbmcgdG8gaW1wcm92ZSB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbm5lIEZyYW5rIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGNhbm5vdCBtYWtlIHlvdXJzZWxmIGZlZWwgc29tZXRoaW5nIHlvdSBkbyBub3QgZmVlbCwgYnV0IHlvdSBjYW4gbWFrZSB5b3Vyc2VsZiBkbyByaWdodCBpbiBzcGl0ZSBvZiB5b3VyIGZlZWxpbmdzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVhcmwgQnVjayIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUgaXMgMTAlIHdoYXQgaGFwcGVucyB0byB5b3UgYW5kIDkwJSBob3cgeW91IHJlYWN0IHRvIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hhcmxlcyBTd2luZG9sbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGFyZSBubyBmYWlsdXJlcyAtIGp1c3QgZXhwZXJpZW5jZXMgYW5kIHlvdXIgcmVhY3Rpb25zIHRvIHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb20gS3JhdXNlIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCBzZXBhcmF0ZXMgdGhlIHdpbm5lcnMgZnJvbSB0aGUgbG9zZXJzIGlzIGhvdyBhIHBlcnNvbiByZWFjdHMgdG8gZWFjaCBuZXcgdHdpc3Qgb2YgZmF0ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRvbmFsZCBUcnVtcCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRyYW5zZm9ybWF0aW9uIGRvZXMgbm90IHN0YXJ0IHdpdGggc29tZSBvbmUgZWxzZSBjaGFuZ2luZyB5b3U7IHRyYW5zZm9ybWF0aW9uIGlzIGFuIGlubmVyIHNlbGYgcmV3b3JraW5nIG9mIHdoYXQgeW91IGFyZSBub3cgdG8gd2hhdCB5b3Ugd2lsbCBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiSW50byBlYWNoIGxpZmUgcmFpbiBtdXN0IGZhbGwgYnV0IHJhaW4gY2FuIGJlIHRoZSBnaXZlciBvZiBsaWZlIGFuZCBpdCBpcyBhbGwgaW4geW91ciBhdHRpdHVkZSB0aGF0IG1ha2VzIHJhaW4gcHJvZHVjZSBzdW5zaGluZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiTmF0dXJlIHRha2VzIGF3YXkgYW55IGZhY3VsdHkgdGhhdCBpcyBub3QgdXNlZC4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gUi4gSW5nZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIktub3cgaG93IHRvIGxpc3RlbiwgYW5kIHlvdSB3aWxsIHByb2ZpdCBldmVuIGZyb20gdGhvc2Ugd2hvIHRhbGsgYmFkbHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQbHV0YXJjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoaW5ncyB0aGF0IHdlcmUgaGFyZCB0byBiZWFyIGFyZSBzd2VldCB0byByZW1lbWJlci4iLAogICAgImF0dHJpYnV0aW9uIjogIlNlbmVjYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9uZSBtYXkgc2F5IHRoZSBldGVybmFsIG15c3Rlcnkgb2YgdGhlIHdvcmxkIGlzIGl0cyBjb21wcmVoZW5zaWJpbGl0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHNvbWVvbmUgaW4geW91ciBsaWZlIHRhbGtlZCB0byB5b3UgdGhlIHdheSB5b3UgdGFsayB0byB5b3Vyc2VsZiwgeW91IHdvdWxkIGhhdmUgbGVmdCB0aGVtIGxvbmcgYWdvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybGEgR29yZG9uIgogIH0sCiAgewogICAgInRleHQiOiAiQmV3YXJlIG9mIHRoZSBoYWxmIHRydXRoLiBZb3UgbWF5IGhhdmUgZ290dGVuIGhvbGQgb2YgdGhlIHdyb25nIGhhbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTaWxlbmNlIGlzIGEgdHJ1ZSBmcmllbmQgd2hvIG5ldmVyIGJldHJheXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJPcHBvcnR1bml0eSBpcyBtaXNzZWQgYnkgbW9zdCBiZWNhdXNlIGl0IGlzIGRyZXNzZWQgaW4gb3ZlcmFsbHMgYW5kIGxvb2tzIGxpa2Ugd29yay4iLAogICAgImF0dHJpYnV0aW9uIjogIlRob21hcyBFZGlzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeXRoaW5nIGNhbiBiZSB0YWtlbiBmcm9tIGEgbWFuIGJ1dCAuLi4gdGhlIGxhc3Qgb2YgdGhlIGh1bWFuIGZyZWVkb21zIC0gdG8gY2hvb3NlIG9uZXMgYXR0aXR1ZGUgaW4gYW55IGdpdmVuIHNldCBvZiBjaXJjdW1zdGFuY2VzLCB0byBjaG9vc2Ugb25lcyBvd24gd2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVmljdG9yIEZyYW5rbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gZGVlZHMgYW5kIHdvcmRzIGFyZSBpbiBhY2NvcmQsIHRoZSB3aG9sZSB3b3JsZCBpcyB0cmFuc2Zvcm1lZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkNodWFuZyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbiBpZGVhIHRoYXQgaXMgZGV2ZWxvcGVkIGFuZCBwdXQgaW50byBhY3Rpb24gaXMgbW9yZSBpbXBvcnRhbnQgdGhhbiBhbiBpZGVhIHRoYXQgZXhpc3RzIG9ubHkgYXMgYW4gaWRlYS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW4ndCBzaGFrZSBoYW5kcyB3aXRoIGEgY2xlbmNoZWQgZmlzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkluZGlyYSBHYW5kaGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBoYXMgbmV2ZXIgYmVlbiBteSBvYmplY3QgdG8gcmVjb3JkIG15IGRyZWFtcywganVzdCB0byByZWFsaXplIHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYW4gUmF5IgogIH0sCiAgewogICAgInRleHQiOiAiRG8gbm90IG92ZXJyYXRlIHdoYXQgeW91IGhhdmUgcmVjZWl2ZWQsIG5vciBlbnZ5IG90aGVycy4gSGUgd2hvIGVudmllcyBvdGhlcnMgZG9lcyBub3Qgb2J0YWluIHBlYWNlIG9mIG1pbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSBjYW4gd2hvIHRoaW5rcyBoZSBjYW4sIGFuZCBoZSBjYW4ndCB3aG8gdGhpbmtzIGhlIGNhbid0LiBUaGlzIGlzIGFuIGluZXhvcmFibGUsIGluZGlzcHV0YWJsZSBsYXcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQYWJsbyBQaWNhc3NvIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IG11c3Qgd2VsY29tZSBjaGFuZ2UgYXMgdGhlIHJ1bGUgYnV0IG5vdCBhcyB5b3VyIHJ1bGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGVuaXMgV2FpdGxleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBoYXZlIG5vIHJlc3BlY3QgZm9yIHlvdXIgb3duIHZhbHVlcyBob3cgY2FuIHlvdSBiZSB3b3J0aHkgb2YgcmVzcGVjdCBmcm9tIG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiSSBhbSBvZiB0aGUgb3BpbmlvbiB0aGF0IG15IGxpZmUgYmVsb25ncyB0byB0aGUgY29tbXVuaXR5LCBhbmQgYXMgbG9uZyBhcyBJIGxpdmUgaXQgaXMgbXkgcHJpdmlsZWdlIHRvIGRvIGZvciBpdCB3aGF0ZXZlciBJIGNhbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkJlcm5hcmQgU2hhdyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGRvbid0IHN0b3AgcGxheWluZyBiZWNhdXNlIHdlIGdyb3cgb2xkOyB3ZSBncm93IG9sZCBiZWNhdXNlIHdlIHN0b3AgcGxheWluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJlcm5hcmQgU2hhdyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk91ciBraW5kbmVzcyBtYXkgYmUgdGhlIG1vc3QgcGVyc3Vhc2l2ZSBhcmd1bWVudCBmb3IgdGhhdCB3aGljaCB3ZSBiZWxpZXZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiR29yZG9uIEhpbmNrbGV5IgogIH0sCiAgewogICAgInRleHQiOiAiV2UgZG8gbm90IHF1aXQgcGxheWluZyBiZWNhdXNlIHdlIGdyb3cgb2xkLCB3ZSBncm93IG9sZCBiZWNhdXNlIHdlIHF1aXQgcGxheWluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIk9saXZlciBIb2xtZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbWFnaW5hdGlvbiB3aWxsIG9mdGVuIGNhcnJ5IHVzIHRvIHdvcmxkcyB0aGF0IG5ldmVyIHdlcmUuIEJ1dCB3aXRob3V0IGl0IHdlIGdvIG5vd2hlcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXJsIFNhZ2FuIgogIH0sCiAgewogICAgInRleHQiOiAiQSBiZWF1dGlmdWwgdGhpbmcgaXMgbmV2ZXIgcGVyZmVjdC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBtaWdodCB3ZWxsIHJlbWVtYmVyIHRoYXQgbm90aGluZyBjYW4gYnJpbmcgeW91IHN1Y2Nlc3MgYnV0IHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBlb3BsZSBncm93IHRocm91Z2ggZXhwZXJpZW5jZSBpZiB0aGV5IG1lZXQgbGlmZSBob25lc3RseSBhbmQgY291cmFnZW91c2x5LiBUaGlzIGlzIGhvdyBjaGFyYWN0ZXIgaXMgYnVpbHQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGVhbm9yIFJvb3NldmVsdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlN0cmVuZ3RoIHRvIGNhcnJ5IG9uIGRlc3BpdGUgdGhlIG9kZHMgbWVhbnMgeW91IGhhdmUgZmFpdGggaW4geW91ciBvd24gYWJpbGl0aWVzIGFuZCBrbm93IGhvdy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXNuJ3Qgd2hhdCBoYXBwZW5zIHRvIHVzIHRoYXQgY2F1c2VzIHVzIHRvIHN1ZmZlcjsgaXQncyB3aGF0IHdlIHNheSB0byBvdXJzZWx2ZXMgYWJvdXQgd2hhdCBoYXBwZW5zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVtYSBDaG9kcm9uIgogIH0sCiAgewogICAgInRleHQiOiAiRWl0aGVyIHlvdSBydW4gdGhlIGRheSBvciB0aGUgZGF5IHJ1bnMgeW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmltIFJvaG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBiZSB0aG91Z2h0ZnVsIGFuZCBraW5kIG9ubHkgdGFrZXMgYSBmZXcgc2Vjb25kcyBjb21wYXJlZCB0byB0aGUgdGltZWxlc3MgaHVydCBjYXVzZWQgYnkgb25lIHJ1ZGUgZ2VzdHVyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiU3RheSBjb21taXR0ZWQgdG8geW91ciBkZWNpc2lvbnMsIGJ1dCBzdGF5IGZsZXhpYmxlIGluIHlvdXIgYXBwcm9hY2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb255IFJvYmJpbnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBnaXZlIG9uZXMgc2VsZiBlYXJuZXN0bHkgdG8gdGhlIGR1dGllcyBkdWUgdG8gbWVuLCBhbmQsIHdoaWxlIHJlc3BlY3Rpbmcgc3Bpcml0dWFsIGJlaW5ncywgdG8ga2VlcCBhbG9vZiBmcm9tIHRoZW0sIG1heSBiZSBjYWxsZWQgd2lzZG9tLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiRWFjaCBkYXkgcHJvdmlkZXMgaXRzIG93biBnaWZ0cy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlVudGlsIHlvdSB2YWx1ZSB5b3Vyc2VsZiwgeW91IHdvbid0IHZhbHVlIHlvdXIgdGltZS4gVW50aWwgeW91IHZhbHVlIHlvdXIgdGltZSwgeW91IHdvbid0IGRvIGFueXRoaW5nIHdpdGggaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNLiBTY290dCBQZWNrIgogIH0sCiAgewogICAgInRleHQiOiAiUGF0aWVuY2UgaXMgYSB2aXJ0dWUgYnV0IHlvdSB3aWxsIG5ldmVyIGV2ZXIgYWNjb21wbGlzaCBhbnl0aGluZyBpZiB5b3UgZG9uJ3QgZXhlcmNpc2UgYWN0aW9uIG92ZXIgcGF0aWVuY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSB3ZXJlIG5vdCBib3JuIGEgd2lubmVyLCBhbmQgeW91IHdlcmUgbm90IGJvcm4gYSBsb3Nlci4gWW91IGFyZSB3aGF0IHlvdSBtYWtlIHlvdXJzZWxmIGJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTG91IEhvbHR6IgogIH0sCiAgewogICAgInRleHQiOiAiVG8gYmUgd2hhdCB3ZSBhcmUsIGFuZCB0byBiZWNvbWUgd2hhdCB3ZSBhcmUgY2FwYWJsZSBvZiBiZWNvbWluZywgaXMgdGhlIG9ubHkgZW5kIG9mIGxpZmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgU3RldmVuc29uIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCBtYXR0ZXJzIGlzIHRoZSB2YWx1ZSB3ZSd2ZSBjcmVhdGVkIGluIG91ciBsaXZlcywgdGhlIHBlb3BsZSB3ZSd2ZSBtYWRlIGhhcHB5IGFuZCBob3cgbXVjaCB3ZSd2ZSBncm93biBhcyBwZW9wbGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWlzYWt1IElrZWRhIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IHdhbnQgYSB0aGluZyBkb25lIHdlbGwsIGRvIGl0IHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gQm9uYXBhcnRlIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiB0aGVyZSBpcyBubyBlbmVteSB3aXRoaW4sIHRoZSBlbmVtaWVzIG91dHNpZGUgY2Fubm90IGh1cnQgeW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWZyaWNhbiBwcm92ZXJiIgogIH0sCiAgewogICAgInRleHQiOiAiRG8gbm90IHdhaXQgZm9yIGxlYWRlcnM7IGRvIGl0IGFsb25lLCBwZXJzb24gdG8gcGVyc29uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTW90aGVyIFRlcmVzYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9ic3RhY2xlcyBhcmUgdGhvc2UgZnJpZ2h0ZnVsIHRoaW5ncyB5b3Ugc2VlIHdoZW4geW91IHRha2UgeW91ciBleWVzIG9mZiB5b3VyIGdvYWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yeSBGb3JkIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGlzIGNvbnRlbnRlZCBpcyByaWNoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1ha2UgdGhlIGJlc3QgdXNlIG9mIHdoYXQgaXMgaW4geW91ciBwb3dlciwgYW5kIHRha2UgdGhlIHJlc3QgYXMgaXQgaGFwcGVucy4iLAogICAgImF0dHJpYnV0aW9uIjogIkVwaWN0ZXR1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBwZXNzaW1pc3Qgc2VlcyBkaWZmaWN1bHR5IGluIGV2ZXJ5IG9wcG9ydHVuaXR5LiBUaGUgb3B0aW1pc3Qgc2VlcyB0aGUgb3Bwb3J0dW5pdHkgaW4gZXZlcnkgZGlmZmljdWx0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHR3byBtb3N0IHBvd2VyZnVsIHdhcnJpb3JzIGFyZSBwYXRpZW5jZSBhbmQgdGltZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlbyBUb2xzdG95IgogIH0sCiAgewogICAgInRleHQiOiAiUHJhY3RpY2UgeW91cnNlbGYsIGZvciBoZWF2ZW5zIHNha2UgaW4gbGl0dGxlIHRoaW5ncywgYW5kIHRoZW4gcHJvY2VlZCB0byBncmVhdGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXBpY3RldHVzIgogIH0sCiAgewogICAgInRleHQiOiAiQWVyb2R5bmFtaWNhbGx5IHRoZSBidW1ibGViZWUgc2hvdWxkbid0IGJlIGFibGUgdG8gZmx5LCBidXQgdGhlIGJ1bWJsZWJlZSBkb2Vzbid0IGtub3cgdGhhdCBzbyBpdCBnb2VzIG9uIGZseWluZyBhbnl3YXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJ5IEtheSBBc2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciBiZSBhZnJhaWQgdG8gdHJ5LCByZW1lbWJlci4uLiBBbWF0ZXVycyBidWlsdCB0aGUgYXJrLCBQcm9mZXNzaW9uYWxzIGJ1aWx0IHRoZSBUaXRhbmljLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVW50aWwgeW91IG1ha2UgcGVhY2Ugd2l0aCB3aG8geW91IGFyZSwgeW91IHdpbGwgbmV2ZXIgYmUgY29udGVudCB3aXRoIHdoYXQgeW91IGhhdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb3JpcyBNb3J0bWFuIgogIH0sCiAgewogICAgInRleHQiOiAiUmVhbGl0eSBpcyBtZXJlbHkgYW4gaWxsdXNpb24sIGFsYmVpdCBhIHZlcnkgcGVyc2lzdGVudCBvbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUcnV0aCBpc24ndCBhbGwgYWJvdXQgd2hhdCBhY3R1YWxseSBoYXBwZW5zIGJ1dCBtb3JlIGFib3V0IGhvdyB3aGF0IGhhcyBoYXBwZW5lZCBpcyBpbnRlcnByZXRlZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiTGVhcm5pbmcgaXMgZmluZGluZyBvdXQgd2hhdCB5b3UgYWxyZWFkeSBrbm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiQSBqdWcgZmlsbHMgZHJvcCBieSBkcm9wLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgb25lIGlzIGx1Y2t5LCBhIHNvbGl0YXJ5IGZhbnRhc3kgY2FuIHRvdGFsbHkgdHJhbnNmb3JtIG9uZSBtaWxsaW9uIHJlYWxpdGllcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1heWEgQW5nZWxvdSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFsbCB0aGUgZmxvd2VycyBvZiBhbGwgdGhlIHRvbW9ycm93cyBhcmUgaW4gdGhlIHNlZWRzIG9mIHRvZGF5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGZlYXJzIGJlaW5nIGNvbnF1ZXJlZCBpcyBzdXJlIG9mIGRlZmVhdC4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEJvbmFwYXJ0ZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvaW5nIHdoYXQgeW91IGxvdmUgaXMgdGhlIGNvcm5lcnN0b25lIG9mIGhhdmluZyBhYnVuZGFuY2UgaW4geW91ciBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIG5vdGhpbmcgc28gdXNlbGVzcyBhcyBkb2luZyBlZmZpY2llbnRseSB0aGF0IHdoaWNoIHNob3VsZCBub3QgYmUgZG9uZSBhdCBhbGwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQZXRlciBEcnVja2VyIgogIH0sCiAgewogICAgInRleHQiOiAiVHJ1dGggaXMgZ2VuZXJhbGx5IHRoZSBiZXN0IHZpbmRpY2F0aW9uIGFnYWluc3Qgc2xhbmRlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkFicmFoYW0gTGluY29sbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoYXQgeW91IGdpdmUgaXMgd2hhdCB5b3UgZ2V0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZnV0dXJlIGJlbG9uZ3MgdG8gdGhvc2Ugd2hvIGJlbGlldmUgaW4gdGhlIGJlYXV0eSBvZiB0aGVpciBkcmVhbXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGVhbm9yIFJvb3NldmVsdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNvbnN0YW50IGtpbmRuZXNzIGNhbiBhY2NvbXBsaXNoIG11Y2guIEFzIHRoZSBzdW4gbWFrZXMgaWNlIG1lbHQsIGtpbmRuZXNzIGNhdXNlcyBtaXN1bmRlcnN0YW5kaW5nLCBtaXN0cnVzdCwgYW5kIGhvc3RpbGl0eSB0byBldmFwb3JhdGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgU2Nod2VpdHplciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdlbnVpbmUgbG92ZSBzaG91bGQgZmlyc3QgYmUgZGlyZWN0ZWQgYXQgb25lc2VsZjsgaWYgd2UgZG8gbm90IGxvdmUgb3Vyc2VsdmVzLCBob3cgY2FuIHdlIGxvdmUgb3RoZXJzPyIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdyZWF0IGFjdHMgYXJlIG1hZGUgdXAgb2Ygc21hbGwgZGVlZHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiUmVhc29uIGFuZCBmcmVlIGlucXVpcnkgYXJlIHRoZSBvbmx5IGVmZmVjdHVhbCBhZ2VudHMgYWdhaW5zdCBlcnJvci4iLAogICAgImF0dHJpYnV0aW9uIjogIlRob21hcyBKZWZmZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgc2VjcmV0IG9mIGpveSBpbiB3b3JrIGlzIGNvbnRhaW5lZCBpbiBvbmUgd29yZCAtIGV4Y2VsbGVuY2UuIFRvIGtub3cgaG93IHRvIGRvIHNvbWV0aGluZyB3ZWxsIGlzIHRvIGVuam95IGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVhcmwgQnVjayIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFyZ3VlIGZvciB5b3VyIGxpbWl0YXRpb25zLCBhbmQgc3VyZSBlbm91Z2ggdGhleXJlIHlvdXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gYWNjb21wbGlzaCBncmVhdCB0aGluZ3MsIHdlIG11c3QgZHJlYW0gYXMgd2VsbCBhcyBhY3QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbmF0b2xlIEZyYW5jZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoYXQgeW91IGRvIG5vdCB3YW50IGRvbmUgdG8geW91cnNlbGYsIGRvIG5vdCBkbyB0byBvdGhlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGNhbm5vdCBtYWtlIG15IGRheXMgbG9uZ2VyIHNvIEkgc3RyaXZlIHRvIG1ha2UgdGhlbSBiZXR0ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yeSBEYXZpZCBUaG9yZWF1IgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGhhdmUgdG8gZG8geW91ciBvd24gZ3Jvd2luZyBubyBtYXR0ZXIgaG93IHRhbGwgeW91ciBncmFuZGZhdGhlciB3YXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBYnJhaGFtIExpbmNvbG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJPbmUgZG9lcyBub3QgZGlzY292ZXIgbmV3IGxhbmRzIHdpdGhvdXQgY29uc2VudGluZyB0byBsb3NlIHNpZ2h0IG9mIHRoZSBzaG9yZSBmb3IgYSB2ZXJ5IGxvbmcgdGltZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuZHJlIEdpZGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBzcGVhayBncmF0aXR1ZGUgaXMgY291cnRlb3VzIGFuZCBwbGVhc2FudCwgdG8gZW5hY3QgZ3JhdGl0dWRlIGlzIGdlbmVyb3VzIGFuZCBub2JsZSwgYnV0IHRvIGxpdmUgZ3JhdGl0dWRlIGlzIHRvIHRvdWNoIEhlYXZlbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubmVzIEdhZXJ0bmVyIgogIH0sCiAgewogICAgInRleHQiOiAiR2l2ZSBpdCBhbGwgeW91J3ZlIGdvdCBiZWNhdXNlIHlvdSBuZXZlciBrbm93IGlmIHRoZXJlJ3MgZ29pbmcgdG8gYmUgYSBuZXh0IHRpbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYW5pZWxsZSBJbmdydW0iCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciByZWdyZXQuIElmIGl0J3MgZ29vZCwgaXQncyB3b25kZXJmdWwuIElmIGl0J3MgYmFkLCBpdCdzIGV4cGVyaWVuY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJWaWN0b3JpYSBIb2x0IgogIH0sCiAgewogICAgInRleHQiOiAiSSBsb3ZlIG15IHBhc3QuIEkgbG92ZSBteSBwcmVzZW50LiBJbSBub3QgYXNoYW1lZCBvZiB3aGF0IEl2ZSBoYWQsIGFuZCBJbSBub3Qgc2FkIGJlY2F1c2UgSSBoYXZlIGl0IG5vIGxvbmdlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbGV0dGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgaGF2ZSBwb3dlciBvdmVyIHlvdXIgbWluZCAtIG5vdCBvdXRzaWRlIGV2ZW50cy4gUmVhbGl6ZSB0aGlzLCBhbmQgeW91IHdpbGwgZmluZCBzdHJlbmd0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdyZWF0IGlzIHRoZSBhcnQgb2YgYmVnaW5uaW5nLCBidXQgZ3JlYXRlciBpcyB0aGUgYXJ0IG9mIGVuZGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhenVydXMgTG9uZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBnaXZlIGJlZm9yZSB5b3UgZ2V0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIG5vIGdyZWF0ZXIgaGFybSB0aGFuIHRoYXQgb2YgdGltZSB3YXN0ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNaWNoZWxhbmdlbG8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZ3JlYXRlc3QgcGxlYXN1cmUgSSBrbm93IGlzIHRvIGRvIGEgZ29vZCBhY3Rpb24gYnkgc3RlYWx0aCwgYW5kIHRvIGhhdmUgaXQgZm91bmQgb3V0IGJ5IGFjY2lkZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hhcmxlcyBMYW1iIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnlvbmUgaGFzIGJlZW4gbWFkZSBmb3Igc29tZSBwYXJ0aWN1bGFyIHdvcmssIGFuZCB0aGUgZGVzaXJlIGZvciB0aGF0IHdvcmsgaGFzIGJlZW4gcHV0IGluIGV2ZXJ5IGhlYXJ0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUnVtaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5dGhpbmcgdGhhdCBpcnJpdGF0ZXMgdXMgYWJvdXQgb3RoZXJzIGNhbiBsZWFkIHVzIHRvIGFuIHVuZGVyc3RhbmRpbmcgYWJvdXQgb3Vyc2VsdmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybCBKdW5nIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiB5b3UgZGFuY2UsIHlvdXIgcHVycG9zZSBpcyBub3QgdG8gZ2V0IHRvIGEgY2VydGFpbiBwbGFjZSBvbiB0aGUgZmxvb3IuIEl0J3MgdG8gZW5qb3kgZWFjaCBzdGVwIGFsb25nIHRoZSB3YXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYXluZSBEeWVyIgogIH0sCiAgewogICAgInRleHQiOiAiUXVhbGl0eSBtZWFucyBkb2luZyBpdCByaWdodCB3aGVuIG5vIG9uZSBpcyBsb29raW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgRm9yZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5ldmVyIGFwb2xvZ2l6ZSBmb3Igc2hvd2luZyBmZWVsaW5nLiBXaGVuIHlvdSBkbyBzbywgeW91IGFwb2xvZ2l6ZSBmb3IgdHJ1dGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBEaXNyYWVsaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGFsbCBkZXBlbmRzIG9uIGhvdyB3ZSBsb29rIGF0IHRoaW5ncywgYW5kIG5vdCBob3cgdGhleSBhcmUgaW4gdGhlbXNlbHZlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNhcmwgSnVuZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNpeCBlc3NlbnRpYWwgcXVhbGl0aWVzIHRoYXQgYXJlIHRoZSBrZXkgdG8gc3VjY2VzczogU2luY2VyaXR5LCBwZXJzb25hbCBpbnRlZ3JpdHksIGh1bWlsaXR5LCBjb3VydGVzeSwgd2lzZG9tLCBjaGFyaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBNZW5uaW5nZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB3ZSBoYWQgbm8gd2ludGVyLCB0aGUgc3ByaW5nIHdvdWxkIG5vdCBiZSBzbyBwbGVhc2FudDsgaWYgd2UgZGlkIG5vdCBzb21ldGltZXMgdGFzdGUgb2YgYWR2ZXJzaXR5LCBwcm9zcGVyaXR5IHdvdWxkIG5vdCBiZSBzbyB3ZWxjb21lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW5uZSBCcmFkc3RyZWV0IgogIH0sCiAgewogICAgInRleHQiOiAiTG92ZSBkb2Vzbid0IG1ha2UgdGhlIHdvcmxkIGdvIHJvdW5kLCBsb3ZlIGlzIHdoYXQgbWFrZXMgdGhlIHJpZGUgd29ydGh3aGlsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVsaXphYmV0aCBCcm93bmluZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRydXN0IHlvdXIgb3duIGluc3RpbmN0LiBZb3VyIG1pc3Rha2VzIG1pZ2h0IGFzIHdlbGwgYmUgeW91ciBvd24sIGluc3RlYWQgb2Ygc29tZW9uZSBlbHNlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJpbGx5IFdpbGRlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNtYWxsIG9wcG9ydHVuaXRpZXMgYXJlIG9mdGVuIHRoZSBiZWdpbm5pbmcgb2YgZ3JlYXQgZW50ZXJwcmlzZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEZW1vc3RoZW5lcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IGZlYXIgZmFpbHVyZSBzbyBtdWNoIHRoYXQgeW91IHJlZnVzZSB0byB0cnkgbmV3IHRoaW5ncy4gVGhlIHNhZGRlc3Qgc3VtbWFyeSBvZiBsaWZlIGNvbnRhaW5zIHRocmVlIGRlc2NyaXB0aW9uczogY291bGQgaGF2ZSwgbWlnaHQgaGF2ZSwgYW5kIHNob3VsZCBoYXZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgYXJlIG9ubHkgdHdvIHdheXMgdG8gbGl2ZSB5b3VyIGxpZmUuIE9uZSBpcyBhcyB0aG91Z2ggbm90aGluZyBpcyBhIG1pcmFjbGUuIFRoZSBvdGhlciBpcyBhcyB0aG91Z2ggZXZlcnl0aGluZyBpcyBhIG1pcmFjbGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJTcGVhayBsb3csIGlmIHlvdSBzcGVhayBsb3ZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNoZXJpc2ggeW91ciB2aXNpb25zIGFuZCB5b3VyIGRyZWFtcyBhcyB0aGV5IGFyZSB0aGUgY2hpbGRyZW4gb2YgeW91ciBzb3VsOyB0aGUgYmx1ZXByaW50cyBvZiB5b3VyIHVsdGltYXRlIGFjaGlldmVtZW50cy4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEhpbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaXZpbmcgYXQgcmlzayBpcyBqdW1waW5nIG9mZiB0aGUgY2xpZmYgYW5kIGJ1aWxkaW5nIHlvdXIgd2luZ3Mgb24gdGhlIHdheSBkb3duLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmF5IEJyYWRidXJ5IgogIH0sCiAgewogICAgInRleHQiOiAiV2V2ZSBnb3QgdG8gaGF2ZSBhIGRyZWFtIGlmIHdlIGFyZSBnb2luZyB0byBtYWtlIGEgZHJlYW0gY29tZSB0cnVlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2FsdCBEaXNuZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbGwgY2hpbGRyZW4gYXJlIGFydGlzdHMuIFRoZSBwcm9ibGVtIGlzIGhvdyB0byByZW1haW4gYW4gYXJ0aXN0IG9uY2UgaGUgZ3Jvd3MgdXAuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQYWJsbyBQaWNhc3NvIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgdGhhdCBpcyBnaWRkeSB0aGlua3MgdGhlIHdvcmxkIHR1cm5zIHJvdW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlllc3RlcmRheSBJIGRhcmVkIHRvIHN0cnVnZ2xlLiBUb2RheSBJIGRhcmUgdG8gd2luLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVybmFkZXR0ZSBEZXZsaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIGZhdGUgaGFuZHMgdXMgYSBsZW1vbiwgbGV0cyB0cnkgdG8gbWFrZSBsZW1vbmFkZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhbGUgQ2FybmVnaWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBvbmx5IG9uZSBoYXBwaW5lc3MgaW4gbGlmZSwgdG8gbG92ZSBhbmQgYmUgbG92ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZ2UgU2FuZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIG5ldmVyIGxpdmU7IHdlIGFyZSBhbHdheXMgaW4gdGhlIGV4cGVjdGF0aW9uIG9mIGxpdmluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIlZvbHRhaXJlIgogIH0sCiAgewogICAgInRleHQiOiAiQW4gaW52aW5jaWJsZSBkZXRlcm1pbmF0aW9uIGNhbiBhY2NvbXBsaXNoIGFsbW9zdCBhbnl0aGluZyBhbmQgaW4gdGhpcyBsaWVzIHRoZSBncmVhdCBkaXN0aW5jdGlvbiBiZXR3ZWVuIGdyZWF0IG1lbiBhbmQgbGl0dGxlIG1lbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlRob21hcyBGdWxsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBjb21lIHRvIGxvdmUgbm90IGJ5IGZpbmRpbmcgYSBwZXJmZWN0IHBlcnNvbiwgYnV0IGJ5IGxlYXJuaW5nIHRvIHNlZSBhbiBpbXBlcmZlY3QgcGVyc29uIHBlcmZlY3RseS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNhbSBLZWVuIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpcyBub3QgbWVhc3VyZWQgYnkgdGhlIGJyZWF0aHMgd2UgdGFrZSwgYnV0IGJ5IHRoZSBtb21lbnRzIHRoYXQgdGFrZSBvdXIgYnJlYXRoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gYmUgdGVzdGVkIGlzIGdvb2QuIFRoZSBjaGFsbGVuZ2VkIGxpZmUgbWF5IGJlIHRoZSBiZXN0IHRoZXJhcGlzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkdhaWwgU2hlZWh5IgogIH0sCiAgewogICAgInRleHQiOiAiSGFwcGluZXNzIGRvZXMgbm90IGNvbWUgZnJvbSBoYXZpbmcgbXVjaCwgYnV0IGZyb20gYmVpbmcgYXR0YWNoZWQgdG8gbGl0dGxlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hlbmcgWWVuIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG9ubHkgcmVhbCBmYWlsdXJlIGluIGxpZmUgaXMgbm90IHRvIGJlIHRydWUgdG8gdGhlIGJlc3Qgb25lIGtub3dzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiWW91ciBhYmlsaXR5IHRvIGxlYXJuIGZhc3RlciB0aGFuIHlvdXIgY29tcGV0aXRpb24gaXMgeW91ciBvbmx5IHN1c3RhaW5hYmxlIGNvbXBldGl0aXZlIGFkdmFudGFnZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFyaWUgZGUgR3VlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBoZWFydCBoYXMgaXRzIHJlYXNvbnMgd2hpY2ggcmVhc29uIGtub3dzIG5vdCBvZi4iLAogICAgImF0dHJpYnV0aW9uIjogIkJsYWlzZSBQYXNjYWwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgZ290IHRvIGJlIGNhcmVmdWwgaWYgeW91IGRvbid0IGtub3cgd2hlcmUgeW91J3JlIGdvaW5nLCBiZWNhdXNlIHlvdSBtaWdodCBub3QgZ2V0IHRoZXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiWW9naSBCZXJyYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIGluIHlvdXIgbW9tZW50cyBvZiBkZWNpc2lvbiB0aGF0IHlvdXIgZGVzdGlueSBpcyBzaGFwZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb255IFJvYmJpbnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaG91c2FuZHMgb2YgY2FuZGxlcyBjYW4gYmUgbGlnaHRlZCBmcm9tIGEgc2luZ2xlIGNhbmRsZSwgYW5kIHRoZSBsaWZlIG9mIHRoZSBjYW5kbGUgd2lsbCBub3QgYmUgc2hvcnRlbmVkLiBIYXBwaW5lc3MgbmV2ZXIgZGVjcmVhc2VzIGJ5IGJlaW5nIHNoYXJlZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzcGlyaXQsIHRoZSB3aWxsIHRvIHdpbiwgYW5kIHRoZSB3aWxsIHRvIGV4Y2VsLCBhcmUgdGhlIHRoaW5ncyB0aGF0IGVuZHVyZS4gVGhlc2UgcXVhbGl0aWVzIGFyZSBzbyBtdWNoIG1vcmUgaW1wb3J0YW50IHRoYW4gdGhlIGV2ZW50cyB0aGF0IG9jY3VyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVmluY2VudCBMb21iYXJkaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIG9ubHkgd2VkIHN0b3AgdHJ5aW5nIHRvIGJlIGhhcHB5IHdlZCBoYXZlIGEgcHJldHR5IGdvb2QgdGltZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVkaXRoIFdoYXJ0b24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbiBvcmRlciB0byBsaXZlIGZyZWUgYW5kIGhhcHBpbHkgeW91IG11c3Qgc2FjcmlmaWNlIGJvcmVkb20uIEl0IGlzIG5vdCBhbHdheXMgYW4gZWFzeSBzYWNyaWZpY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSByZWFsaXplIHRoZXJlIGlzIG5vdGhpbmcgbGFja2luZywgdGhlIHdob2xlIHdvcmxkIGJlbG9uZ3MgdG8geW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkltYWdpbmF0aW9uIGlzIG5vdCBhIHRhbGVudCBvZiBzb21lIG1lbiBidXQgaXMgdGhlIGhlYWx0aCBvZiBldmVyeSBtYW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBXYWxkbyBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSXQncyBpbXBvcnRhbnQgdG8ga25vdyB0aGF0IHdvcmRzIGRvbid0IG1vdmUgbW91bnRhaW5zLiBXb3JrLCBleGFjdGluZyB3b3JrIG1vdmVzIG1vdW50YWlucy4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhbmlsbyBEb2xjaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRydXN0IHlvdXIgaHVuY2hlcy4gVGhleSdyZSB1c3VhbGx5IGJhc2VkIG9uIGZhY3RzIGZpbGVkIGF3YXkganVzdCBiZWxvdyB0aGUgY29uc2Npb3VzIGxldmVsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm95Y2UgQnJvdGhlcnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbyBtb3JlIHRoYW4gZHJlYW06IHdvcmsuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIEFydGh1ciBXYXJkIgogIH0sCiAgewogICAgInRleHQiOiAiR29kIGhhcyBnaXZlbiB5b3Ugb25lIGZhY2UsIGFuZCB5b3UgbWFrZSB5b3Vyc2VsZiBhbm90aGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRha2UgaGVlZDogeW91IGRvIG5vdCBmaW5kIHdoYXQgeW91IGRvIG5vdCBzZWVrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRW5nbGlzaCBwcm92ZXJiIgogIH0sCiAgewogICAgInRleHQiOiAiRG8gd2hhdCB5b3UgY2FuLiBXYW50IHdoYXQgeW91IGhhdmUuIEJlIHdobyB5b3UgYXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRm9ycmVzdCBDaHVyY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbyBub3QgZm9sbG93IHdoZXJlIHRoZSBwYXRoIG1heSBsZWFkLiBHbywgaW5zdGVhZCwgd2hlcmUgdGhlcmUgaXMgbm8gcGF0aCBhbmQgbGVhdmUgYSB0cmFpbC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIFdhbGRvIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJMZWFybmluZyBpcyBhIHRyZWFzdXJlIHRoYXQgd2lsbCBmb2xsb3cgaXRzIG93bmVyIGV2ZXJ5d2hlcmUiLAogICAgImF0dHJpYnV0aW9uIjogIkNoaW5lc2UgcHJvdmVyYiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IGJlIGFmcmFpZCB0byBnbyBvdXQgb24gYSBsaW1iLiBUaGF0J3Mgd2hlcmUgdGhlIGZydWl0IGlzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSC4gSmFja3NvbiBCcm93bmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbGwgaXMgZmx1eDsgbm90aGluZyBzdGF5cyBzdGlsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlcmFjbGl0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGxpZmUgc3BlbnQgbWFraW5nIG1pc3Rha2VzIGlzIG5vdCBvbmx5IG1vcmUgaG9ub3VyYWJsZSwgYnV0IG1vcmUgdXNlZnVsIHRoYW4gYSBsaWZlIHNwZW50IGRvaW5nIG5vdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZ2UgQmVybmFyZCBTaGF3IgogIH0sCiAgewogICAgInRleHQiOiAiTG92ZSBpcyB0aGUgb25seSBmb3JjZSBjYXBhYmxlIG9mIHRyYW5zZm9ybWluZyBhbiBlbmVteSBpbnRvIGZyaWVuZC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcnRpbiBMdXRoZXIgS2luZywgSnIuIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgbXVzdCBiZSBhcyBjb3VydGVvdXMgdG8gYSBtYW4gYXMgd2UgYXJlIHRvIGEgcGljdHVyZSwgd2hpY2ggd2UgYXJlIHdpbGxpbmcgdG8gZ2l2ZSB0aGUgYWR2YW50YWdlIG9mIGEgZ29vZCBsaWdodC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGFtIGxpa2UgYSBmYWxsaW5nIHN0YXIgd2hvIGhhcyBmaW5hbGx5IGZvdW5kIGhlciBwbGFjZSBuZXh0IHRvIGFub3RoZXIgaW4gYSBsb3ZlbHkgY29uc3RlbGxhdGlvbiwgd2hlcmUgd2Ugd2lsbCBzcGFya2xlIGluIHRoZSBoZWF2ZW5zIGZvcmV2ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbXkgVGFuIgogIH0sCiAgewogICAgInRleHQiOiAiQSBtYW4gd2hvIGRvZXNuJ3QgdHJ1c3QgaGltc2VsZiBjYW4gbmV2ZXIgcmVhbGx5IHRydXN0IGFueW9uZSBlbHNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FyZGluYWwgUmV0eiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1ha2UgaXQgYSBydWxlIG9mIGxpZmUgbmV2ZXIgdG8gcmVncmV0IGFuZCBuZXZlciB0byBsb29rIGJhY2suIFJlZ3JldCBpcyBhbiBhcHBhbGxpbmcgd2FzdGUgb2YgZW5lcmd5OyB5b3UgY2FuJ3QgYnVpbGQgb24gaXQ7IGl0J3Mgb25seSBmb3Igd2FsbG93aW5nIGluLiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2F0aGVyaW5lIE1hbnNmaWVsZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBzcGVuZCB0b28gbXVjaCB0aW1lIHRoaW5raW5nIGFib3V0IGEgdGhpbmcsIHlvdSdsbCBuZXZlciBnZXQgaXQgZG9uZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJydWNlIExlZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1vc3QgcGVvcGxlIGFyZSBhYm91dCBhcyBoYXBweSBhcyB0aGV5IG1ha2UgdXAgdGhlaXIgbWluZHMgdG8gYmUiLAogICAgImF0dHJpYnV0aW9uIjogIkFicmFoYW0gTGluY29sbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdyZWF0IGFyZSB0aGV5IHdobyBzZWUgdGhhdCBzcGlyaXR1YWwgaXMgc3Ryb25nZXIgdGhhbiBhbnkgbWF0ZXJpYWwgZm9yY2UsIHRoYXQgdGhvdWdodHMgcnVsZSB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSXJvbiBydXN0cyBmcm9tIGRpc3VzZTsgd2F0ZXIgbG9zZXMgaXRzIHB1cml0eSBmcm9tIHN0YWduYXRpb24uLi4gZXZlbiBzbyBkb2VzIGluYWN0aW9uIHNhcCB0aGUgdmlnb3VyIG9mIHRoZSBtaW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGVvbmFyZG8gZGEgVmluY2kiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgYXJlIGFsd2F5cyBmcmVlIHRvIGNoYW5nZSB5b3VyIG1pbmQgYW5kIGNob29zZSBhIGRpZmZlcmVudCBmdXR1cmUsIG9yIGEgZGlmZmVyZW50IHBhc3QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgcG93ZXIgb2YgaW50dWl0aXZlIHVuZGVyc3RhbmRpbmcgd2lsbCBwcm90ZWN0IHlvdSBmcm9tIGhhcm0gdW50aWwgdGhlIGVuZCBvZiB5b3VyIGRheXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW96aSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJ5IGFjY2VwdGluZyB5b3Vyc2VsZiBhbmQgYmVpbmcgZnVsbHkgd2hhdCB5b3UgYXJlLCB5b3VyIHByZXNlbmNlIGNhbiBtYWtlIG90aGVycyBoYXBweS4iLAogICAgImF0dHJpYnV0aW9uIjogIkphbmUgUm9iZXJ0cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgbGVhZGVyIGlzIGJlc3Qgd2hlbiBwZW9wbGUgYmFyZWx5IGtub3cgaGUgZXhpc3RzLCB3aGVuIGhpcyB3b3JrIGlzIGRvbmUsIGhpcyBhaW0gZnVsZmlsbGVkLCB0aGV5IHdpbGwgc2F5OiB3ZSBkaWQgaXQgb3Vyc2VsdmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIG9uZSB3YXkgYmUgYmV0dGVyIHRoYW4gYW5vdGhlciwgdGhhdCB5b3UgbWF5IGJlIHN1cmUgaXMgbmF0dXJlcyB3YXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBcmlzdG90bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIHNob3J0IHNheWluZyBvZnRlbiBjb250YWlucyBtdWNoIHdpc2RvbS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNvcGhvY2xlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldpc2RvbSBpcyB0aGUgcmV3YXJkIHlvdSBnZXQgZm9yIGEgbGlmZXRpbWUgb2YgbGlzdGVuaW5nIHdoZW4geW91J2QgaGF2ZSBwcmVmZXJyZWQgdG8gdGFsay4iLAogICAgImF0dHJpYnV0aW9uIjogIkRvdWcgTGFyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiVG8ga25vdyBvbmVzZWxmIGlzIHRvIHN0dWR5IG9uZXNlbGYgaW4gYWN0aW9uIHdpdGggYW5vdGhlciBwZXJzb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCcnVjZSBMZWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgYWx3YXlzIHN1Y2NlZWQgaW4gcHJvZHVjaW5nIGEgcmVzdWx0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVG9ueSBSb2JiaW5zIgogIH0sCiAgewogICAgInRleHQiOiAiRHJlYW1zIGNvbWUgdHJ1ZS4gV2l0aG91dCB0aGF0IHBvc3NpYmlsaXR5LCBuYXR1cmUgd291bGQgbm90IGluY2l0ZSB1cyB0byBoYXZlIHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIFVwZGlrZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1vdW50YWlucyBjYW5ub3QgYmUgc3VybW91bnRlZCBleGNlcHQgYnkgd2luZGluZyBwYXRocy4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubiBXb2xmZ2FuZyB2b24gR29ldGhlIgogIH0sCiAgewogICAgInRleHQiOiAiSSB3aWxsIGxvdmUgdGhlIGxpZ2h0IGZvciBpdCBzaG93cyBtZSB0aGUgd2F5LCB5ZXQgSSB3aWxsIGVuZHVyZSB0aGUgZGFya25lc3MgYmVjYXVzZSBpdCBzaG93cyBtZSB0aGUgc3RhcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPZyBNYW5kaW5vIgogIH0sCiAgewogICAgInRleHQiOiAiRWFjaCBkYXkgY2FuIGJlIG9uZSBvZiB0cml1bXBoIGlmIHlvdSBrZWVwIHVwIHlvdXIgaW50ZXJlc3RzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiR2VvcmdlIE1hdHRoZXcgQWRhbXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJLbm93aW5nIHlvdXIgb3duIGRhcmtuZXNzIGlzIHRoZSBiZXN0IG1ldGhvZCBmb3IgZGVhbGluZyB3aXRoIHRoZSBkYXJrbmVzc2VzIG9mIG90aGVyIHBlb3BsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNhcmwgSnVuZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gcGVvcGxlIGFyZSBsaWtlIGVhY2ggb3RoZXIgdGhleSB0ZW5kIHRvIGxpa2UgZWFjaCBvdGhlci4iLAogICAgImF0dHJpYnV0aW9uIjogIlRvbnkgUm9iYmlucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSdyZSBpbiBhIGJhZCBzaXR1YXRpb24sIGRvbid0IHdvcnJ5IGl0J2xsIGNoYW5nZS4gSWYgeW91J3JlIGluIGEgZ29vZCBzaXR1YXRpb24sIGRvbid0IHdvcnJ5IGl0J2xsIGNoYW5nZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gU2ltb25lIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyZWF0ZXIgcGFydCBvZiBodW1hbiBwYWluIGlzIHVubmVjZXNzYXJ5LiBJdCBpcyBzZWxmLWNyZWF0ZWQgYXMgbG9uZyBhcyB0aGUgdW5vYnNlcnZlZCBtaW5kIHJ1bnMgeW91ciBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWNraGFydCBUb2xsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSByZWFsbHkgY2FuIGNoYW5nZSB0aGUgd29ybGQgaWYgeW91IGNhcmUgZW5vdWdoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyaWFuIEVkZWxtYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeW9uZSB0aGlua3Mgb2YgY2hhbmdpbmcgdGhlIHdvcmxkLCBidXQgbm8gb25lIHRoaW5rcyBvZiBjaGFuZ2luZyBoaW1zZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGVvIFRvbHN0b3kiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHdlIGZlZWwgbG92ZSBhbmQga2luZG5lc3MgdG93YXJkIG90aGVycywgaXQgbm90IG9ubHkgbWFrZXMgb3RoZXJzIGZlZWwgbG92ZWQgYW5kIGNhcmVkIGZvciwgYnV0IGl0IGhlbHBzIHVzIGFsc28gdG8gZGV2ZWxvcCBpbm5lciBoYXBwaW5lc3MgYW5kIHBlYWNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGVuemluIEd5YXRzbyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxvdmUgZG9lcyBub3QgY29uc2lzdCBvZiBnYXppbmcgYXQgZWFjaCBvdGhlciwgYnV0IGluIGxvb2tpbmcgdG9nZXRoZXIgaW4gdGhlIHNhbWUgZGlyZWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW50b2luZSBkZSBTYWludC1FeHVwZXJ5IgogIH0sCiAgewogICAgInRleHQiOiAiTmF0dXJlIGdhdmUgdXMgb25lIHRvbmd1ZSBhbmQgdHdvIGVhcnMgc28gd2UgY291bGQgaGVhciB0d2ljZSBhcyBtdWNoIGFzIHdlIHNwZWFrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXBpY3RldHVzIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gYmUgd3JvbmdlZCBpcyBub3RoaW5nIHVubGVzcyB5b3UgY29udGludWUgdG8gcmVtZW1iZXIgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBkaWZmaWN1bHQgdG8gYWNoaWV2ZSBhIHNwaXJpdCBvZiBnZW51aW5lIGNvb3BlcmF0aW9uIGFzIGxvbmcgYXMgcGVvcGxlIHJlbWFpbiBpbmRpZmZlcmVudCB0byB0aGUgZmVlbGluZ3MgYW5kIGhhcHBpbmVzcyBvZiBvdGhlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiV2VyZSBoZXJlIGZvciBhIHJlYXNvbi4gSSBiZWxpZXZlIGEgYml0IG9mIHRoZSByZWFzb24gaXMgdG8gdGhyb3cgbGl0dGxlIHRvcmNoZXMgb3V0IHRvIGxlYWQgcGVvcGxlIHRocm91Z2ggdGhlIGRhcmsuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaG9vcGkgR29sZGJlcmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJDcml0aWNpc20gaXMgc29tZXRoaW5nIHlvdSBjYW4gZWFzaWx5IGF2b2lkIGJ5IHNheWluZyBub3RoaW5nLCBkb2luZyBub3RoaW5nLCBhbmQgYmVpbmcgbm90aGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFyaXN0b3RsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIGhlcmUgbm93LiBCZSBzb21lcGxhY2UgZWxzZSBsYXRlci4gSXMgdGhhdCBzbyBjb21wbGljYXRlZD8iLAogICAgImF0dHJpYnV0aW9uIjogIkRhdmlkIEJhZGVyIgogIH0sCiAgewogICAgInRleHQiOiAiTW9yYWwgZXhjZWxsZW5jZSBjb21lcyBhYm91dCBhcyBhIHJlc3VsdCBvZiBoYWJpdC4gV2UgYmVjb21lIGp1c3QgYnkgZG9pbmcganVzdCBhY3RzLCB0ZW1wZXJhdGUgYnkgZG9pbmcgdGVtcGVyYXRlIGFjdHMsIGJyYXZlIGJ5IGRvaW5nIGJyYXZlIGFjdHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBcmlzdG90bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYmVzdCB3YXkgdG8gcHJlZGljdCB5b3VyIGZ1dHVyZSBpcyB0byBjcmVhdGUgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQZXRlciBEcnVja2VyIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgaXQgaXMgbm90IHJpZ2h0IGRvIG5vdCBkbyBpdDsgaWYgaXQgaXMgbm90IHRydWUgZG8gbm90IHNheSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4geW91IGxlYXJuLCB0ZWFjaC4gV2hlbiB5b3UgZ2V0LCBnaXZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWF5YSBBbmdlbG91IgogIH0sCiAgewogICAgInRleHQiOiAiRnJvbSB3b25kZXIgaW50byB3b25kZXIgZXhpc3RlbmNlIG9wZW5zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBjYW4ndCBleHBsYWluIGl0IHNpbXBseSwgeW91IGRvbid0IHVuZGVyc3RhbmQgaXQgd2VsbCBlbm91Z2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJDb3VyYWdlIGlzIG5vdCB0aGUgYWJzZW5jZSBvZiBmZWFyLCBidXQgc2ltcGx5IG1vdmluZyBvbiB3aXRoIGRpZ25pdHkgZGVzcGl0ZSB0aGF0IGZlYXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQYXQgUmlsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8ga25vd3MsIGRvZXMgbm90IHNwZWFrLiBIZSB3aG8gc3BlYWtzLCBkb2VzIG5vdCBrbm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldpc2RvbSBiZWdpbnMgaW4gd29uZGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU29jcmF0ZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbWFnaW5hdGlvbiBydWxlcyB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBCb25hcGFydGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFYWNoIG1pc2ZvcnR1bmUgeW91IGVuY291bnRlciB3aWxsIGNhcnJ5IGluIGl0IHRoZSBzZWVkIG9mIHRvbW9ycm93cyBnb29kIGx1Y2suIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPZyBNYW5kaW5vIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGhlYXJ0IGhhcyBleWVzIHdoaWNoIHRoZSBicmFpbiBrbm93cyBub3RoaW5nIG9mLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hhcmxlcyBQZXJraHVyc3QiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBjYW4ndCBiZSBzcHJpbmcgaWYgeW91ciBoZWFydCBpcyBmaWxsZWQgd2l0aCBwYXN0IGZhaWx1cmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTdW5zaGluZSBpcyBkZWxpY2lvdXMsIHJhaW4gaXMgcmVmcmVzaGluZywgd2luZCBicmFjZXMgdXMgdXAsIHNub3cgaXMgZXhoaWxhcmF0aW5nOyB0aGVyZSBpcyByZWFsbHkgbm8gc3VjaCB0aGluZyBhcyBiYWQgd2VhdGhlciwgb25seSBkaWZmZXJlbnQga2luZHMgb2YgZ29vZCB3ZWF0aGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBSdXNraW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJGYWlsdXJlIHdpbGwgbmV2ZXIgb3ZlcnRha2UgbWUgaWYgbXkgZGV0ZXJtaW5hdGlvbiB0byBzdWNjZWVkIGlzIHN0cm9uZyBlbm91Z2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPZyBNYW5kaW5vIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIEkgY2FuIHNheSBhYm91dCBsaWZlIGlzLCBPaCBHb2QsIGVuam95IGl0ISIsCiAgICAiYXR0cmlidXRpb24iOiAiQm9iIE5ld2hhcnQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8gb2J0YWlucyBoYXMgbGl0dGxlLiBIZSB3aG8gc2NhdHRlcnMgaGFzIG11Y2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiSSBjYW5ub3QgZ2l2ZSB5b3UgdGhlIGZvcm11bGEgZm9yIHN1Y2Nlc3MsIGJ1dCBJIGNhbiBnaXZlIHlvdSB0aGUgZm9ybXVsYSBmb3IgZmFpbHVyZTogd2hpY2ggaXM6IFRyeSB0byBwbGVhc2UgZXZlcnlib2R5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVyYmVydCBTd29wZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZpcnN0IGNvbWVzIHRob3VnaHQ7IHRoZW4gb3JnYW5pemF0aW9uIG9mIHRoYXQgdGhvdWdodCwgaW50byBpZGVhcyBhbmQgcGxhbnM7IHRoZW4gdHJhbnNmb3JtYXRpb24gb2YgdGhvc2UgcGxhbnMgaW50byByZWFsaXR5LiBUaGUgYmVnaW5uaW5nLCBhcyB5b3Ugd2lsbCBvYnNlcnZlLCBpcyBpbiB5b3VyIGltYWdpbmF0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIktub3dpbmcgaXMgbm90IGVub3VnaDsgd2UgbXVzdCBhcHBseSEiLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubiBXb2xmZ2FuZyB2b24gR29ldGhlIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG1vcmUgbGlnaHQgeW91IGFsbG93IHdpdGhpbiB5b3UsIHRoZSBicmlnaHRlciB0aGUgd29ybGQgeW91IGxpdmUgaW4gd2lsbCBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNoYWt0aSBHYXdhaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbiBhbGwgY2hhb3MgdGhlcmUgaXMgYSBjb3Ntb3MsIGluIGFsbCBkaXNvcmRlciBhIHNlY3JldCBvcmRlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkNhcmwgSnVuZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9wcG9ydHVuaXR5IG9mdGVuIGNvbWVzIGRpc2d1aXNlZCBpbiB0aGUgZm9ybSBvZiBtaXNmb3J0dW5lLCBvciB0ZW1wb3JhcnkgZGVmZWF0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldvcnJ5IG9mdGVuIGdpdmVzIGEgc21hbGwgdGhpbmcgYSBiaWcgc2hhZG93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiU3dlZGlzaCBwcm92ZXJiIgogIH0sCiAgewogICAgInRleHQiOiAiTW9zdCBzbWlsZXMgYXJlIHN0YXJ0ZWQgYnkgYW5vdGhlciBzbWlsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJlbWVtYmVyIHRoYXQgZmFpbHVyZSBpcyBhbiBldmVudCwgbm90IGEgcGVyc29uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiWmlnIFppZ2xhciIKICB9LAogIHsKICAgICJ0ZXh0IjogIktub3dsZWRnZSBoYXMgdGhyZWUgZGVncmVlcyAtIG9waW5pb24sIHNjaWVuY2UsIGlsbHVtaW5hdGlvbi4gVGhlIG1lYW5zIG9yIGluc3RydW1lbnQgb2YgdGhlIGZpcnN0IGlzIHNlbnNlOyBvZiB0aGUgc2Vjb25kLCBkaWFsZWN0aWM7IG9mIHRoZSB0aGlyZCwgaW50dWl0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGxvdGludXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGNhcmUgbm90IHNvIG11Y2ggd2hhdCBJIGFtIHRvIG90aGVycyBhcyB3aGF0IEkgYW0gdG8gbXlzZWxmLiBJIHdpbGwgYmUgcmljaCBieSBteXNlbGYsIGFuZCBub3QgYnkgYm9ycm93aW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWljaGVsIGRlIE1vbnRhaWduZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoaW5ncyB0dXJuIG91dCBiZXN0IGZvciB0aG9zZSB3aG8gbWFrZSB0aGUgYmVzdCBvZiB0aGUgd2F5IHRoaW5ncyB0dXJuIG91dC4iLAogICAgImF0dHJpYnV0aW9uIjogIkphY2sgQnVjayIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB3b3JsZCBtYWtlcyB3YXkgZm9yIHRoZSBtYW4gd2hvIGtub3dzIHdoZXJlIGhlIGlzIGdvaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBtdXN0IHRlbGwgbWUgeW91ciBvcGluaW9ucywgdGVsbCBtZSB3aGF0IHlvdSBiZWxpZXZlIGluLiBJIGhhdmUgcGxlbnR5IG9mIGRvdXRzIG9mIG15IG93bi4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubiBXb2xmZ2FuZyB2b24gR29ldGhlIgogIH0sCiAgewogICAgInRleHQiOiAiTGV0IHlvdXIgaG9vayBhbHdheXMgYmUgY2FzdDsgaW4gdGhlIHBvb2wgd2hlcmUgeW91IGxlYXN0IGV4cGVjdCBpdCwgdGhlcmUgd2lsbCBiZSBhIGZpc2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPdmlkIgogIH0sCiAgewogICAgInRleHQiOiAiVGhvc2Ugd2hvIHdpbGwgcGxheSB3aXRoIGNhdHMgbXVzdCBleHBlY3QgdG8gYmUgc2NyYXRjaGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2VydmFudGVzIgogIH0sCiAgewogICAgInRleHQiOiAiQmV0dGVyIHRoYW4gYSB0aG91c2FuZCBob2xsb3cgd29yZHMsIGlzIG9uZSB3b3JkIHRoYXQgYnJpbmdzIHBlYWNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG9ubHkgcGVyc29uIHdobyBuZXZlciBtYWtlcyBtaXN0YWtlcyBpcyB0aGUgcGVyc29uIHdobyBuZXZlciBkb2VzIGFueXRoaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGVuaXMgV2FpdGxleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIGtpbmQgd2hlbmV2ZXIgcG9zc2libGUuIEl0IGlzIGFsd2F5cyBwb3NzaWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhbGFpIExhbWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZGF5IGFsd2F5cyBsb29rcyBicmlnaHRlciBmcm9tIGJlaGluZCBhIHNtaWxlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiTm8gaXMgZWFzaWVyIHRvIGRvLiBZZXMgaXMgZWFzaWVyIHRvIHNheS4iLAogICAgImF0dHJpYnV0aW9uIjogIkphc29uIEZyaWVkIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIHRoaW5ncyBjaGFuZ2U7IG5vdGhpbmcgcGVyaXNoZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPdmlkIgogIH0sCiAgewogICAgInRleHQiOiAiSGFwcGluZXNzIGlzIG5vdCBpbiB0aGUgbWVyZSBwb3NzZXNzaW9uIG9mIG1vbmV5OyBpdCBsaWVzIGluIHRoZSBqb3kgb2YgYWNoaWV2ZW1lbnQsIGluIHRoZSB0aHJpbGwgb2YgY3JlYXRpdmUgZWZmb3J0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbmtsaW4gUm9vc2V2ZWx0IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHBhaW4gcGFzc2VzLCBidXQgdGhlIGJlYXV0eSByZW1haW5zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGllcnJlIEF1Z3VzdGUgUmVub2lyIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gY2hvb3NlIHdoYXQgaXMgZGlmZmljdWx0IGFsbCBvbmVzIGRheXMsIGFzIGlmIGl0IHdlcmUgZWFzeSwgdGhhdCBpcyBmYWl0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIlcuIEguIEF1ZGVuIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGxldCBnbyBhIGxpdHRsZSwgeW91IHdpbGwgaGF2ZSBhIGxpdHRsZSBwZWFjZS4gSWYgeW91IGxldCBnbyBhIGxvdCwgeW91IHdpbGwgaGF2ZSBhIGxvdCBvZiBwZWFjZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFqYWhuIENoYWgiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeXRoaW5nIHRoYXQgZXhpc3RzIGlzIGluIGEgbWFubmVyIHRoZSBzZWVkIG9mIHRoYXQgd2hpY2ggd2lsbCBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIHNoYWxsIG5ldmVyIGtub3cgYWxsIHRoZSBnb29kIHRoYXQgYSBzaW1wbGUgc21pbGUgY2FuIGRvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTW90aGVyIFRlcmVzYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUgaXMgd2hhdCB5b3UgbWFrZSBvZiBpdC4gQWx3YXlzIGhhcyBiZWVuLCBhbHdheXMgd2lsbCBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkdyYW5kbWEgTW9zZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGZWFycyBhcmUgbm90aGluZyBtb3JlIHRoYW4gYSBzdGF0ZSBvZiBtaW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGJlIHVwc2V0IG92ZXIgd2hhdCB5b3UgZG9uJ3QgaGF2ZSBpcyB0byB3YXN0ZSB3aGF0IHlvdSBkbyBoYXZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2VuIFMuIEtleWVzIgogIH0sCiAgewogICAgInRleHQiOiAiV2Ugc2hvdWxkIGFsbCBiZSB0aGFua2Z1bCBmb3IgdGhvc2UgcGVvcGxlIHdobyByZWtpbmRsZSB0aGUgaW5uZXIgc3Bpcml0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IFNjaHdlaXR6ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgY2hhbmdlIHRoZSB3YXkgeW91IGxvb2sgYXQgdGhpbmdzLCB0aGUgdGhpbmdzIHlvdSBsb29rIGF0IGNoYW5nZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyB2ZXJ5IGVhc3kgdG8gZm9yZ2l2ZSBvdGhlcnMgdGhlaXIgbWlzdGFrZXM7IGl0IHRha2VzIG1vcmUgZ3JpdCB0byBmb3JnaXZlIHRoZW0gZm9yIGhhdmluZyB3aXRuZXNzZWQgeW91ciBvd24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKZXNzYW15biBXZXN0IgogIH0sCiAgewogICAgInRleHQiOiAiS2luZG5lc3MgaXMgdGhlIGdvbGRlbiBjaGFpbiBieSB3aGljaCBzb2NpZXR5IGlzIGJvdW5kIHRvZ2V0aGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9oYW5uIFdvbGZnYW5nIHZvbiBHb2V0aGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZHJlYW0gd2FzIGFsd2F5cyBydW5uaW5nIGFoZWFkIG9mIG1lLiBUbyBjYXRjaCB1cCwgdG8gbGl2ZSBmb3IgYSBtb21lbnQgaW4gdW5pc29uIHdpdGggaXQsIHRoYXQgd2FzIHRoZSBtaXJhY2xlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW5haXMgTmluIgogIH0sCiAgewogICAgInRleHQiOiAiVGhleSBjYW4gY29ucXVlciB3aG8gYmVsaWV2ZSB0aGV5IGNhbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlZpcmdpbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldyaXRlIHlvdXIgcGxhbnMgaW4gcGVuY2lsIGFuZCBnaXZlIEdvZCB0aGUgZXJhc2VyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGF1bG8gQ29lbGhvIgogIH0sCiAgewogICAgInRleHQiOiAiQXMgdGhlIHJlc3Qgb2YgdGhlIHdvcmxkIGlzIHdhbGtpbmcgb3V0IHRoZSBkb29yLCB5b3VyIGJlc3QgZnJpZW5kcyBhcmUgdGhlIG9uZXMgd2Fsa2luZyBpbi4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhcHBpbmVzcyBpcyBhIHBlcmZ1bWUgeW91IGNhbm5vdCBwb3VyIG9uIG90aGVycyB3aXRob3V0IGdldHRpbmcgYSBmZXcgZHJvcHMgb24geW91cnNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBXYWxkbyBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSSBiZWxpZXZlIHRoYXQgd2UgYXJlIHNvbGVseSByZXNwb25zaWJsZSBmb3Igb3VyIGNob2ljZXMsIGFuZCB3ZSBoYXZlIHRvIGFjY2VwdCB0aGUgY29uc2VxdWVuY2VzIG9mIGV2ZXJ5IGRlZWQsIHdvcmQsIGFuZCB0aG91Z2h0IHRocm91Z2hvdXQgb3VyIGxpZmV0aW1lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxpc2FiZXRoIEt1Ymxlci1Sb3NzIgogIH0sCiAgewogICAgInRleHQiOiAiTGV0IHVzIHJlc29sdmUgdG8gYmUgbWFzdGVycywgbm90IHRoZSB2aWN0aW1zLCBvZiBvdXIgaGlzdG9yeSwgY29udHJvbGxpbmcgb3VyIG93biBkZXN0aW55IHdpdGhvdXQgZ2l2aW5nIHdheSB0byBibGluZCBzdXNwaWNpb25zIGFuZCBlbW90aW9ucy4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gS2VubmVkeSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZyZWVkb20gaXMgd2hhdCB5b3UgZG8gd2l0aCB3aGF0J3MgYmVlbiBkb25lIHRvIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIkplYW4tUGF1bCBTYXJ0cmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCdWlsZCBhIGJldHRlciBtb3VzZXRyYXAgYW5kIHRoZSB3b3JsZCB3aWxsIGJlYXQgYSBwYXRoIHRvIHlvdXIgZG9vci4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGV5IGNhbiBkbyBhbGwgYmVjYXVzZSB0aGV5IHRoaW5rIHRoZXkgY2FuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVmlyZ2lsIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGNhbm5vdCBzdGVwIHR3aWNlIGludG8gdGhlIHNhbWUgcml2ZXIsIGZvciBvdGhlciB3YXRlcnMgYXJlIGNvbnRpbnVhbGx5IGZsb3dpbmcgaW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZXJhY2xpdHVzIgogIH0sCiAgewogICAgInRleHQiOiAiUGVyc2V2ZXJhbmNlIGlzIGEgZ3JlYXQgZWxlbWVudCBvZiBzdWNjZXNzLiBJZiB5b3Ugb25seSBrbm9jayBsb25nIGVub3VnaCBhbmQgbG91ZCBlbm91Z2ggYXQgdGhlIGdhdGUsIHlvdSBhcmUgc3VyZSB0byB3YWtlIHVwIHNvbWVib2R5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgTG9uZ2ZlbGxvdyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5ldmVyIHNheSB0aGVyZSBpcyBub3RoaW5nIGJlYXV0aWZ1bCBpbiB0aGUgd29ybGQgYW55IG1vcmUuIFRoZXJlIGlzIGFsd2F5cyBzb21ldGhpbmcgdG8gbWFrZSB5b3Ugd29uZGVyIGluIHRoZSBzaGFwZSBvZiBhIHRyZWUsIHRoZSB0cmVtYmxpbmcgb2YgYSBsZWFmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IFNjaHdlaXR6ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGF0cyB0aGUgcmlzayB5b3UgdGFrZSBpZiB5b3UgY2hhbmdlOiB0aGF0IHBlb3BsZSB5b3UndmUgYmVlbiBpbnZvbHZlZCB3aXRoIHdvbid0IGxpa2UgdGhlIG5ldyB5b3UuIEJ1dCBvdGhlciBwZW9wbGUgd2hvIGRvIHdpbGwgY29tZSBhbG9uZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxpc2EgQWx0aGVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGVuZXJneSBvZiB0aGUgbWluZCBpcyB0aGUgZXNzZW5jZSBvZiBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQXJpc3RvdGxlIgogIH0sCiAgewogICAgInRleHQiOiAiRG9pbmcgbm90aGluZyBpcyBiZXR0ZXIgdGhhbiBiZWluZyBidXN5IGRvaW5nIG5vdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGdldCB1cCBvbmUgbW9yZSB0aW1lIHRoYW4geW91IGZhbGwsIHlvdSB3aWxsIG1ha2UgaXQgdGhyb3VnaC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgdGhpbmcgbG9uZyBleHBlY3RlZCB0YWtlcyB0aGUgZm9ybSBvZiB0aGUgdW5leHBlY3RlZCB3aGVuIGF0IGxhc3QgaXQgY29tZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJrIFR3YWluIgogIH0sCiAgewogICAgInRleHQiOiAiQmVmb3JlIHlvdSBwdXQgb24gYSBmcm93biwgbWFrZSBhYnNvbHV0ZWx5IHN1cmUgdGhlcmUgYXJlIG5vIHNtaWxlcyBhdmFpbGFibGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKaW0gQmVnZ3MiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCeSBnb2luZyBiZXlvbmQgeW91ciBvd24gcHJvYmxlbXMgYW5kIHRha2luZyBjYXJlIG9mIG90aGVycywgeW91IGdhaW4gaW5uZXIgc3RyZW5ndGgsIHNlbGYtY29uZmlkZW5jZSwgY291cmFnZSwgYW5kIGEgZ3JlYXRlciBzZW5zZSBvZiBjYWxtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGFyZSBvbmx5IHR3byBtaXN0YWtlcyBvbmUgY2FuIG1ha2UgYWxvbmcgdGhlIHJvYWQgdG8gdHJ1dGg7IG5vdCBnb2luZyBhbGwgdGhlIHdheSwgYW5kIG5vdCBzdGFydGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5ldmVyIGRvIHRoaW5ncyBvdGhlcnMgY2FuIGRvIGFuZCB3aWxsIGRvLCBpZiB0aGVyZSBhcmUgdGhpbmdzIG90aGVycyBjYW5ub3QgZG8gb3Igd2lsbCBub3QgZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbWVsaWEgRWFyaGFydCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIGFsbCB0aGluZ3Mgb2YgbmF0dXJlIHRoZXJlIGlzIHNvbWV0aGluZyBvZiB0aGUgbWFydmVsbG91cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFyaXN0b3RsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgYmVuZCBpbiB0aGUgcm9hZCBpcyBub3QgdGhlIGVuZCBvZiB0aGUgcm9hZC4uLnVubGVzcyB5b3UgZmFpbCB0byBtYWtlIHRoZSB0dXJuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiTW9yZSBwb3dlcmZ1bCB0aGFuIHRoZSB3aWxsIHRvIHdpbiBpcyB0aGUgY291cmFnZSB0byBiZWdpbi4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGtub3cgZnJvbSBzY2llbmNlIHRoYXQgbm90aGluZyBpbiB0aGUgdW5pdmVyc2UgZXhpc3RzIGFzIGFuIGlzb2xhdGVkIG9yIGluZGVwZW5kZW50IGVudGl0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmdhcmV0IFdoZWF0bGV5IgogIH0sCiAgewogICAgInRleHQiOiAiS2luZCB3b3JkcyBjYW4gYmUgc2hvcnQgYW5kIGVhc3kgdG8gc3BlYWsgYnV0IHRoZWlyIGVjaG9lcyBhcmUgdHJ1bHkgZW5kbGVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vdGhlciBUZXJlc2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgaGF2ZSBrbm93bGVkZ2UsIGxldCBvdGhlcnMgbGlnaHQgdGhlaXIgY2FuZGxlcyBpbiBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmdhcmV0IEZ1bGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIGltcG9zc2libGUgZm9yIGEgbWFuIHRvIGxlYXJuIHdoYXQgaGUgdGhpbmtzIGhlIGFscmVhZHkga25vd3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBnbyBhZ2FpbnN0IHRoZSBkb21pbmFudCB0aGlua2luZyBvZiB5b3VyIGZyaWVuZHMsIG9mIG1vc3Qgb2YgdGhlIHBlb3BsZSB5b3Ugc2VlIGV2ZXJ5IGRheSwgaXMgcGVyaGFwcyB0aGUgbW9zdCBkaWZmaWN1bHQgYWN0IG9mIGhlcm9pc20geW91IGNhbiBwZXJmb3JtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhlb2RvcmUgSC4gV2hpdGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEcmVhbXMgcGFzcyBpbnRvIHRoZSByZWFsaXR5IG9mIGFjdGlvbi4gRnJvbSB0aGUgYWN0aW9ucyBzdGVtcyB0aGUgZHJlYW0gYWdhaW47IGFuZCB0aGlzIGludGVyZGVwZW5kZW5jZSBwcm9kdWNlcyB0aGUgaGlnaGVzdCBmb3JtIG9mIGxpdmluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuYWlzIE5pbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWUgcGVvcGxlIHRocml2ZSBvbiBodWdlLCBkcmFtYXRpYyBjaGFuZ2UuIFNvbWUgcGVvcGxlIHByZWZlciB0aGUgc2xvdyBhbmQgc3RlYWR5IHJvdXRlLiBEbyB3aGF0J3MgcmlnaHQgZm9yIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIkp1bGllIE1vcmdlbnN0ZXJuIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGJlZ2lubmluZyBpcyBhbHdheXMgdG9kYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJ5IFdvbGxzdG9uZWNyYWZ0IgogIH0sCiAgewogICAgInRleHQiOiAiTWVuIGFyZSBkaXN0dXJiZWQgbm90IGJ5IHRoaW5ncywgYnV0IGJ5IHRoZSB2aWV3IHdoaWNoIHRoZXkgdGFrZSBvZiB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXBpY3RldHVzIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIG1lbiB3aG8gaGF2ZSBhY2hpZXZlZCBncmVhdCB0aGluZ3MgaGF2ZSBiZWVuIGdyZWF0IGRyZWFtZXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT3Jpc29uIE1hcmRlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBvZGRzIG9mIGhpdHRpbmcgeW91ciB0YXJnZXQgZ28gdXAgZHJhbWF0aWNhbGx5IHdoZW4geW91IGFpbSBhdCBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hbCBQYW5jb2FzdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIG5vIHNjYXJjaXR5IG9mIG9wcG9ydHVuaXR5IHRvIG1ha2UgYSBsaXZpbmcgYXQgd2hhdCB5b3UgbG92ZTsgdGhlcmVzIG9ubHkgc2NhcmNpdHkgb2YgcmVzb2x2ZSB0byBtYWtlIGl0IGhhcHBlbi4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMZXQgdGhlIGZ1dHVyZSB0ZWxsIHRoZSB0cnV0aCwgYW5kIGV2YWx1YXRlIGVhY2ggb25lIGFjY29yZGluZyB0byBoaXMgd29yayBhbmQgYWNjb21wbGlzaG1lbnRzLiBUaGUgcHJlc2VudCBpcyB0aGVpcnM7IHRoZSBmdXR1cmUsIGZvciB3aGljaCBJIGhhdmUgcmVhbGx5IHdvcmtlZCwgaXMgbWluZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk5pa29sYSBUZXNsYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgd2VlZCBpcyBubyBtb3JlIHRoYW4gYSBmbG93ZXIgaW4gZGlzZ3Vpc2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKYW1lcyBMb3dlbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTaWxlbmNlIGlzIHRoZSB0cnVlIGZyaWVuZCB0aGF0IG5ldmVyIGJldHJheXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBiZSBhd2FyZSBvZiBhIHNpbmdsZSBzaG9ydGNvbWluZyBpbiBvbmVzZWxmIGlzIG1vcmUgdXNlZnVsIHRoYW4gdG8gYmUgYXdhcmUgb2YgYSB0aG91c2FuZCBpbiBzb21lb25lIGVsc2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUZW56aW4gR3lhdHNvIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGxpdmVzIGluIGhhcm1vbnkgd2l0aCBoaW1zZWxmIGxpdmVzIGluIGhhcm1vbnkgd2l0aCB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJjdXMgQXVyZWxpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGdyZWF0IHdhcyBldmVyIGFjaGlldmVkIHdpdGhvdXQgZW50aHVzaWFzbS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgd29ybGQgY2FyZXMgdmVyeSBsaXR0bGUgYWJvdXQgd2hhdCBhIG1hbiBvciB3b21hbiBrbm93czsgaXQgaXMgd2hhdCBhIG1hbiBvciB3b21hbiBpcyBhYmxlIHRvIGRvIHRoYXQgY291bnRzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQm9va2VyIFdhc2hpbmd0b24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyB0aHJvdWdoIHNjaWVuY2UgdGhhdCB3ZSBwcm92ZSwgYnV0IHRocm91Z2ggaW50dWl0aW9uIHRoYXQgd2UgZGlzY292ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKdWxlcyBQb2luY2FyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSByZWFzb25hYmxlIG1hbiBhZGFwdHMgaGltc2VsZiB0byB0aGUgd29ybGQ7IHRoZSB1bnJlYXNvbmFibGUgbWFuIHBlcnNpc3RzIGluIHRyeWluZyB0byBhZGFwdCB0aGUgd29ybGQgdG8gaGltc2VsZi4gVGhlcmVmb3JlLCBhbGwgcHJvZ3Jlc3MgZGVwZW5kcyBvbiB0aGUgdW5yZWFzb25hYmxlIG1hbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkdlb3JnZSBTaGF3IgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCB3ZSBzZWUgZGVwZW5kcyBtYWlubHkgb24gd2hhdCB3ZSBsb29rIGZvci4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gTHViYm9jayIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSdsbCBzZWUgaXQgd2hlbiB5b3UgYmVsaWV2ZSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCeSBsZXR0aW5nIGl0IGdvIGl0IGFsbCBnZXRzIGRvbmUuIFRoZSB3b3JsZCBpcyB3b24gYnkgdGhvc2Ugd2hvIGxldCBpdCBnby4gQnV0IHdoZW4geW91IHRyeSBhbmQgdHJ5LiBUaGUgd29ybGQgaXMgYmV5b25kIHRoZSB3aW5uaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkVhY2ggbWFuIGhhcyBoaXMgb3duIHZvY2F0aW9uOyBoaXMgdGFsZW50IGlzIGhpcyBjYWxsLiBUaGVyZSBpcyBvbmUgZGlyZWN0aW9uIGluIHdoaWNoIGFsbCBzcGFjZSBpcyBvcGVuIHRvIGhpbS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJQZW9wbGUgYXJlIG5vdCBsYXp5LiBUaGV5IHNpbXBseSBoYXZlIGltcG90ZW50IGdvYWxzIC0gdGhhdCBpcywgZ29hbHMgdGhhdCBkbyBub3QgaW5zcGlyZSB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVG9ueSBSb2JiaW5zIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgd2UgbG9vayBhdCB0aGUgd29ybGQgd2l0aCBhIGxvdmUgb2YgbGlmZSwgdGhlIHdvcmxkIHdpbGwgcmV2ZWFsIGl0cyBiZWF1dHkgdG8gdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWlzYWt1IElrZWRhIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlcmUgdGhlcmUgaXMgZ3JlYXQgbG92ZSwgdGhlcmUgYXJlIGFsd2F5cyBtaXJhY2xlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxhIENhdGhlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIG1pc2VyYWJsZS4gT3IgbW90aXZhdGUgeW91cnNlbGYuIFdoYXRldmVyIGhhcyB0byBiZSBkb25lLCBpdCdzIGFsd2F5cyB5b3VyIGNob2ljZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIHdpc2UgbWFuIGNhbiBsZWFybiBtb3JlIGZyb20gYSBmb29saXNoIHF1ZXN0aW9uIHRoYW4gYSBmb29sIGNhbiBsZWFybiBmcm9tIGEgd2lzZSBhbnN3ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCcnVjZSBMZWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiBvbmUgaXMgZXN0cmFuZ2VkIGZyb20gb25lc2VsZiwgdGhlbiBvbmUgaXMgZXN0cmFuZ2VkIGZyb20gb3RoZXJzIHRvby4gSWYgb25lIGlzIG91dCBvZiB0b3VjaCB3aXRoIG9uZXNlbGYsIHRoZW4gb25lIGNhbm5vdCB0b3VjaCBvdGhlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbm5lIExpbmRiZXJnaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUgaXMgcmVhbGx5IHNpbXBsZSwgYnV0IHdlIGluc2lzdCBvbiBtYWtpbmcgaXQgY29tcGxpY2F0ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHcm93dGggaXRzZWxmIGNvbnRhaW5zIHRoZSBnZXJtIG9mIGhhcHBpbmVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlBlYXJsIEJ1Y2siCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB0aGVyZSBpcyBubyBzdHJ1Z2dsZSwgdGhlcmUgaXMgbm8gcHJvZ3Jlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmVkZXJpY2sgRG91Z2xhc3MiCiAgfSwKICB7CiAgICAidGV4dCI6ICJPbmUgb2YgdGhlIGFkdmFudGFnZXMgb2YgYmVpbmcgZGlzb3JkZXJseSBpcyB0aGF0IG9uZSBpcyBjb25zdGFudGx5IG1ha2luZyBleGNpdGluZyBkaXNjb3Zlcmllcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkEuIEEuIE1pbG5lIgogIH0sCiAgewogICAgInRleHQiOiAiUXVhbGl0eSBpcyBuZXZlciBhbiBhY2NpZGVudDsgaXQgaXMgYWx3YXlzIHRoZSByZXN1bHQgb2YgaW50ZWxsaWdlbnQgZWZmb3J0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBSdXNraW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJXYXN0ZSBubyBtb3JlIHRpbWUgYXJndWluZyBhYm91dCB3aGF0IGEgZ29vZCBtYW4gc2hvdWxkIGJlLiBCZSBvbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJjdXMgQXVyZWxpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJKdXN0IHRydXN0IHlvdXJzZWxmLCB0aGVuIHlvdSB3aWxsIGtub3cgaG93IHRvIGxpdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHb2V0aGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBjbGltYiBzdGVlcCBoaWxscyByZXF1aXJlcyBhIHNsb3cgcGFjZSBhdCBmaXJzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gU2hha2VzcGVhcmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGcmllbmRzaGlwIGlzbid0IGEgYmlnIHRoaW5nLiBJdCdzIGEgbWlsbGlvbiBsaXR0bGUgdGhpbmdzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiT25lIHNlY3JldCBvZiBzdWNjZXNzIGluIGxpZmUgaXMgZm9yIGEgbWFuIHRvIGJlIHJlYWR5IGZvciBoaXMgb3Bwb3J0dW5pdHkgd2hlbiBpdCBjb21lcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJlbmphbWluIERpc3JhZWxpIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgaXMgYSB3aXNlIG1hbiB3aG8gZG9lcyBub3QgZ3JpZXZlIGZvciB0aGUgdGhpbmdzIHdoaWNoIGhlIGhhcyBub3QsIGJ1dCByZWpvaWNlcyBmb3IgdGhvc2Ugd2hpY2ggaGUgaGFzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXBpY3RldHVzIgogIH0sCiAgewogICAgInRleHQiOiAiSSBjYW5ub3QgYWx3YXlzIGNvbnRyb2wgd2hhdCBnb2VzIG9uIG91dHNpZGUuIEJ1dCBJIGNhbiBhbHdheXMgY29udHJvbCB3aGF0IGdvZXMgb24gaW5zaWRlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWV0aW1lcyB0aGUgYmlnZ2VzdCBhY3Qgb2YgY291cmFnZSBpcyBhIHNtYWxsIG9uZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhdXJlbiBSYWZmbyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdlbml1cyBpcyBvbmUgcGVyY2VudCBpbnNwaXJhdGlvbiBhbmQgbmluZXR5LW5pbmUgcGVyY2VudCBwZXJzcGlyYXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaG9tYXMgRWRpc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSnVzdCBhcyBhIGNhbmRsZSBjYW5ub3QgYnVybiB3aXRob3V0IGZpcmUsIG1lbiBjYW5ub3QgbGl2ZSB3aXRob3V0IGEgc3Bpcml0dWFsIGxpZmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UncmUgbm90IG9ibGlnYXRlZCB0byB3aW4uIFlvdSdyZSBvYmxpZ2F0ZWQgdG8ga2VlcCB0cnlpbmcgdG8gZG8gdGhlIGJlc3QgeW91IGNhbiBldmVyeSBkYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJpYW4gRWRlbG1hbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIHRoZSBza3ksIHRoZXJlIGlzIG5vIGRpc3RpbmN0aW9uIG9mIGVhc3QgYW5kIHdlc3Q7IHBlb3BsZSBjcmVhdGUgZGlzdGluY3Rpb25zIG91dCBvZiB0aGVpciBvd24gbWluZHMgYW5kIHRoZW4gYmVsaWV2ZSB0aGVtIHRvIGJlIHRydWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaXRoIGNvdXJhZ2UgeW91IHdpbGwgZGFyZSB0byB0YWtlIHJpc2tzLCBoYXZlIHRoZSBzdHJlbmd0aCB0byBiZSBjb21wYXNzaW9uYXRlLCBhbmQgdGhlIHdpc2RvbSB0byBiZSBodW1ibGUuIENvdXJhZ2UgaXMgdGhlIGZvdW5kYXRpb24gb2YgaW50ZWdyaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2VzaGF2YW4gTmFpciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIHlvdXIgb3duIGhlcm8sIGl0J3MgY2hlYXBlciB0aGFuIGEgbW92aWUgdGlja2V0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRG91ZyBIb3J0b24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGNvdWxkIGJlIHdvcnNlIHRoYW4gdGhlIGZlYXIgdGhhdCBvbmUgaGFkIGdpdmVuIHVwIHRvbyBzb29uLCBhbmQgbGVmdCBvbmUgdW5leHBlbmRlZCBlZmZvcnQgdGhhdCBtaWdodCBoYXZlIHNhdmVkIHRoZSB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkphbmUgQWRkYW1zIgogIH0sCiAgewogICAgInRleHQiOiAiSW4gdGhlIGVuZCB3ZSByZXRhaW4gZnJvbSBvdXIgc3R1ZGllcyBvbmx5IHRoYXQgd2hpY2ggd2UgcHJhY3RpY2FsbHkgYXBwbHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2hhbm4gV29sZmdhbmcgdm9uIEdvZXRoZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJhZCB0aGluZ3MgYXJlIG5vdCB0aGUgd29yc3QgdGhpbmdzIHRoYXQgY2FuIGhhcHBlbiB0byB1cy4gTm90aGluZyBpcyB0aGUgd29yc3QgdGhpbmcgdGhhdCBjYW4gaGFwcGVuIHRvIHVzISIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiTm90aGluZyBldmVyIGdvZXMgYXdheSB1bnRpbCBpdCBoYXMgdGF1Z2h0IHVzIHdoYXQgd2UgbmVlZCB0byBrbm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVtYSBDaG9kcm9uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGtleSB0byBncm93dGggaXMgdGhlIGludHJvZHVjdGlvbiBvZiBoaWdoZXIgZGltZW5zaW9ucyBvZiBjb25zY2lvdXNuZXNzIGludG8gb3VyIGF3YXJlbmVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTdWNjZXNzZnVsIHBlb3BsZSBhc2sgYmV0dGVyIHF1ZXN0aW9ucywgYW5kIGFzIGEgcmVzdWx0LCB0aGV5IGdldCBiZXR0ZXIgYW5zd2Vycy4iLAogICAgImF0dHJpYnV0aW9uIjogIlRvbnkgUm9iYmlucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGV4aXN0IGlzIHRvIGNoYW5nZSwgdG8gY2hhbmdlIGlzIHRvIG1hdHVyZSwgdG8gbWF0dXJlIGlzIHRvIGdvIG9uIGNyZWF0aW5nIG9uZXNlbGYgZW5kbGVzc2x5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucmkgQmVyZ3NvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSB3YW50IHRvIHN0dWR5IHlvdXJzZWxmIC0gbG9vayBpbnRvIHRoZSBoZWFydHMgb2Ygb3RoZXIgcGVvcGxlLiBJZiB5b3Ugd2FudCB0byBzdHVkeSBvdGhlciBwZW9wbGUgLSBsb29rIGludG8geW91ciBvd24gaGVhcnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmllZHJpY2ggdm9uIFNjaGlsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiVHJ1dGggaXMgcG93ZXJmdWwgYW5kIGl0IHByZXZhaWxzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU29qb3VybmVyIFRydXRoIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpc24ndCBhYm91dCBmaW5kaW5nIHlvdXJzZWxmLiBMaWZlIGlzIGFib3V0IGNyZWF0aW5nIHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVybmFyZCBTaGF3IgogIH0sCiAgewogICAgInRleHQiOiAiQSBzdWJ0bGUgdGhvdWdodCB0aGF0IGlzIGluIGVycm9yIG1heSB5ZXQgZ2l2ZSByaXNlIHRvIGZydWl0ZnVsIGlucXVpcnkgdGhhdCBjYW4gZXN0YWJsaXNoIHRydXRocyBvZiBncmVhdCB2YWx1ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIklzYWFjIEFzaW1vdiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNvbmZsaWN0IGlzIHRoZSBnYWRmbHkgb2YgdGhvdWdodC4gSXQgc3RpcnMgdXMgdG8gb2JzZXJ2YXRpb24gYW5kIG1lbW9yeS4gSXQgaW5zdGlnYXRlcyB0byBpbnZlbnRpb24uIEl0IHNob2NrcyB1cyBvdXQgb2Ygc2hlZXBsaWtlIHBhc3Npdml0eSwgYW5kIHNldHMgdXMgYXQgbm90aW5nIGFuZCBjb250cml2aW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBEZXdleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzdGVlcGVyIHRoZSBtb3VudGFpbiB0aGUgaGFyZGVyIHRoZSBjbGltYiB0aGUgYmV0dGVyIHRoZSB2aWV3IGZyb20gdGhlIGZpbmlzaGluZyBsaW5lIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHBlcmZvcm1hbmNlIGV4Y2VlZHMgYW1iaXRpb24sIHRoZSBvdmVybGFwIGlzIGNhbGxlZCBzdWNjZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ3VsbGVuIEhpZ2h0b3dlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWVvbmUgaXMgc3BlY2lhbCBvbmx5IGlmIHlvdSB0ZWxsIHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWV0aW1lcyBpdCBpcyBiZXR0ZXIgdG8gbG9zZSBhbmQgZG8gdGhlIHJpZ2h0IHRoaW5nIHRoYW4gdG8gd2luIGFuZCBkbyB0aGUgd3JvbmcgdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb255IEJsYWlyIgogIH0sCiAgewogICAgInRleHQiOiAiSG9wZSBhcm91c2VzLCBhcyBub3RoaW5nIGVsc2UgY2FuIGFyb3VzZSwgYSBwYXNzaW9uIGZvciB0aGUgcG9zc2libGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIFNsb2FuZSBDb2ZmaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJBY3Rpb24gaXMgdGhlIGZvdW5kYXRpb25hbCBrZXkgdG8gYWxsIHN1Y2Nlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQYWJsbyBQaWNhc3NvIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHRyb3VibGUgd2l0aCBtb3N0IHBlb3BsZSBpcyB0aGF0IHRoZXkgdGhpbmsgd2l0aCB0aGVpciBob3BlcyBvciBmZWFycyBvciB3aXNoZXMgcmF0aGVyIHRoYW4gd2l0aCB0aGVpciBtaW5kcy4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGwgRHVyYW50IgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIG1lbiBoYXZlIGEgc3dlZXRuZXNzIGluIHRoZWlyIGxpZmUuIFRoYXQgaXMgd2hhdCBoZWxwcyB0aGVtIGdvIG9uLiBJdCBpcyB0b3dhcmRzIHRoYXQgdGhleSB0dXJuIHdoZW4gdGhleSBmZWVsIHRvbyB3b3JuIG91dC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBDYW11cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBjb3Ntb3MgaXMgbmVpdGhlciBtb3JhbCBvciBpbW1vcmFsOyBvbmx5IHBlb3BsZSBhcmUuIEhlIHdobyB3b3VsZCBtb3ZlIHRoZSB3b3JsZCBtdXN0IGZpcnN0IG1vdmUgaGltc2VsZi4iLAogICAgImF0dHJpYnV0aW9uIjogIkVkd2FyZCBFcmljc29uIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCB3b3JyaWVzIHlvdSBtYXN0ZXJzIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIkhhZGRvbiBSb2JpbnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIG9ubHkgd2hlbiB0aGUgbWluZCBhbmQgY2hhcmFjdGVyIHNsdW1iZXIgdGhhdCB0aGUgZHJlc3MgY2FuIGJlIHNlZW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBXYWxkbyBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgdGhlIG1hcmsgb2YgYW4gZWR1Y2F0ZWQgbWluZCB0byBiZSBhYmxlIHRvIGVudGVydGFpbiBhIHRob3VnaHQgd2l0aG91dCBhY2NlcHRpbmcgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBcmlzdG90bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMZXQgbWUgdGVsbCB5b3UgdGhlIHNlY3JldCB0aGF0IGhhcyBsZWQgbWUgdG8gbXkgZ29hbDogbXkgc3RyZW5ndGggbGllcyBzb2xlbHkgaW4gbXkgdGVuYWNpdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMb3VpcyBQYXN0ZXVyIgogIH0sCiAgewogICAgInRleHQiOiAiRmVhciBub3QgZm9yIHRoZSBmdXR1cmUsIHdlZXAgbm90IGZvciB0aGUgcGFzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlBlcmN5IFNoZWxsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUcmFuc2Zvcm1hdGlvbiBkb2Vzbid0IHRha2UgcGxhY2Ugd2l0aCBhIHZhY3V1bTsgaW5zdGVhZCwgaXQgb2NjdXJzIHdoZW4gd2UgYXJlIGluZGlyZWN0bHkgYW5kIGRpcmVjdGx5IGNvbm5lY3RlZCB0byBhbGwgdGhvc2UgYXJvdW5kIHVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBhaW0gYWJvdmUgdGhlIG1hcmsgdG8gaGl0IHRoZSBtYXJrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFsbCBmaXhlZCBzZXQgcGF0dGVybnMgYXJlIGluY2FwYWJsZSBvZiBhZGFwdGFiaWxpdHkgb3IgcGxpYWJpbGl0eS4gVGhlIHRydXRoIGlzIG91dHNpZGUgb2YgYWxsIGZpeGVkIHBhdHRlcm5zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJ1Y2UgTGVlIgogIH0sCiAgewogICAgInRleHQiOiAiU29tZXRpbWVzIGJ5IGxvc2luZyBhIGJhdHRsZSB5b3UgZmluZCBhIG5ldyB3YXkgdG8gd2luIHRoZSB3YXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb25hbGQgVHJ1bXAiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3Ugd291bGQgdGFrZSwgeW91IG11c3QgZmlyc3QgZ2l2ZSwgdGhpcyBpcyB0aGUgYmVnaW5uaW5nIG9mIGludGVsbGlnZW5jZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2Fubm90IGZpbmQgeW91cnNlbGYgYnkgZ29pbmcgaW50byB0aGUgcGFzdC4gWW91IGNhbiBmaW5kIHlvdXJzZWxmIGJ5IGNvbWluZyBpbnRvIHRoZSBwcmVzZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWNraGFydCBUb2xsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgaGF2ZSBqdXN0IHRocmVlIHRoaW5ncyB0byB0ZWFjaDogc2ltcGxpY2l0eSwgcGF0aWVuY2UsIGNvbXBhc3Npb24uIFRoZXNlIHRocmVlIGFyZSB5b3VyIGdyZWF0ZXN0IHRyZWFzdXJlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgbWF5IHNheSBJbSBhIGRyZWFtZXIsIGJ1dCBJbSBub3QgdGhlIG9ubHkgb25lLCBJIGhvcGUgc29tZWRheSB5b3Ugd2lsbCBqb2luIHVzLCBhbmQgdGhlIHdvcmxkIHdpbGwgbGl2ZSBhcyBvbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIExlbm5vbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk91ciBwYXNzaW9uIGlzIG91ciBzdHJlbmd0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJpbGxpZSBBcm1zdHJvbmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBoZWxsIHdpdGggY2lyY3Vtc3RhbmNlczsgSSBjcmVhdGUgb3Bwb3J0dW5pdGllcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJydWNlIExlZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxvb2sgYmFjayBvdmVyIHRoZSBwYXN0LCB3aXRoIGl0cyBjaGFuZ2luZyBlbXBpcmVzIHRoYXQgcm9zZSBhbmQgZmVsbCwgYW5kIHlvdSBjYW4gZm9yZXNlZSB0aGUgZnV0dXJlLCB0b28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJjdXMgQXVyZWxpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgbXVzdCB0cmFpbiB5b3VyIGludHVpdGlvbiwgeW91IG11c3QgdHJ1c3QgdGhlIHNtYWxsIHZvaWNlIGluc2lkZSB5b3Ugd2hpY2ggdGVsbHMgeW91IGV4YWN0bHkgd2hhdCB0byBzYXksIHdoYXQgdG8gZGVjaWRlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSW5ncmlkIEJlcmdtYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJPdXIgZ3JlYXRlc3QgZ2xvcnkgaXMgbm90IGluIG5ldmVyIGZhbGxpbmcsIGJ1dCBpbiByaXNpbmcgZXZlcnkgdGltZSB3ZSBmYWxsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgYXJlIG5vIGxpbWl0YXRpb25zIHRvIHRoZSBtaW5kIGV4Y2VwdCB0aG9zZSB3ZSBhY2tub3dsZWRnZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEhpbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZSBhcyB5b3Ugd2lzaCB0byBzZWVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU29jcmF0ZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgbW9yZSB5b3Uga25vdyB5b3Vyc2VsZiwgdGhlIG1vcmUgeW91IGZvcmdpdmUgeW91cnNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGNhbid0IGltYWdpbmUgYSBwZXJzb24gYmVjb21pbmcgYSBzdWNjZXNzIHdobyBkb2Vzbid0IGdpdmUgdGhpcyBnYW1lIG9mIGxpZmUgZXZlcnl0aGluZyBoZXMgZ290LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2FsdGVyIENyb25raXRlIgogIH0sCiAgewogICAgInRleHQiOiAiTWVtb3J5IGlzIHRoZSBtb3RoZXIgb2YgYWxsIHdpc2RvbS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNhbXVlbCBKb2huc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IHRha2UgZWFjaCBjaGFsbGVuZ2Ugb25lIHN0ZXAgYXQgYSB0aW1lLCB3aXRoIGZhaXRoIGluIGV2ZXJ5IGZvb3RzdGVwLCB5b3VyIHN0cmVuZ3RoIGFuZCB1bmRlcnN0YW5kaW5nIHdpbGwgaW5jcmVhc2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKYW1lcyBGYXVzdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IHdhaXQgZm9yIHBlb3BsZSB0byBiZSBmcmllbmRseS4gU2hvdyB0aGVtIGhvdy4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHdlIGNvdWxkIHNlZSB0aGUgbWlyYWNsZSBvZiBhIHNpbmdsZSBmbG93ZXIgY2xlYXJseSwgb3VyIHdob2xlIGxpZmUgd291bGQgY2hhbmdlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgYXJlIHNoYXBlZCBieSBvdXIgdGhvdWdodHM7IHdlIGJlY29tZSB3aGF0IHdlIHRoaW5rLiBXaGVuIHRoZSBtaW5kIGlzIHB1cmUsIGpveSBmb2xsb3dzIGxpa2UgYSBzaGFkb3cgdGhhdCBuZXZlciBsZWF2ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHaXZpbmcgdXAgZG9lc24ndCBhbHdheXMgbWVhbiB5b3UgYXJlIHdlYWs7IHNvbWV0aW1lcyBpdCBtZWFucyB0aGF0IHlvdSBhcmUgc3Ryb25nIGVub3VnaCB0byBsZXQgZ28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBub3QgaW4gdGhlIHN0YXJzIHRvIGhvbGQgb3VyIGRlc3RpbnkgYnV0IGluIG91cnNlbHZlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gU2hha2VzcGVhcmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbywgb3IgZG8gbm90LiBUaGVyZSBpcyBubyB0cnkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJZb2RhIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIHNlcmlvdXMgZGFyaW5nIHN0YXJ0cyBmcm9tIHdpdGhpbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkhhcnJpZXQgQmVlY2hlciBTdG93ZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW5ldmVyIHlvdSBoYXZlIGVsaW1pbmF0ZWQgdGhlIGltcG9zc2libGUsIHdoYXRldmVyIHJlbWFpbnMsIGhvd2V2ZXIgaW1wcm9iYWJsZSwgbXVzdCBiZSB0aGUgdHJ1dGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBcnRodXIgQ29uYW4gRG95bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgdGhvdWdodCBtYW5pZmVzdHMgYXMgdGhlIHdvcmQuIFRoZSB3b3JkIG1hbmlmZXN0cyBhcyB0aGUgZGVlZC4gVGhlIGRlZWQgZGV2ZWxvcHMgaW50byBoYWJpdC4gQW5kIHRoZSBoYWJpdCBoYXJkZW5zIGludG8gY2hhcmFjdGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiQ291cmFnZSBpcyBub3QgYWJvdXQgdGFraW5nIHJpc2tzIHVua25vd2luZ2x5IGJ1dCBwdXR0aW5nIHlvdXIgb3duIGJlaW5nIGluIGZyb250IG9mIGNoYWxsZW5nZXMgdGhhdCBvdGhlcnMgbWF5IG5vdCBiZSBhYmxlIHRvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYXdhcmVuZXNzIG9mIG91ciBvd24gc3RyZW5ndGggbWFrZXMgdXMgbW9kZXN0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGF1bCBDZXphbm5lIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IG1heSBvbmx5IGJlIHNvbWVvbmUgaW4gdGhlIHdvcmxkLCBidXQgdG8gc29tZW9uZSBlbHNlLCB5b3UgbWF5IGJlIHRoZSB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIG9uZSB0aGluZyB5b3UgaGF2ZSBnb3QgdG8gbGVhcm4gYWJvdXQgb3VyIG1vdmVtZW50LiBUaHJlZSBwZW9wbGUgYXJlIGJldHRlciB0aGFuIG5vIHBlb3BsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkZhbm5pZSBIYW1lciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBncmVhdGVzdCBvYnN0YWNsZSB0byBjb25uZWN0aW5nIHdpdGggb3VyIGpveSBpcyByZXNlbnRtZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVtYSBDaG9kcm9uIgogIH0sCiAgewogICAgInRleHQiOiAiQ29udGludW91cyBlZmZvcnQgLSBub3Qgc3RyZW5ndGggb3IgaW50ZWxsaWdlbmNlIC0gaXMgdGhlIGtleSB0byB1bmxvY2tpbmcgb3VyIHBvdGVudGlhbC4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiV2l0aG91dCBjb3VyYWdlLCB3aXNkb20gYmVhcnMgbm8gZnJ1aXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCYWx0YXNhciBHcmFjaWFuIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiBkZWVkcyBzcGVhaywgd29yZHMgYXJlIG5vdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBZnJpY2FuIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeW9uZSBzbWlsZXMgaW4gdGhlIHNhbWUgbGFuZ3VhZ2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIHN0dW1ibGUgbWF5IHByZXZlbnQgYSBmYWxsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgd2UgZGlkIHRoZSB0aGluZ3Mgd2UgYXJlIGNhcGFibGUgb2YsIHdlIHdvdWxkIGFzdG91bmQgb3Vyc2VsdmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIEVkaXNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIGdsYWQgb2YgbGlmZSBiZWNhdXNlIGl0IGdpdmVzIHlvdSB0aGUgY2hhbmNlIHRvIGxvdmUsIHRvIHdvcmssIHRvIHBsYXksIGFuZCB0byBsb29rIHVwIGF0IHRoZSBzdGFycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbnJ5IFZhbiBEeWtlIgogIH0sCiAgewogICAgInRleHQiOiAiRGVlcCBsaXN0ZW5pbmcgaXMgbWlyYWN1bG91cyBmb3IgYm90aCBsaXN0ZW5lciBhbmQgc3BlYWtlci5XaGVuIHNvbWVvbmUgcmVjZWl2ZXMgdXMgd2l0aCBvcGVuLWhlYXJ0ZWQsIG5vbi1qdWRnaW5nLCBpbnRlbnNlbHkgaW50ZXJlc3RlZCBsaXN0ZW5pbmcsIG91ciBzcGlyaXRzIGV4cGFuZC4iLAogICAgImF0dHJpYnV0aW9uIjogIlN1ZSBQYXR0b24gVGhvZWxlIgogIH0sCiAgewogICAgInRleHQiOiAiQnkgbGl2aW5nIGRlZXBseSBpbiB0aGUgcHJlc2VudCBtb21lbnQgd2UgY2FuIHVuZGVyc3RhbmQgdGhlIHBhc3QgYmV0dGVyIGFuZCB3ZSBjYW4gcHJlcGFyZSBmb3IgYSBiZXR0ZXIgZnV0dXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhpY2ggTmhhdCBIYW5oIgogIH0sCiAgewogICAgInRleHQiOiAiQSBsb3Qgb2YgcGVvcGxlIGdpdmUgdXAganVzdCBiZWZvcmUgdGhleXJlIGFib3V0IHRvIG1ha2UgaXQuIFlvdSBrbm93IHlvdSBuZXZlciBrbm93IHdoZW4gdGhhdCBuZXh0IG9ic3RhY2xlIGlzIGdvaW5nIHRvIGJlIHRoZSBsYXN0IG9uZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNodWNrIE5vcnJpcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB0aGluZ3MgdGhhdCBvbmUgbW9zdCB3YW50cyB0byBkbyBhcmUgdGhlIHRoaW5ncyB0aGF0IGFyZSBwcm9iYWJseSBtb3N0IHdvcnRoIGRvaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2luaWZyZWQgSG9sdGJ5IgogIH0sCiAgewogICAgInRleHQiOiAiVG8ga25vdyB5b3VyIHB1cnBvc2UgaXMgdG8gbGl2ZSBhIGxpZmUgb2YgZGlyZWN0aW9uLCBhbmQgaW4gdGhhdCBkaXJlY3Rpb24gaXMgZm91bmQgcGVhY2UgYW5kIHRyYW5xdWlsbGl0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGRvb3JzIHdlIG9wZW4gYW5kIGNsb3NlIGVhY2ggZGF5IGRlY2lkZSB0aGUgbGl2ZXMgd2UgbGl2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkZsb3JhIFdoaXR0ZW1vcmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaG9zZSB3aG8gdHJ5IHRvIGRvIHNvbWV0aGluZyBhbmQgZmFpbCBhcmUgaW5maW5pdGVseSBiZXR0ZXIgdGhhbiB0aG9zZSB3aG8gdHJ5IG5vdGhpbmcgYW5kIHN1Y2NlZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMbG95ZCBKb25lcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgZ29vZCB0ZWFjaGVyIGlzIGxpa2UgYSBjYW5kbGUgLSBpdCBjb25zdW1lcyBpdHNlbGYgdG8gbGlnaHQgdGhlIHdheSBmb3Igb3RoZXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGNvbnF1ZXJzIG90aGVycyBpcyBzdHJvbmc7IEhlIHdobyBjb25xdWVycyBoaW1zZWxmIGlzIG1pZ2h0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbHdheXMgYmUgbWluZGZ1bCBvZiB0aGUga2luZG5lc3MgYW5kIG5vdCB0aGUgZmF1bHRzIG9mIG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUgYSBjdWxtaW5hdGlvbiBvZiB0aGUgcGFzdCwgYW4gYXdhcmVuZXNzIG9mIHRoZSBwcmVzZW50LCBhbiBpbmRpY2F0aW9uIG9mIHRoZSBmdXR1cmUgYmV5b25kIGtub3dsZWRnZSwgdGhlIHF1YWxpdHkgdGhhdCBnaXZlcyBhIHRvdWNoIG9mIGRpdmluaXR5IHRvIG1hdHRlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoYXJsZXMgQS4gTGluZGJlcmdoIgogIH0sCiAgewogICAgInRleHQiOiAiU3VjY2VzcyBpcyBkZXRlcm1pbmVkIGJ5IHRob3NlIHdob20gcHJvdmUgdGhlIGltcG9zc2libGUsIHBvc3NpYmxlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmFtZXMgUGVuY2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZ3JlYXRlc3QgcmVtZWR5IGZvciBhbmdlciBpcyBkZWxheS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNlbmVjYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdXIgd29yc3QgZW5lbXkgY2Fubm90IGhhcm0geW91IGFzIG11Y2ggYXMgeW91ciBvd24gdW5ndWFyZGVkIHRob3VnaHRzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG1vc3QgcHJlY2lvdXMgZ2lmdCB3ZSBjYW4gb2ZmZXIgYW55b25lIGlzIG91ciBhdHRlbnRpb24uIFdoZW4gbWluZGZ1bG5lc3MgZW1icmFjZXMgdGhvc2Ugd2UgbG92ZSwgdGhleSB3aWxsIGJsb29tIGxpa2UgZmxvd2Vycy4iLAogICAgImF0dHJpYnV0aW9uIjogIlRoaWNoIE5oYXQgSGFuaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgbWFuIG1heSBmdWxmaWwgdGhlIG9iamVjdCBvZiBoaXMgZXhpc3RlbmNlIGJ5IGFza2luZyBhIHF1ZXN0aW9uIGhlIGNhbm5vdCBhbnN3ZXIsIGFuZCBhdHRlbXB0aW5nIGEgdGFzayBoZSBjYW5ub3QgYWNoaWV2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk9saXZlciBIb2xtZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBmcmVlIHVzIGZyb20gdGhlIGV4cGVjdGF0aW9ucyBvZiBvdGhlcnMsIHRvIGdpdmUgdXMgYmFjayB0byBvdXJzZWx2ZXMgLSB0aGVyZSBsaWVzIHRoZSBncmVhdCwgc2luZ3VsYXIgcG93ZXIgb2Ygc2VsZi1yZXNwZWN0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9hbiBEaWRpb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBiZWxpZXZlIHdoYXQgeW91ciBleWVzIGFyZSB0ZWxsaW5nIHlvdS4gQWxsIHRoZXkgc2hvdyBpcyBsaW1pdGF0aW9uLiBMb29rIHdpdGggeW91ciB1bmRlcnN0YW5kaW5nLCBmaW5kIG91dCB3aGF0IHlvdSBhbHJlYWR5IGtub3csIGFuZCB5b3UnbGwgc2VlIHRoZSB3YXkgdG8gZmx5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiTm90aGluZyBpcyBhdCBsYXN0IHNhY3JlZCBidXQgdGhlIGludGVncml0eSBvZiB5b3VyIG93biBtaW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRha2UgaXQgZWFzeSAtIGJ1dCB0YWtlIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV29vZHkgR3V0aHJpZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGNoYW5nZSBvbmVzIGxpZmUsIHN0YXJ0IGltbWVkaWF0ZWx5LCBkbyBpdCBmbGFtYm95YW50bHksIG5vIGV4Y2VwdGlvbnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIEphbWVzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGxlc3MgZWZmb3J0LCB0aGUgZmFzdGVyIGFuZCBtb3JlIHBvd2VyZnVsIHlvdSB3aWxsIGJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJ1Y2UgTGVlIgogIH0sCiAgewogICAgInRleHQiOiAiRWl0aGVyIEkgd2lsbCBmaW5kIGEgd2F5LCBvciBJIHdpbGwgbWFrZSBvbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQaGlsaXAgU2lkbmV5IgogIH0sCiAgewogICAgInRleHQiOiAiQW4gb2JzdGFjbGUgbWF5IGJlIGVpdGhlciBhIHN0ZXBwaW5nIHN0b25lIG9yIGEgc3R1bWJsaW5nIGJsb2NrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiSSd2ZSBuZXZlciBzZWVuIGEgc21pbGluZyBmYWNlIHRoYXQgd2FzIG5vdCBiZWF1dGlmdWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIGlzIHdoYXQgaGFwcGVucyB0byB5b3Ugd2hpbGUgeW91J3JlIGJ1c3kgbWFraW5nIG90aGVyIHBsYW5zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBMZW5ub24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJMb3ZlIGFsbCwgdHJ1c3QgYSBmZXcsIGRvIHdyb25nIHRvIG5vbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIFNoYWtlc3BlYXJlIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGRvbid0IGtub3cgd2hlcmUgeW91IGFyZSBnb2luZywgeW91IHdpbGwgcHJvYmFibHkgZW5kIHVwIHNvbWV3aGVyZSBlbHNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGF3cmVuY2UgUGV0ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgYmxvY2sgeW91ciBkcmVhbSB3aGVuIHlvdSBhbGxvdyB5b3VyIGZlYXIgdG8gZ3JvdyBiaWdnZXIgdGhhbiB5b3VyIGZhaXRoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyeSBNb3JyaXNzZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIYXBwaW5lc3MgZGVwZW5kcyB1cG9uIG91cnNlbHZlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFyaXN0b3RsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklnbm9yYW50IG1lbiBkb24ndCBrbm93IHdoYXQgZ29vZCB0aGV5IGhvbGQgaW4gdGhlaXIgaGFuZHMgdW50aWwgdGhleSd2ZSBmbHVuZyBpdCBhd2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiU29waG9jbGVzIgogIH0sCiAgewogICAgInRleHQiOiAiQW55b25lIHdobyBkb2Vzbid0IHRha2UgdHJ1dGggc2VyaW91c2x5IGluIHNtYWxsIG1hdHRlcnMgY2Fubm90IGJlIHRydXN0ZWQgaW4gbGFyZ2Ugb25lcyBlaXRoZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIGlzIHRoZSBmbG93ZXIgZm9yIHdoaWNoIGxvdmUgaXMgdGhlIGhvbmV5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVmljdG9yIEh1Z28iCiAgfSwKICB7CiAgICAidGV4dCI6ICJBZHZlcnNpdHkgaGFzIHRoZSBlZmZlY3Qgb2YgZWxpY2l0aW5nIHRhbGVudHMsIHdoaWNoIGluIHByb3NwZXJvdXMgY2lyY3Vtc3RhbmNlcyB3b3VsZCBoYXZlIGxhaW4gZG9ybWFudC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhvcmFjZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpbWl0YXRpb25zIGxpdmUgb25seSBpbiBvdXIgbWluZHMuIEJ1dCBpZiB3ZSB1c2Ugb3VyIGltYWdpbmF0aW9ucywgb3VyIHBvc3NpYmlsaXRpZXMgYmVjb21lIGxpbWl0bGVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkphbWllIFBhb2xpbmV0dGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUcnV0aCwgYW5kIGdvb2RuZXNzLCBhbmQgYmVhdXR5IGFyZSBidXQgZGlmZmVyZW50IGZhY2VzIG9mIHRoZSBzYW1lIGFsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSBhcmlzZSBpbiB0aGUgbW9ybmluZywgdGhpbmsgb2Ygd2hhdCBhIHByZWNpb3VzIHByaXZpbGVnZSBpdCBpcyB0byBiZSBhbGl2ZSAtIHRvIGJyZWF0aGUsIHRvIHRoaW5rLCB0byBlbmpveSwgdG8gbG92ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZvciBzdWNjZXNzLCBhdHRpdHVkZSBpcyBlcXVhbGx5IGFzIGltcG9ydGFudCBhcyBhYmlsaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGFycnkgQmFua3MiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBcmd1ZSBmb3IgeW91ciBsaW1pdGF0aW9ucywgYW5kIHN1cmUgZW5vdWdoIHRoZXkncmUgeW91cnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBub3RoaW5nIGxpa2UgcmV0dXJuaW5nIHRvIGEgcGxhY2UgdGhhdCByZW1haW5zIHVuY2hhbmdlZCB0byBmaW5kIHRoZSB3YXlzIGluIHdoaWNoIHlvdSB5b3Vyc2VsZiBoYXZlIGFsdGVyZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOZWxzb24gTWFuZGVsYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSByZWFsIG1lYXN1cmUgb2YgeW91ciB3ZWFsdGggaXMgaG93IG11Y2ggeW91ZCBiZSB3b3J0aCBpZiB5b3UgbG9zdCBhbGwgeW91ciBtb25leS4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFzIG91ciBjYXNlIGlzIG5ldywgd2UgbXVzdCB0aGluayBhbmQgYWN0IGFuZXcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBYnJhaGFtIExpbmNvbG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBjb21tb24gc2Vuc2UgdG8gdGFrZSBhIG1ldGhvZCBhbmQgdHJ5IGl0LiBJZiBpdCBmYWlscywgYWRtaXQgaXQgZnJhbmtseSBhbmQgdHJ5IGFub3RoZXIuIEJ1dCBhYm92ZSBhbGwsIHRyeSBzb21ldGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmFua2xpbiBELiBSb29zZXZlbHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCaXRlIG9mZiBtb3JlIHRoYW4geW91IGNhbiBjaGV3LCB0aGVuIGNoZXcgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGxhIFdpbGxpYW1zIgogIH0sCiAgewogICAgInRleHQiOiAiU2V0IHlvdXIgZ29hbHMgaGlnaCwgYW5kIGRvbid0IHN0b3AgdGlsbCB5b3UgZ2V0IHRoZXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQm8gSmFja3NvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk91ciBpbnRlbnRpb24gY3JlYXRlcyBvdXIgcmVhbGl0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBY3Rpb24gbWF5IG5vdCBhbHdheXMgYnJpbmcgaGFwcGluZXNzLCBidXQgdGhlcmUgaXMgbm8gaGFwcGluZXNzIHdpdGhvdXQgYWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVuamFtaW4gRGlzcmFlbGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJKdXN0IGFzIGEgZmxvd2VyLCB3aGljaCBzZWVtcyBiZWF1dGlmdWwgaGFzIGNvbG9yIGJ1dCBubyBwZXJmdW1lLCBzbyBhcmUgdGhlIGZydWl0bGVzcyB3b3JkcyBvZiBhIG1hbiB3aG8gc3BlYWtzIHRoZW0gYnV0IGRvZXMgdGhlbSBub3QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEaGFtbWFwYWRhIgogIH0sCiAgewogICAgInRleHQiOiAiUGVhY2UgYmVnaW5zIHdpdGggYSBzbWlsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vdGhlciBUZXJlc2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGYXRlIGlzIGluIHlvdXIgaGFuZHMgYW5kIG5vIG9uZSBlbHNlcyIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBYnNlbmNlIG1ha2VzIHRoZSBoZWFydCBncm93IGZvbmRlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkhheW5lcyBCYXlseSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgc2VlayBjb25zdGFudGx5IHRvIGltcHJvdmUgbXkgbWFubmVycyBhbmQgZ3JhY2VzLCBmb3IgdGhleSBhcmUgdGhlIHN1Z2FyIHRvIHdoaWNoIGFsbCBhcmUgYXR0cmFjdGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT2cgTWFuZGlubyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gd2Ugc2VlayB0byBkaXNjb3ZlciB0aGUgYmVzdCBpbiBvdGhlcnMsIHdlIHNvbWVob3cgYnJpbmcgb3V0IHRoZSBiZXN0IGluIG91cnNlbHZlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gV2FyZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgbWF5IG5vdCBrbm93IGV2ZXJ5dGhpbmcsIGJ1dCBldmVyeXRoaW5nIGlzIG5vdCBrbm93biB5ZXQgYW55d2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGbG93IHdpdGggd2hhdGV2ZXIgaXMgaGFwcGVuaW5nIGFuZCBsZXQgeW91ciBtaW5kIGJlIGZyZWUuIFN0YXkgY2VudHJlZCBieSBhY2NlcHRpbmcgd2hhdGV2ZXIgeW91IGFyZSBkb2luZy4gVGhpcyBpcyB0aGUgdWx0aW1hdGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaHVhbmcgVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiV2UgbmVlZCB0byBmaW5kIHRoZSBjb3VyYWdlIHRvIHNheSBOTyB0byB0aGUgdGhpbmdzIGFuZCBwZW9wbGUgdGhhdCBhcmUgbm90IHNlcnZpbmcgdXMgaWYgd2Ugd2FudCB0byByZWRpc2NvdmVyIG91cnNlbHZlcyBhbmQgbGl2ZSBvdXIgbGl2ZXMgd2l0aCBhdXRoZW50aWNpdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCYXJiYXJhIERlIEFuZ2VsaXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbXBvcnRhbnQgcHJpbmNpcGxlcyBtYXksIGFuZCBtdXN0LCBiZSBpbmZsZXhpYmxlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWJyYWhhbSBMaW5jb2xuIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpcyBzbyBjb25zdHJ1Y3RlZCB0aGF0IGFuIGV2ZW50IGRvZXMgbm90LCBjYW5ub3QsIHdpbGwgbm90LCBtYXRjaCB0aGUgZXhwZWN0YXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFybG90dGUgQnJvbnRlIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgbmV2ZXIgdW5kZXJzdGFuZCBob3cgbGl0dGxlIHdlIG5lZWQgaW4gdGhpcyB3b3JsZCB1bnRpbCB3ZSBrbm93IHRoZSBsb3NzIG9mIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmFtZXMgQmFycmllIgogIH0sCiAgewogICAgInRleHQiOiAiQSBzdWNjZXNzZnVsIHBlcnNvbiBpcyBvbmUgd2hvIGNhbiBsYXkgYSBmaXJtIGZvdW5kYXRpb24gd2l0aCB0aGUgYnJpY2tzIHRoYXQgb3RoZXJzIHRocm93IGF0IGhpbSBvciBoZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYXZpZCBCcmlua2xleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBmb290IGZlZWxzIHRoZSBmb290IHdoZW4gaXQgZmVlbHMgdGhlIGdyb3VuZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB3b3JsZCBoYXMgdGhlIGhhYml0IG9mIG1ha2luZyByb29tIGZvciB0aGUgbWFuIHdob3NlIGFjdGlvbnMgc2hvdyB0aGF0IGhlIGtub3dzIHdoZXJlIGhlIGlzIGdvaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNoYW9zIGFuZCBPcmRlciBhcmUgbm90IGVuZW1pZXMsIG9ubHkgb3Bwb3NpdGVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBHYXJyaW90dCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBvbmx5IFplbiB5b3UgZmluZCBvbiB0aGUgdG9wcyBvZiBtb3VudGFpbnMgaXMgdGhlIFplbiB5b3UgYnJpbmcgdXAgdGhlcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgUGlyc2lnIgogIH0sCiAgewogICAgInRleHQiOiAiU3VjY2VzcyBtZWFucyBoYXZpbmcgdGhlIGNvdXJhZ2UsIHRoZSBkZXRlcm1pbmF0aW9uLCBhbmQgdGhlIHdpbGwgdG8gYmVjb21lIHRoZSBwZXJzb24geW91IGJlbGlldmUgeW91IHdlcmUgbWVhbnQgdG8gYmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZ2UgU2hlZWhhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4geW91IGhhdmUgZ290IGFuIGVsZXBoYW50IGJ5IHRoZSBoaW5kIGxlZ3MgYW5kIGhlIGlzIHRyeWluZyB0byBydW4gYXdheSwgaXQncyBiZXN0IHRvIGxldCBoaW0gcnVuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWJyYWhhbSBMaW5jb2xuIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyZWF0ZXN0IHBhcnQgb2Ygb3VyIGhhcHBpbmVzcyBkZXBlbmRzIG9uIG91ciBkaXNwb3NpdGlvbnMsIG5vdCBvdXIgY2lyY3Vtc3RhbmNlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcnRoYSBXYXNoaW5ndG9uIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGxvc2UgdG9kYXksIHdpbiB0b21vcnJvdy4gSW4gdGhpcyBuZXZlci1lbmRpbmcgc3Bpcml0IG9mIGNoYWxsZW5nZSBpcyB0aGUgaGVhcnQgb2YgYSB2aWN0b3IuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWlzYWt1IElrZWRhIgogIH0sCiAgewogICAgInRleHQiOiAiVGhyZWUgdGhpbmdzIGNhbm5vdCBiZSBsb25nIGhpZGRlbjogdGhlIHN1biwgdGhlIG1vb24sIGFuZCB0aGUgdHJ1dGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJPdXIgdmlydHVlcyBhbmQgb3VyIGZhaWxpbmdzIGFyZSBpbnNlcGFyYWJsZSwgbGlrZSBmb3JjZSBhbmQgbWF0dGVyLiBXaGVuIHRoZXkgc2VwYXJhdGUsIG1hbiBpcyBubyBtb3JlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmlrb2xhIFRlc2xhIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGtub3dzIGhpbXNlbGYgaXMgZW5saWdodGVuZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgbm90IGZhaXIgdG8gYXNrIG9mIG90aGVycyB3aGF0IHlvdSBhcmUgdW53aWxsaW5nIHRvIGRvIHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxlYW5vciBSb29zZXZlbHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVyZXZlciB5b3UgZ28sIGdvIHdpdGggYWxsIHlvdXIgaGVhcnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHb29kIGx1Y2sgaXMgYW5vdGhlciBuYW1lIGZvciB0ZW5hY2l0eSBvZiBwdXJwb3NlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNpbGVuY2VzIG1ha2UgdGhlIHJlYWwgY29udmVyc2F0aW9ucyBiZXR3ZWVuIGZyaWVuZHMuIE5vdCB0aGUgc2F5aW5nIGJ1dCB0aGUgbmV2ZXIgbmVlZGluZyB0byBzYXkgaXMgd2hhdCBjb3VudHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJnYXJldCBSdW5iZWNrIgogIH0sCiAgewogICAgInRleHQiOiAiU2FkbmVzcyBtYXkgYmUgcGFydCBvZiBsaWZlIGJ1dCB0aGVyZSBpcyBubyBuZWVkIHRvIGxldCBpdCBkb21pbmF0ZSB5b3VyIGVudGlyZSBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYWltIG9mIGxpZmUgaXMgc2VsZi1kZXZlbG9wbWVudC4gVG8gcmVhbGl6ZSBvbmVzIG5hdHVyZSBwZXJmZWN0bHkgLSB0aGF0IGlzIHdoYXQgZWFjaCBvZiB1cyBpcyBoZXJlIGZvci4iLAogICAgImF0dHJpYnV0aW9uIjogIk9zY2FyIFdpbGRlIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IHNlZWsgdHJ1dGggeW91IHdpbGwgbm90IHNlZWsgdmljdG9yeSBieSBkaXNob25vdXJhYmxlIG1lYW5zLCBhbmQgaWYgeW91IGZpbmQgdHJ1dGggeW91IHdpbGwgYmVjb21lIGludmluY2libGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJObyBtYXR0ZXIgaG93IGhhcmQgdGhlIHBhc3QsIHlvdSBjYW4gYWx3YXlzIGJlZ2luIGFnYWluLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiVGhpbmdzIGRvIG5vdCBjaGFuZ2U7IHdlIGNoYW5nZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbnJ5IFRob3JlYXUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMZWFybiB3aXNkb20gZnJvbSB0aGUgd2F5cyBvZiBhIHNlZWRsaW5nLiBBIHNlZWRsaW5nIHdoaWNoIGlzIG5ldmVyIGhhcmRlbmVkIG9mZiB0aHJvdWdoIHN0cmVzc2Z1bCBzaXR1YXRpb25zIHdpbGwgbmV2ZXIgYmVjb21lIGEgc3Ryb25nIHByb2R1Y3RpdmUgcGxhbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTdGVwaGVuIFNpZ211bmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYmVnaW5uaW5nIG9mIHdpc2RvbSBpcyBmb3VuZCBpbiBkb3VidGluZzsgYnkgZG91YnRpbmcgd2UgY29tZSB0byB0aGUgcXVlc3Rpb24sIGFuZCBieSBzZWVraW5nIHdlIG1heSBjb21lIHVwb24gdGhlIHRydXRoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGllcnJlIEFiZWxhcmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8ga25vd3Mgb3RoZXJzIGlzIHdpc2UuIEhlIHdobyBrbm93cyBoaW1zZWxmIGlzIGVubGlnaHRlbmVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5dGhpbmcgaW4gbGlmZSBpcyBsdWNrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRG9uYWxkIFRydW1wIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIGtub3cgdGhlIHdheTsgZmV3IGFjdHVhbGx5IHdhbGsgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCb2RoaWRoYXJtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBiZXN0IHdheSBvdXQgaXMgYWx3YXlzIHRocm91Z2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgRnJvc3QiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3Ugd2FudCB5b3VyIGxpZmUgdG8gYmUgbW9yZSByZXdhcmRpbmcsIHlvdSBoYXZlIHRvIGNoYW5nZSB0aGUgd2F5IHlvdSB0aGluay4iLAogICAgImF0dHJpYnV0aW9uIjogIk9wcmFoIFdpbmZyZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbGwgcGVyY2VpdmluZyBpcyBhbHNvIHRoaW5raW5nLCBhbGwgcmVhc29uaW5nIGlzIGFsc28gaW50dWl0aW9uLCBhbGwgb2JzZXJ2YXRpb24gaXMgYWxzbyBpbnZlbnRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSdWRvbGYgQXJuaGVpbSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoYXQgeW91IGZlYXIgaXMgdGhhdCB3aGljaCByZXF1aXJlcyBhY3Rpb24gdG8gb3ZlcmNvbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzdXBlcmlvciBtYW4gaXMgc2F0aXNmaWVkIGFuZCBjb21wb3NlZDsgdGhlIG1lYW4gbWFuIGlzIGFsd2F5cyBmdWxsIG9mIGRpc3RyZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gd2FudCB0byBiZSB3aGF0IG9uZSBjYW4gYmUgaXMgcHVycG9zZSBpbiBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ3ludGhpYSBPemljayIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgbmV2ZXIgd29ycnkgYWJvdXQgYWN0aW9uLCBidXQgb25seSBpbmFjdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiTmVpdGhlciBnZW5pdXMsIGZhbWUsIG5vciBsb3ZlIHNob3cgdGhlIGdyZWF0bmVzcyBvZiB0aGUgc291bC4gT25seSBraW5kbmVzcyBjYW4gZG8gdGhhdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkplYW4gTGFjb3JkYWlyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBub2JsZXN0IHdvcnNoaXAgaXMgdG8gbWFrZSB5b3Vyc2VsZiBhcyBnb29kIGFuZCBhcyBqdXN0IGFzIHlvdSBjYW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJJc29jcmF0ZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBubyB3YXkgdG8gaGFwcGluZXNzLCBoYXBwaW5lc3MgaXMgdGhlIHdheS4iLAogICAgImF0dHJpYnV0aW9uIjogIlRoaWNoIE5oYXQgSGFuaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIktub3dsZWRnZSBpcyBhIHByb2Nlc3Mgb2YgcGlsaW5nIHVwIGZhY3RzOyB3aXNkb20gbGllcyBpbiB0aGVpciBzaW1wbGlmaWNhdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcnRpbiBGaXNjaGVyIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnkgYXJ0aXN0IGRpcHMgaGlzIGJydXNoIGluIGhpcyBvd24gc291bCwgYW5kIHBhaW50cyBoaXMgb3duIG5hdHVyZSBpbnRvIGhpcyBwaWN0dXJlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbnJ5IFdhcmQgQmVlY2hlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFkdmVyc2l0eSBpc24ndCBzZXQgYWdhaW5zdCB5b3UgdG8gZmFpbDsgYWR2ZXJzaXR5IGlzIGEgd2F5IHRvIGJ1aWxkIHlvdXIgY2hhcmFjdGVyIHNvIHRoYXQgeW91IGNhbiBzdWNjZWVkIG92ZXIgYW5kIG92ZXIgYWdhaW4gdGhyb3VnaCBwZXJzZXZlcmFuY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vIGFjdCBvZiBraW5kbmVzcywgbm8gbWF0dGVyIGhvdyBzbWFsbCwgaXMgZXZlciB3YXN0ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBZXNvcCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNlZSB0aGUgcG9zaXRpdmUgc2lkZSwgdGhlIHBvdGVudGlhbCwgYW5kIG1ha2UgYW4gZWZmb3J0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBpY2sgYmF0dGxlcyBiaWcgZW5vdWdoIHRvIG1hdHRlciwgc21hbGwgZW5vdWdoIHRvIHdpbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvbmF0aGFuIEtvem9sIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyYW5kIGVzc2VudGlhbHMgb2YgaGFwcGluZXNzIGFyZTogc29tZXRoaW5nIHRvIGRvLCBzb21ldGhpbmcgdG8gbG92ZSwgYW5kIHNvbWV0aGluZyB0byBob3BlIGZvci4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoYWxtZXJzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyZWF0ZXN0IGdvb2QgeW91IGNhbiBkbyBmb3IgYW5vdGhlciBpcyBub3QganVzdCB0byBzaGFyZSB5b3VyIHJpY2hlcyBidXQgdG8gcmV2ZWFsIHRvIGhpbSBoaXMgb3duLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVuamFtaW4gRGlzcmFlbGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBjb3VsZCBuZXZlciBsZWFybiB0byBiZSBicmF2ZSBhbmQgcGF0aWVudCBpZiB0aGVyZSB3ZXJlIG9ubHkgam95IGluIHRoZSB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbGVuIEtlbGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vIGRheSBpbiB3aGljaCB5b3UgbGVhcm4gc29tZXRoaW5nIGlzIGEgY29tcGxldGUgbG9zcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhdmlkIEVkZGluZ3MiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGFtIG5vdCBhZnJhaWQgb2YgdG9tb3Jyb3csIGZvciBJIGhhdmUgc2VlbiB5ZXN0ZXJkYXkgYW5kIEkgbG92ZSB0b2RheS4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gV2hpdGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGYW1lIHVzdWFsbHkgY29tZXMgdG8gdGhvc2Ugd2hvIGFyZSB0aGlua2luZyBhYm91dCBzb21ldGhpbmcgZWxzZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkhvbG1lcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGNob29zZSBvdXIgam95cyBhbmQgc29ycm93cyBsb25nIGJlZm9yZSB3ZSBleHBlcmllbmNlIHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJLYWhsaWwgR2licmFuIgogIH0sCiAgewogICAgInRleHQiOiAiTG92ZSB2YW5xdWlzaGVzIHRpbWUuIFRvIGxvdmVycywgYSBtb21lbnQgY2FuIGJlIGV0ZXJuaXR5LCBldGVybml0eSBjYW4gYmUgdGhlIHRpY2sgb2YgYSBjbG9jay4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcnkgUGFycmlzaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRyeSBub3QgdG8gYmVjb21lIGEgbWFuIG9mIHN1Y2Nlc3MsIGJ1dCByYXRoZXIgdHJ5IHRvIGJlY29tZSBhIG1hbiBvZiB2YWx1ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBwdXJwb3NlIG9mIGxlYXJuaW5nIGlzIGdyb3d0aCwgYW5kIG91ciBtaW5kcywgdW5saWtlIG91ciBib2RpZXMsIGNhbiBjb250aW51ZSBncm93aW5nIGFzIHdlIGNvbnRpbnVlIHRvIGxpdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNb3J0aW1lciBBZGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gaW4gZG91YnQsIHRlbGwgdGhlIHRydXRoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyayBUd2FpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGZseSBhcyBmYXN0IGFzIHRob3VnaHQsIHlvdSBtdXN0IGJlZ2luIGJ5IGtub3dpbmcgdGhhdCB5b3UgaGF2ZSBhbHJlYWR5IGFycml2ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJHbyBmb3IgaXQgbm93LiBUaGUgZnV0dXJlIGlzIHByb21pc2VkIHRvIG5vIG9uZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIGlzIGEgc3VjY2Vzc2lvbiBvZiBsZXNzb25zLCB3aGljaCBtdXN0IGJlIGxpdmVkIHRvIGJlIHVuZGVyc3Rvb2QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCBpcyBuZWNlc3NhcnkgdG8gY2hhbmdlIGEgcGVyc29uIGlzIHRvIGNoYW5nZSBoaXMgYXdhcmVuZXNzIG9mIGhpbXNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBYnJhaGFtIE1hc2xvdyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBmaW5hbCBwcm9vZiBvZiBncmVhdG5lc3MgbGllcyBpbiBiZWluZyBhYmxlIHRvIGVuZHVyZSBjcml0aWNpc20gd2l0aG91dCByZXNlbnRtZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxiZXJ0IEh1YmJhcmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGJlZ2luIHdpdGggYW4gaWRlYSBhbmQgdGhlbiBpdCBiZWNvbWVzIHNvbWV0aGluZyBlbHNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGFibG8gUGljYXNzbyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJhdGhlciB0aGFuIHdpc2hpbmcgZm9yIGNoYW5nZSwgeW91IGZpcnN0IG11c3QgYmUgcHJlcGFyZWQgdG8gY2hhbmdlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2F0aGVyaW5lIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgc3VyZWx5IGlzIGluIGh1bWFuIG5hdHVyZSBhbiBpbmhlcmVudCBwcm9wZW5zaXR5IHRvIGV4dHJhY3QgYWxsIHRoZSBnb29kIG91dCBvZiBhbGwgdGhlIGV2aWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBIYXlkb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciBtaXN0YWtlIGFjdGl2aXR5IGZvciBhY2hpZXZlbWVudC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gV29vZGVuIgogIH0sCiAgewogICAgInRleHQiOiAiUmVhbCBtYWdpYyBpbiByZWxhdGlvbnNoaXBzIG1lYW5zIGFuIGFic2VuY2Ugb2YganVkZ2VtZW50IG9mIG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGxpdHRsZSBrbm93bGVkZ2UgdGhhdCBhY3RzIGlzIHdvcnRoIGluZmluaXRlbHkgbW9yZSB0aGFuIG11Y2gga25vd2xlZGdlIHRoYXQgaXMgaWRsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkthaGxpbCBHaWJyYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZmlyc3QgcmVxdWlzaXRlIGZvciBzdWNjZXNzIGlzIHRoZSBhYmlsaXR5IHRvIGFwcGx5IHlvdXIgcGh5c2ljYWwgYW5kIG1lbnRhbCBlbmVyZ2llcyB0byBvbmUgcHJvYmxlbSBpbmNlc3NhbnRseSB3aXRob3V0IGdyb3dpbmcgd2VhcnkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaG9tYXMgRWRpc29uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGZ1dHVyZSBpcyBjb21wbGV0ZWx5IG9wZW4sIGFuZCB3ZSBhcmUgd3JpdGluZyBpdCBtb21lbnQgdG8gbW9tZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVtYSBDaG9kcm9uIgogIH0sCiAgewogICAgInRleHQiOiAiS2luZG5lc3MgaXMgdGhlIGxhbmd1YWdlIHdoaWNoIHRoZSBkZWFmIGNhbiBoZWFyIGFuZCB0aGUgYmxpbmQgY2FuIHNlZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmsgVHdhaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaG91Z2h0IGlzIHRoZSBibG9zc29tOyBsYW5ndWFnZSB0aGUgYnVkOyBhY3Rpb24gdGhlIGZydWl0IGJlaGluZCBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJHcmF0aXR1ZGUgaXMgcmljaGVzLiBDb21wbGFpbnQgaXMgcG92ZXJ0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRvcmlzIERheSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJldHRlciBiZSBpZ25vcmFudCBvZiBhIG1hdHRlciB0aGFuIGhhbGYga25vdyBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlB1YmxpbGl1cyBTeXJ1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBoaWdoZXN0IHN0YWdlIGluIG1vcmFsIHVyZSBhdCB3aGljaCB3ZSBjYW4gYXJyaXZlIGlzIHdoZW4gd2UgcmVjb2duaXplIHRoYXQgd2Ugb3VnaHQgdG8gY29udHJvbCBvdXIgdGhvdWdodHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFybGVzIERhcndpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBmb3ggaGFzIG1hbnkgdHJpY2tzLiBUaGUgaGVkZ2Vob2cgaGFzIGJ1dCBvbmUuIEJ1dCB0aGF0IGlzIHRoZSBiZXN0IG9mIGFsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkRlc2lkZXJpdXMgRXJhc211cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBlb3BsZSB0YWtlIGRpZmZlcmVudCByb2FkcyBzZWVraW5nIGZ1bGZpbG1lbnQgYW5kIGhhcHBpbmVzcy4gSnVzdCBiZWNhdXNlIHRoZXlyZSBub3Qgb24geW91ciByb2FkIGRvZXNuJ3QgbWVhbiB0aGV5J3ZlIGdvdHRlbiBsb3N0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRob3NlIHdobyBhcmUgYmxlc3NlZCB3aXRoIHRoZSBtb3N0IHRhbGVudCBkb24ndCBuZWNlc3NhcmlseSBvdXRwZXJmb3JtIGV2ZXJ5b25lIGVsc2UuIEl0J3MgdGhlIHBlb3BsZSB3aXRoIGZvbGxvdy10aHJvdWdoIHdobyBleGNlbC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcnkgS2F5IEFzaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRydWUgc2lsZW5jZSBpcyB0aGUgcmVzdCBvZiB0aGUgbWluZDsgaXQgaXMgdG8gdGhlIHNwaXJpdCB3aGF0IHNsZWVwIGlzIHRvIHRoZSBib2R5LCBub3VyaXNobWVudCBhbmQgcmVmcmVzaG1lbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIFBlbm4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaHJvdWdoIG1lZGl0YXRpb24gYW5kIGJ5IGdpdmluZyBmdWxsIGF0dGVudGlvbiB0byBvbmUgdGhpbmcgYXQgYSB0aW1lLCB3ZSBjYW4gbGVhcm4gdG8gZGlyZWN0IGF0dGVudGlvbiB3aGVyZSB3ZSBjaG9vc2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFa25hdGggRWFzd2FyYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIHdhbnQgeW91IHRvIGJlIGV2ZXJ5dGhpbmcgdGhhdCdzIHlvdSwgZGVlcCBhdCB0aGUgY2VudGVyIG9mIHlvdXIgYmVpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJObyBtYW4gaXMgZnJlZSB3aG8gaXMgbm90IG1hc3RlciBvZiBoaW1zZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXBpY3RldHVzIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgbWFrZSBhIGxpdmluZyBieSB3aGF0IHdlIGdldCwgYnV0IHdlIG1ha2UgYSBsaWZlIGJ5IHdoYXQgd2UgZ2l2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnl0aGluZyB3ZSBoZWFyIGlzIGFuIG9waW5pb24sIG5vdCBhIGZhY3QuIEV2ZXJ5dGhpbmcgd2Ugc2VlIGlzIGEgcGVyc3BlY3RpdmUsIG5vdCB0aGUgdHJ1dGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJjdXMgQXVyZWxpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJPdXIgYWJpbGl0eSB0byBhY2hpZXZlIGhhcHBpbmVzcyBhbmQgc3VjY2VzcyBkZXBlbmRzIG9uIHRoZSBzdHJlbmd0aCBvZiBvdXIgd2luZ3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXRoZXJpbmUgUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTaG9ydCB3b3JkcyBhcmUgYmVzdCBhbmQgdGhlIG9sZCB3b3JkcyB3aGVuIHNob3J0IGFyZSBiZXN0IG9mIGFsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiUGVhY2UgaXMgbm90IHNvbWV0aGluZyB5b3Ugd2lzaCBmb3IuIEl0J3Mgc29tZXRoaW5nIHlvdSBtYWtlLCBzb21ldGhpbmcgeW91IGRvLCBzb21ldGhpbmcgeW91IGFyZSwgYW5kIHNvbWV0aGluZyB5b3UgZ2l2ZSBhd2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IEZ1bGdodW0iCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbyBub3QgYmUgdG9vIHRpbWlkIGFuZCBzcXVlYW1pc2ggYWJvdXQgeW91ciByZWFjdGlvbnMuIEFsbCBsaWZlIGlzIGFuIGV4cGVyaW1lbnQuIFRoZSBtb3JlIGV4cGVyaW1lbnRzIHlvdSBtYWtlIHRoZSBiZXR0ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgSSBjb3VsZCByZWFjaCB1cCBhbmQgaG9sZCBhIHN0YXIgZm9yIGV2ZXJ5IHRpbWUgeW91J3ZlIG1hZGUgbWUgc21pbGUsIHRoZSBlbnRpcmUgZXZlbmluZyBza3kgd291bGQgYmUgaW4gdGhlIHBhbG0gb2YgbXkgaGFuZC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW4gZG8gd2hhdCdzIHJlYXNvbmFibGUgb3IgeW91IGNhbiBkZWNpZGUgd2hhdCdzIHBvc3NpYmxlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiU25vd2ZsYWtlcyBhcmUgb25lIG9mIG5hdHVyZXMgbW9zdCBmcmFnaWxlIHRoaW5ncywgYnV0IGp1c3QgbG9vayB3aGF0IHRoZXkgY2FuIGRvIHdoZW4gdGhleSBzdGljayB0b2dldGhlci4iLAogICAgImF0dHJpYnV0aW9uIjogIlZpc3RhIEtlbGx5IgogIH0sCiAgewogICAgInRleHQiOiAiSWYgdGhlIHN0YXJzIHNob3VsZCBhcHBlYXIgYnV0IG9uZSBuaWdodCBldmVyeSB0aG91c2FuZCB5ZWFycyBob3cgbWFuIHdvdWxkIG1hcnZlbCBhbmQgYWRvcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiQSB0cnVlIGZyaWVuZCBpcyB0aGUgbW9zdCBwcmVjaW91cyBvZiBhbGwgcG9zc2Vzc2lvbnMgYW5kIHRoZSBvbmUgd2UgdGFrZSB0aGUgbGVhc3QgdGhvdWdodCBhYm91dCBhY3F1aXJpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmFuY29pcyBkZSBMYSBSb2NoZWZvdWNhdWxkIgogIH0sCiAgewogICAgInRleHQiOiAiUGVvcGxlIG1heSBkb3VidCB3aGF0IHlvdSBzYXksIGJ1dCB0aGV5IHdpbGwgYmVsaWV2ZSB3aGF0IHlvdSBkby4iLAogICAgImF0dHJpYnV0aW9uIjogIkxld2lzIENhc3MiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIG1hbiBpcyBub3Qgb2xkIGFzIGxvbmcgYXMgaGUgaXMgc2Vla2luZyBzb21ldGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFZG1vbmQgUm9zdGFuZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW5ub3QgdHJhdmVsIHRoZSBwYXRoIHVudGlsIHlvdSBoYXZlIGJlY29tZSB0aGUgcGF0aCBpdHNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGdhcmRlbiBpcyBhbHdheXMgYSBzZXJpZXMgb2YgbG9zc2VzIHNldCBhZ2FpbnN0IGEgZmV3IHRyaXVtcGhzLCBsaWtlIGxpZmUgaXRzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWF5IFNhcnRvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIGZhY3RzIGFyZSB0aGUgc2VlZHMgdGhhdCBsYXRlciBwcm9kdWNlIGtub3dsZWRnZSBhbmQgd2lzZG9tLCB0aGVuIHRoZSBlbW90aW9ucyBhbmQgdGhlIGltcHJlc3Npb25zIG9mIHRoZSBzZW5zZXMgYXJlIHRoZSBmZXJ0aWxlIHNvaWwgaW4gd2hpY2ggdGhlIHNlZWRzIG11c3QgZ3Jvdy4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhY2hlbCBDYXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIG9uZSBkb29yIG9mIGhhcHBpbmVzcyBjbG9zZXMsIGFub3RoZXIgb3BlbnM7IGJ1dCBvZnRlbiB3ZSBsb29rIHNvIGxvbmcgYXQgdGhlIGNsb3NlZCBkb29yIHRoYXQgd2UgZG8gbm90IHNlZSB0aGUgb25lIHdoaWNoIGhhcyBiZWVuIG9wZW5lZCBmb3IgdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZWxlbiBLZWxsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgY2F1c2UgaXMgaGlkZGVuLiBUaGUgZWZmZWN0IGlzIHZpc2libGUgdG8gYWxsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT3ZpZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIG5vIHdheSB0byBwcm9zcGVyaXR5LCBwcm9zcGVyaXR5IGlzIHRoZSB3YXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYXluZSBEeWVyIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiB5b3UgYXJlIG9mZmVuZGVkIGF0IGFueSBtYW4ncyBmYXVsdCwgdHVybiB0byB5b3Vyc2VsZiBhbmQgc3R1ZHkgeW91ciBvd24gZmFpbGluZ3MuIFRoZW4geW91IHdpbGwgZm9yZ2V0IHlvdXIgYW5nZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgYXJlbid0IGdvaW5nIGFsbCB0aGUgd2F5LCB3aHkgZ28gYXQgYWxsPyIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9lIE5hbWF0aCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGxpc3RlbiB3ZWxsIGlzIGFzIHBvd2VyZnVsIGEgbWVhbnMgb2YgY29tbXVuaWNhdGlvbiBhbmQgaW5mbHVlbmNlIGFzIHRvIHRhbGsgd2VsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gTWFyc2hhbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNb3N0IGdyZWF0IHBlb3BsZSBoYXZlIGF0dGFpbmVkIHRoZWlyIGdyZWF0ZXN0IHN1Y2Nlc3MganVzdCBvbmUgc3RlcCBiZXlvbmQgdGhlaXIgZ3JlYXRlc3QgZmFpbHVyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEhpbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJObyBtYW4gY2FuIHN1Y2NlZWQgaW4gYSBsaW5lIG9mIGVuZGVhdm9yIHdoaWNoIGhlIGRvZXMgbm90IGxpa2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiV2h5IGNvbXBhcmUgeW91cnNlbGYgd2l0aCBvdGhlcnM/IE5vIG9uZSBpbiB0aGUgZW50aXJlIHdvcmxkIGNhbiBkbyBhIGJldHRlciBqb2Igb2YgYmVpbmcgeW91IHRoYW4geW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiQSBsb3Qgb2YgdGltZXMgcGVvcGxlIGxvb2sgYXQgdGhlIG5lZ2F0aXZlIHNpZGUgb2Ygd2hhdCB0aGV5IGZlZWwgdGhleSBjYW4ndCBkby4gSSBhbHdheXMgbG9vayBvbiB0aGUgcG9zaXRpdmUgc2lkZSBvZiB3aGF0IEkgY2FuIGRvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2h1Y2sgTm9ycmlzIgogIH0sCiAgewogICAgInRleHQiOiAiUHV0IHlvdXIgZnV0dXJlIGluIGdvb2QgaGFuZHMgLSB5b3VyIG93bi4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUgaXMgd2hhdCBoYXBwZW5zIHdoaWxlIHlvdSBhcmUgbWFraW5nIG90aGVyIHBsYW5zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBMZW5ub24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJDaGFvcyBpcyBpbmhlcmVudCBpbiBhbGwgY29tcG91bmRlZCB0aGluZ3MuIFN0cml2ZSBvbiB3aXRoIGRpbGlnZW5jZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBsb3ZlIGxpZmUsIGRvbid0IHdhc3RlIHRpbWUsIGZvciB0aW1lIGlzIHdoYXQgbGlmZSBpcyBtYWRlIHVwIG9mLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJ1Y2UgTGVlIgogIH0sCiAgewogICAgInRleHQiOiAiV2l0aG91dCB0aGlzIHBsYXlpbmcgd2l0aCBmYW50YXN5IG5vIGNyZWF0aXZlIHdvcmsgaGFzIGV2ZXIgeWV0IGNvbWUgdG8gYmlydGguIFRoZSBkZWJ0IHdlIG93ZSB0byB0aGUgcGxheSBvZiB0aGUgaW1hZ2luYXRpb24gaXMgaW5jYWxjdWxhYmxlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybCBKdW5nIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiBJIGxldCBnbyBvZiB3aGF0IEkgYW0sIEkgYmVjb21lIHdoYXQgSSBtaWdodCBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTdWNjZXNzIGluIGJ1c2luZXNzIHJlcXVpcmVzIHRyYWluaW5nIGFuZCBkaXNjaXBsaW5lIGFuZCBoYXJkIHdvcmsuIEJ1dCBpZiB5b3UncmUgbm90IGZyaWdodGVuZWQgYnkgdGhlc2UgdGhpbmdzLCB0aGUgb3Bwb3J0dW5pdGllcyBhcmUganVzdCBhcyBncmVhdCB0b2RheSBhcyB0aGV5IGV2ZXIgd2VyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhdmlkIFJvY2tlZmVsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gZHJlYW0gb2YgdGhlIHBlcnNvbiB5b3Ugd291bGQgbGlrZSB0byBiZSBpcyB0byB3YXN0ZSB0aGUgcGVyc29uIHlvdSBhcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaW0gTWVuY2hlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJlYWxpdHkgZG9lcyBub3QgY29uZm9ybSB0byB0aGUgaWRlYWwsIGJ1dCBjb25maXJtcyBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkd1c3RhdmUgRmxhdWJlcnQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBiZSB3cm9uZyBpcyBub3RoaW5nIHVubGVzcyB5b3UgY29udGludWUgdG8gcmVtZW1iZXIgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCdzIHNvIHNpbXBsZSB0byBiZSB3aXNlLiBKdXN0IHRoaW5rIG9mIHNvbWV0aGluZyBzdHVwaWQgdG8gc2F5IGFuZCB0aGVuIGRvbid0IHNheSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlNhbSBMZXZlbnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIG5vIG5lZWQgZm9yIHRlbXBsZXMsIG5vIG5lZWQgZm9yIGNvbXBsaWNhdGVkIHBoaWxvc29waGllcy4gTXkgYnJhaW4gYW5kIG15IGhlYXJ0IGFyZSBteSB0ZW1wbGVzOyBteSBwaGlsb3NvcGh5IGlzIGtpbmRuZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFyZSBhbGwgc29tZXRoaW5nLCBidXQgbm9uZSBvZiB1cyBhcmUgZXZlcnl0aGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJsYWlzZSBQYXNjYWwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBub3Qgb25seSBmb3Igd2hhdCB3ZSBkbyB0aGF0IHdlIGFyZSBoZWxkIHJlc3BvbnNpYmxlLCBidXQgYWxzbyBmb3Igd2hhdCB3ZSBkbyBub3QgZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNb2xpZXJlIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnkgZ3JlYXQgZHJlYW0gYmVnaW5zIHdpdGggYSBkcmVhbWVyLiBBbHdheXMgcmVtZW1iZXIsIHlvdSBoYXZlIHdpdGhpbiB5b3UgdGhlIHN0cmVuZ3RoLCB0aGUgcGF0aWVuY2UsIGFuZCB0aGUgcGFzc2lvbiB0byByZWFjaCBmb3IgdGhlIHN0YXJzIHRvIGNoYW5nZSB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIYXJyaWV0IFR1Ym1hbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IHNldHRsZSBmb3IgYSByZWxhdGlvbnNoaXAgdGhhdCB3b24ndCBsZXQgeW91IGJlIHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT3ByYWggV2luZnJleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGJyaW5nIGFueXRoaW5nIGludG8geW91ciBsaWZlLCBpbWFnaW5lIHRoYXQgaXQncyBhbHJlYWR5IHRoZXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gZm9yZ2l2ZSBpcyB0byBzZXQgYSBwcmlzb25lciBmcmVlIGFuZCByZWFsaXplIHRoYXQgcHJpc29uZXIgd2FzIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxld2lzIEIuIFNtZWRlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNsb3cgZG93biBhbmQgZXZlcnl0aGluZyB5b3UgYXJlIGNoYXNpbmcgd2lsbCBjb21lIGFyb3VuZCBhbmQgY2F0Y2ggeW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBEZSBQYW9sYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxvdmUgYW5kIGNvbXBhc3Npb24gb3BlbiBvdXIgb3duIGlubmVyIGxpZmUsIHJlZHVjaW5nIHN0cmVzcywgZGlzdHJ1c3QgYW5kIGxvbmVsaW5lc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiV2Ugc2VlIHRoaW5ncyBub3QgYXMgdGhleSBhcmUsIGJ1dCBhcyB3ZSBhcmUuIE91ciBwZXJjZXB0aW9uIGlzIHNoYXBlZCBieSBvdXIgcHJldmlvdXMgZXhwZXJpZW5jZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEZW5uaXMgS2ltYnJvIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIG91ciBrbm93bGVkZ2UgYmVnaW5zIHdpdGggdGhlIHNlbnNlcywgcHJvY2VlZHMgdGhlbiB0byB0aGUgdW5kZXJzdGFuZGluZywgYW5kIGVuZHMgd2l0aCByZWFzb24uIFRoZXJlIGlzIG5vdGhpbmcgaGlnaGVyIHRoYW4gcmVhc29uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSW1tYW51ZWwgS2FudCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNoYXJhY3RlciBjYW5ub3QgYmUgZGV2ZWxvcGVkIGluIGVhc2UgYW5kIHF1aWV0LiBPbmx5IHRocm91Z2ggZXhwZXJpZW5jZSBvZiB0cmlhbCBhbmQgc3VmZmVyaW5nIGNhbiB0aGUgc291bCBiZSBzdHJlbmd0aGVuZWQsIHZpc2lvbiBjbGVhcmVkLCBhbWJpdGlvbiBpbnNwaXJlZCwgYW5kIHN1Y2Nlc3MgYWNoaWV2ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZWxlbiBLZWxsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDaGFuZ2UgeW91ciB3b3Jkcy4gQ2hhbmdlIHlvdXIgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIHRoaW5nIHdlbGwgc2FpZCB3aWxsIGJlIHdpdCBpbiBhbGwgbGFuZ3VhZ2VzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBEcnlkZW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgdHJ1ZXN0IGdyZWF0bmVzcyBsaWVzIGluIGJlaW5nIGtpbmQsIHRoZSB0cnVlc3Qgd2lzZG9tIGluIGEgaGFwcHkgbWluZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkVsbGEgV2lsY294IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHNob3J0ZXN0IGFuc3dlciBpcyBkb2luZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxvcmQgSGVyYmVydCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGNhbm5vdCBkbyBldmVyeXRoaW5nIGF0IG9uY2UsIGJ1dCB3ZSBjYW4gZG8gc29tZXRoaW5nIGF0IG9uY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYWx2aW4gQ29vbGlkZ2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIb3cgZmFyIHRoYXQgbGl0dGxlIGNhbmRsZSB0aHJvd3MgaXRzIGJlYW1zISBTbyBzaGluZXMgYSBnb29kIGRlZWQgaW4gYSBuYXVnaHR5IHdvcmxkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNwZWFrIHdoZW4geW91IGFyZSBhbmdyeSBhbmQgeW91IHdpbGwgbWFrZSB0aGUgYmVzdCBzcGVlY2ggeW91IHdpbGwgZXZlciByZWdyZXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbWJyb3NlIEJpZXJjZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1vc3QgZm9sa3MgYXJlIGFzIGhhcHB5IGFzIHRoZXkgbWFrZSB1cCB0aGVpciBtaW5kcyB0byBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFicmFoYW0gTGluY29sbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlByb2JsZW1zIGFyZSBvbmx5IG9wcG9ydHVuaXRpZXMgd2l0aCB0aG9ybnMgb24gdGhlbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkh1Z2ggTWlsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiTWluZHMgYXJlIGxpa2UgcGFyYWNodXRlcy4gVGhleSBvbmx5IGZ1bmN0aW9uIHdoZW4gb3Blbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlRob21hcyBEZXdhciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBlb3BsZSBvZiBtZWRpb2NyZSBhYmlsaXR5IHNvbWV0aW1lcyBhY2hpZXZlIG91dHN0YW5kaW5nIHN1Y2Nlc3MgYmVjYXVzZSB0aGV5IGRvbid0IGtub3cgd2hlbiB0byBxdWl0LiBNb3N0IG1lbiBzdWNjZWVkIGJlY2F1c2UgdGhleSBhcmUgZGV0ZXJtaW5lZCB0by4iLAogICAgImF0dHJpYnV0aW9uIjogIkdlb3JnZSBBbGxlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW5ub3QgY2hhbmdlIGFueXRoaW5nIGluIHlvdXIgbGlmZSB3aXRoIGludGVudGlvbiBhbG9uZSwgd2hpY2ggY2FuIGJlY29tZSBhIHdhdGVyZWQtZG93biwgb2NjYXNpb25hbCBob3BlIHRoYXQgeW91J2xsIGdldCB0byB0b21vcnJvdy4gSW50ZW50aW9uIHdpdGhvdXQgYWN0aW9uIGlzIHVzZWxlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXJvbGluZSBNeXNzIgogIH0sCiAgewogICAgInRleHQiOiAiQWltIGZvciBzdWNjZXNzLCBub3QgcGVyZmVjdGlvbi4gTmV2ZXIgZ2l2ZSB1cCB5b3VyIHJpZ2h0IHRvIGJlIHdyb25nLCBiZWNhdXNlIHRoZW4geW91IHdpbGwgbG9zZSB0aGUgYWJpbGl0eSB0byBsZWFybiBuZXcgdGhpbmdzIGFuZCBtb3ZlIGZvcndhcmQgd2l0aCB5b3VyIGxpZmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEci4gRGF2aWQgTS4gQnVybnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGF0IGlzIGEgd2VlZD8gQSBwbGFudCB3aG9zZSB2aXJ0dWVzIGhhdmUgbm90IHlldCBiZWVuIGRpc2NvdmVyZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiRGlzY292ZXJ5IGNvbnNpc3RzIG9mIHNlZWluZyB3aGF0IGV2ZXJ5Ym9keSBoYXMgc2VlbiBhbmQgdGhpbmtpbmcgd2hhdCBub2JvZHkgZWxzZSBoYXMgdGhvdWdodC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvbmF0aGFuIFN3aWZ0IgogIH0sCiAgewogICAgInRleHQiOiAiSSBuZXZlciB0aGluayBvZiB0aGUgZnV0dXJlLiBJdCBjb21lcyBzb29uIGVub3VnaC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdyZWF0IHRhbGVudCBmaW5kcyBoYXBwaW5lc3MgaW4gZXhlY3V0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9oYW5uIFdvbGZnYW5nIHZvbiBHb2V0aGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGF0IGxpZXMgYmVoaW5kIHVzIGFuZCB3aGF0IGxpZXMgYmVmb3JlIHVzIGFyZSB0aW55IG1hdHRlcnMgY29tcGFyZWQgdG8gd2hhdCBsaWVzIHdpdGhpbiB1cy4iLAogICAgImF0dHJpYnV0aW9uIjogIldhbHQgRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBkb24ndCBkZXNpZ24geW91ciBvd24gbGlmZSBwbGFuLCBjaGFuY2VzIGFyZSB5b3UnbGwgZmFsbCBpbnRvIHNvbWVvbmUgZWxzZSdzIHBsYW4uIEFuZCBndWVzcyB3aGF0IHRoZXkgaGF2ZSBwbGFubmVkIGZvciB5b3U/IE5vdCBtdWNoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmltIFJvaG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJGYWl0aCBpbiBvbmVzZWxmIGlzIHRoZSBiZXN0IGFuZCBzYWZlc3QgY291cnNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWljaGVsYW5nZWxvIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHBvc3Nlc3Npb24gb2Yga25vd2xlZGdlIGRvZXMgbm90IGtpbGwgdGhlIHNlbnNlIG9mIHdvbmRlciBhbmQgbXlzdGVyeS4gVGhlcmUgaXMgYWx3YXlzIG1vcmUgbXlzdGVyeS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuYWlzIE5pbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFsbCBncmVhdCBhY2hpZXZlbWVudHMgcmVxdWlyZSB0aW1lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWF5YSBBbmdlbG91IgogIH0sCiAgewogICAgInRleHQiOiAiTGV0IHRoZSBiZWF1dHkgb2Ygd2hhdCB5b3UgbG92ZSBiZSB3aGF0IHlvdSBkby4iLAogICAgImF0dHJpYnV0aW9uIjogIlJ1bWkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHcmF0aXR1ZGUgaXMgdGhlIGZhaXJlc3QgYmxvc3NvbSB3aGljaCBzcHJpbmdzIGZyb20gdGhlIHNvdWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yeSBCZWVjaGVyIgogIH0sCiAgewogICAgInRleHQiOiAiQXMgd2UgYXJlIGxpYmVyYXRlZCBmcm9tIG91ciBvd24gZmVhciwgb3VyIHByZXNlbmNlIGF1dG9tYXRpY2FsbHkgbGliZXJhdGVzIG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIk5lbHNvbiBNYW5kZWxhIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgaXMgbmV2ZXIgZW5vdWdoIHRpbWUgdG8gZG8gZXZlcnl0aGluZywgYnV0IHRoZXJlIGlzIGFsd2F5cyBlbm91Z2ggdGltZSB0byBkbyB0aGUgbW9zdCBpbXBvcnRhbnQgdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCcmlhbiBUcmFjeSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNoYW5nZSBpbiBhbGwgdGhpbmdzIGlzIHN3ZWV0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQXJpc3RvdGxlIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHNpbXBsZXN0IHRoaW5ncyBhcmUgb2Z0ZW4gdGhlIHRydWVzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpY2hhcmQgQmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV4cGVyaWVuY2UgaXMgc2ltcGx5IHRoZSBuYW1lIHdlIGdpdmUgb3VyIG1pc3Rha2VzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT3NjYXIgV2lsZGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBvbmx5IHdpdGggdGhlIGhlYXJ0IHRoYXQgb25lIGNhbiBzZWUgcmlnaHRseSwgd2hhdCBpcyBlc3NlbnRpYWwgaXMgaW52aXNpYmxlIHRvIHRoZSBleWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbnRvaW5lIGRlIFNhaW50LUV4dXBlcnkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJQcm9taXNlcyBhcmUgdGhlIHVuaXF1ZWx5IGh1bWFuIHdheSBvZiBvcmRlcmluZyB0aGUgZnV0dXJlLCBtYWtpbmcgaXQgcHJlZGljdGFibGUgYW5kIHJlbGlhYmxlIHRvIHRoZSBleHRlbnQgdGhhdCB0aGlzIGlzIGh1bWFubHkgcG9zc2libGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIYW5uYWggQXJlbmR0IgogIH0sCiAgewogICAgInRleHQiOiAiQSBmcmllbmQgaXMgc29tZW9uZSB3aG8gdW5kZXJzdGFuZHMgeW91ciBwYXN0LCBiZWxpZXZlcyBpbiB5b3VyIGZ1dHVyZSwgYW5kIGFjY2VwdHMgeW91IGp1c3QgdGhlIHdheSB5b3UgYXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgb25lIG9mIHRoZSBibGVzc2luZ3Mgb2Ygb2xkIGZyaWVuZHMgdGhhdCB5b3UgY2FuIGFmZm9yZCB0byBiZSBzdHVwaWQgd2l0aCB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFwcHJlY2lhdGlvbiBjYW4gbWFrZSBhIGRheSwgZXZlbiBjaGFuZ2UgYSBsaWZlLiBZb3VyIHdpbGxpbmduZXNzIHRvIHB1dCBpdCBpbnRvIHdvcmRzIGlzIGFsbCB0aGF0IGlzIG5lY2Vzc2FyeS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmdhcmV0IENvdXNpbnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbyBub3QgZ2l2ZSB5b3VyIGF0dGVudGlvbiB0byB3aGF0IG90aGVycyBkbyBvciBmYWlsIHRvIGRvOyBnaXZlIGl0IHRvIHdoYXQgeW91IGRvIG9yIGZhaWwgdG8gZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEaGFtbWFwYWRhIgogIH0sCiAgewogICAgInRleHQiOiAiS25vdyB0aGF0IGFsdGhvdWdoIGluIHRoZSBldGVybmFsIHNjaGVtZSBvZiB0aGluZ3MgeW91IGFyZSBzbWFsbCwgeW91IGFyZSBhbHNvIHVuaXF1ZSBhbmQgaXJyZXBsYWNlYWJsZSwgYXMgYXJlIGFsbCB5b3VyIGZlbGxvdyBodW1hbnMgZXZlcnl3aGVyZSBpbiB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJnYXJldCBMYXVyZW5jZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZvdXIgc3RlcHMgdG8gYWNoaWV2ZW1lbnQ6IFBsYW4gcHVycG9zZWZ1bGx5LiBQcmVwYXJlIHByYXllcmZ1bGx5LiBQcm9jZWVkIHBvc2l0aXZlbHkuIFB1cnN1ZSBwZXJzaXN0ZW50bHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIEFydGh1ciBXYXJkIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGRvbid0IGdvIGFmdGVyIHdoYXQgeW91IHdhbnQsIHlvdSdsbCBuZXZlciBoYXZlIGl0LiBJZiB5b3UgZG9uJ3QgYXNrLCB0aGUgYW5zd2VyIGlzIGFsd2F5cyBuby4gSWYgeW91IGRvbid0IHN0ZXAgZm9yd2FyZCwgeW91J3JlIGFsd2F5cyBpbiB0aGUgc2FtZSBwbGFjZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk5vcmEgUm9iZXJ0cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlbGwgYmVndW4gaXMgaGFsZiBkb25lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQXJpc3RvdGxlIgogIH0sCiAgewogICAgInRleHQiOiAiWWVzdGVyZGF5cyBob21lIHJ1bnMgZG9uJ3Qgd2luIHRvZGF5J3MgZ2FtZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCYWJlIFJ1dGgiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBubyByZXRpcmVtZW50IGZvciBhbiBhcnRpc3QsIGl0J3MgeW91ciB3YXkgb2YgbGl2aW5nIHNvIHRoZXJlIGlzIG5vIGVuZCB0byBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbnJ5IE1vb3JlIgogIH0sCiAgewogICAgInRleHQiOiAiTGVhcm4gYWxsIHlvdSBjYW4gZnJvbSB0aGUgbWlzdGFrZXMgb2Ygb3RoZXJzLiBZb3Ugd29uJ3QgaGF2ZSB0aW1lIHRvIG1ha2UgdGhlbSBhbGwgeW91cnNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGZyZWQgU2hlaW53b2xkIgogIH0sCiAgewogICAgInRleHQiOiAiRG9uJ3Qgd2FpdCBmb3IgeW91ciBmZWVsaW5ncyB0byBjaGFuZ2UgdG8gdGFrZSB0aGUgYWN0aW9uLiBUYWtlIHRoZSBhY3Rpb24gYW5kIHlvdXIgZmVlbGluZ3Mgd2lsbCBjaGFuZ2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCYXJiYXJhIEJhcm9uIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgdGhhdCBuZXZlciBjaGFuZ2VzIGhpcyBvcGluaW9ucywgbmV2ZXIgY29ycmVjdHMgaGlzIG1pc3Rha2VzLCBhbmQgd2lsbCBuZXZlciBiZSB3aXNlciBvbiB0aGUgbW9ycm93IHRoYW4gaGUgaXMgdG9kYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUcnlvbiBFZHdhcmRzIgogIH0sCiAgewogICAgInRleHQiOiAiRGVjaXNpb24gaXMgYSByaXNrIHJvb3RlZCBpbiB0aGUgY291cmFnZSBvZiBiZWluZyBmcmVlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGF1bCBUaWxsaWNoIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHBsYWNlIHRvIGltcHJvdmUgdGhlIHdvcmxkIGlzIGZpcnN0IGluIG9uZSdzIG93biBoZWFydCBhbmQgaGVhZCBhbmQgaGFuZHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgTS4gUGlyc2lnIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgd2UgYXJlIG5vdCBmdWxseSBvdXJzZWx2ZXMsIHRydWx5IGluIHRoZSBwcmVzZW50IG1vbWVudCwgd2UgbWlzcyBldmVyeXRoaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhpY2ggTmhhdCBIYW5oIgogIH0sCiAgewogICAgInRleHQiOiAiVGhyb3VnaCBwcmlkZSB3ZSBhcmUgZXZlciBkZWNlaXZpbmcgb3Vyc2VsdmVzLiBCdXQgZGVlcCBkb3duIGJlbG93IHRoZSBzdXJmYWNlIG9mIHRoZSBhdmVyYWdlIGNvbnNjaWVuY2UgYSBzdGlsbCwgc21hbGwgdm9pY2Ugc2F5cyB0byB1cywgU29tZXRoaW5nIGlzIG91dCBvZiB0dW5lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybCBKdW5nIgogIH0sCiAgewogICAgInRleHQiOiAiRnJvbSBzbWFsbCBiZWdpbm5pbmdzIGNvbWUgZ3JlYXQgdGhpbmdzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiSSB0aGluayBzb21laG93IHdlIGxlYXJuIHdobyB3ZSByZWFsbHkgYXJlIGFuZCB0aGVuIGxpdmUgd2l0aCB0aGF0IGRlY2lzaW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxlYW5vciBSb29zZXZlbHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMb3ZlIGlzIGp1c3QgYSB3b3JkIHVudGlsIHNvbWVvbmUgY29tZXMgYWxvbmcgYW5kIGdpdmVzIGl0IG1lYW5pbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeSBhY3Rpb24gb2Ygb3VyIGxpdmVzIHRvdWNoZXMgb24gc29tZSBjaG9yZCB0aGF0IHdpbGwgdmlicmF0ZSBpbiBldGVybml0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVkd2luIENoYXBpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBtYXkgYmUgZGVjZWl2ZWQgaWYgeW91IHRydXN0IHRvbyBtdWNoLCBidXQgeW91IHdpbGwgbGl2ZSBpbiB0b3JtZW50IGlmIHlvdSBkb24ndCB0cnVzdCBlbm91Z2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmFuayBDcmFuZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGdpdmUgaG9wZSB0byBzb21lb25lIG9jY3VycyB3aGVuIHlvdSB0ZWFjaCB0aGVtIGhvdyB0byB1c2UgdGhlIHRvb2xzIHRvIGRvIGl0IGZvciB0aGVtc2VsdmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTb21ldGltZXMgeW91ciBqb3kgaXMgdGhlIHNvdXJjZSBvZiB5b3VyIHNtaWxlLCBidXQgc29tZXRpbWVzIHlvdXIgc21pbGUgY2FuIGJlIHRoZSBzb3VyY2Ugb2YgeW91ciBqb3kuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaGljaCBOaGF0IEhhbmgiCiAgfSwKICB7CiAgICAidGV4dCI6ICJLaW5kbmVzcyBpcyB0aGUgZ3JlYXRlc3Qgd2lzZG9tLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiTWFueSBwZW9wbGUgaGF2ZSBnb25lIGZ1cnRoZXIgdGhhbiB0aGV5IHRob3VnaHQgdGhleSBjb3VsZCBiZWNhdXNlIHNvbWVvbmUgZWxzZSB0aG91Z2h0IHRoZXkgY291bGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaW1lIHlvdSBlbmpveWVkIHdhc3Rpbmcgd2FzIG5vdCB3YXN0ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIExlbm5vbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJldmlld2luZyB3aGF0IHlvdSBoYXZlIGxlYXJuZWQgYW5kIGxlYXJuaW5nIGFuZXcsIHlvdSBhcmUgZml0IHRvIGJlIGEgdGVhY2hlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZyaWVuZHNoaXAgd2l0aCBvbmVzZWxmIGlzIGFsbCBpbXBvcnRhbnQgYmVjYXVzZSB3aXRob3V0IGl0IG9uZSBjYW5ub3QgYmUgZnJpZW5kcyB3aXRoIGFueWJvZHkgZWxzZSBpbiB0aGUgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGVhbm9yIFJvb3NldmVsdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXNlIGRheXMgcGVvcGxlIHNlZWsga25vd2xlZGdlLCBub3Qgd2lzZG9tLiBLbm93bGVkZ2UgaXMgb2YgdGhlIHBhc3QsIHdpc2RvbSBpcyBvZiB0aGUgZnV0dXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVmVybm9uIENvb3BlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IHR1cm4gYXdheSBmcm9tIHBvc3NpYmxlIGZ1dHVyZXMgYmVmb3JlIHlvdSdyZSBjZXJ0YWluIHlvdSBkb24ndCBoYXZlIGFueXRoaW5nIHRvIGxlYXJuIGZyb20gdGhlbS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpY2hhcmQgQmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNvcnJlY3Rpb24gZG9lcyBtdWNoLCBidXQgZW5jb3VyYWdlbWVudCBkb2VzIG1vcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2hhbm4gV29sZmdhbmcgdm9uIEdvZXRoZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IGZyb3duIGJlY2F1c2UgeW91IG5ldmVyIGtub3cgd2hvIGlzIGZhbGxpbmcgaW4gbG92ZSB3aXRoIHlvdXIgc21pbGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTaW52eWVzdCBUYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3VyIHdvcmsgaXMgdG8gZGlzY292ZXIgeW91ciB3b3JrIGFuZCB0aGVuIHdpdGggYWxsIHlvdXIgaGVhcnQgdG8gZ2l2ZSB5b3Vyc2VsZiB0byBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgY2FuJ3QgYmVsaWV2ZSB0aGF0IEdvZCBwdXQgdXMgb24gdGhpcyBlYXJ0aCB0byBiZSBvcmRpbmFyeS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxvdSBIb2x0eiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNtaWxlLCBicmVhdGhlLCBhbmQgZ28gc2xvd2x5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhpY2ggTmhhdCBIYW5oIgogIH0sCiAgewogICAgInRleHQiOiAiU29jaWV0eSBkZXZlbG9wcyB3aXQsIGJ1dCBpdHMgY29udGVtcGxhdGlvbiBhbG9uZSBmb3JtcyBnZW5pdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYWRhbWUgZGUgU3RhZWwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZWdpbiB0byB3ZWF2ZSBhbmQgR29kIHdpbGwgZ2l2ZSB5b3UgdGhlIHRocmVhZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkdlcm1hbiBwcm92ZXJiIgogIH0sCiAgewogICAgInRleHQiOiAiUHV0IHlvdXIgZnV0dXJlIGluIGdvb2QgaGFuZHMgLSB5b3VyIG93bi4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGZseSwgd2UgaGF2ZSB0byBoYXZlIHJlc2lzdGFuY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXlhIExpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUgaXMgbGlrZSBhIHNld2VyLiBXaGF0IHlvdSBnZXQgb3V0IG9mIGl0IGRlcGVuZHMgb24gd2hhdCB5b3UgcHV0IGludG8gaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb20gTGVocmVyIgogIH0sCiAgewogICAgInRleHQiOiAiTm8geWVzdGVyZGF5cyBhcmUgZXZlciB3YXN0ZWQgZm9yIHRob3NlIHdobyBnaXZlIHRoZW1zZWx2ZXMgdG8gdG9kYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCcmVuZGFuIEZyYW5jaXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2FuIG9ic2VydmUgYSBsb3QganVzdCBieSB3YXRjaGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIllvZ2kgQmVycmEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8gbGl2ZXMgaW4gaGFybW9ueSB3aXRoIGhpbXNlbGYgbGl2ZXMgaW4gaGFybW9ueSB3aXRoIHRoZSB1bml2ZXJzZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhcHBpbmVzcyBvZnRlbiBzbmVha3MgaW4gdGhyb3VnaCBhIGRvb3IgeW91IGRpZG4ndCBrbm93IHlvdSBsZWZ0IG9wZW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIEJhcnJ5bW9yZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGFyZSBiYXNpY2FsbHkgdHdvIHR5cGVzIG9mIHBlb3BsZS4gUGVvcGxlIHdobyBhY2NvbXBsaXNoIHRoaW5ncywgYW5kIHBlb3BsZSB3aG8gY2xhaW0gdG8gaGF2ZSBhY2NvbXBsaXNoZWQgdGhpbmdzLiBUaGUgZmlyc3QgZ3JvdXAgaXMgbGVzcyBjcm93ZGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyayBUd2FpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldvcnJ5IGdpdmVzIGEgc21hbGwgdGhpbmcgYSBiaWcgc2hhZG93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiQ2hvb3NlIGEgam9iIHlvdSBsb3ZlLCBhbmQgeW91IHdpbGwgbmV2ZXIgaGF2ZSB0byB3b3JrIGEgZGF5IGluIHlvdXIgbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSB3b24ndCBza2lkIGlmIHlvdSBzdGF5IGluIGEgcnV0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2luIEh1YmJhcmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3Qgd2hhdCB3ZSBoYXZlIGJ1dCB3aGF0IHdlIGVuam95IGNvbnN0aXR1dGVzIG91ciBhYnVuZGFuY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIFBldGl0LVNlbm4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbyBnb29kIGJ5IHN0ZWFsdGgsIGFuZCBibHVzaCB0byBmaW5kIGl0IGZhbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGV4YW5kZXIgUG9wZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJlbWVtYmVyIHRoYXQgc29tZXRpbWVzIG5vdCBnZXR0aW5nIHdoYXQgeW91IHdhbnQgaXMgYSB3b25kZXJmdWwgc3Ryb2tlIG9mIGx1Y2suIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiTm9ib2R5IHdpbGwgYmVsaWV2ZSBpbiB5b3UgdW5sZXNzIHlvdSBiZWxpZXZlIGluIHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGliZXJhY2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGJlbGlldmUgdGhhdCB3ZSBhcmUgZnVuZGFtZW50YWxseSB0aGUgc2FtZSBhbmQgaGF2ZSB0aGUgc2FtZSBiYXNpYyBwb3RlbnRpYWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgb25lIGRvZXMgbm90IGtub3cgdG8gd2hpY2ggcG9ydCBpcyBzYWlsaW5nLCBubyB3aW5kIGlzIGZhdm9yYWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNlbmVjYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoeSB3b3JyeSBhYm91dCB0aGluZ3MgeW91IGNhbid0IGNvbnRyb2wgd2hlbiB5b3UgY2FuIGtlZXAgeW91cnNlbGYgYnVzeSBjb250cm9sbGluZyB0aGUgdGhpbmdzIHRoYXQgZGVwZW5kIG9uIHlvdT8iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBlb3BsZSBhcmUgc28gY29uc3RpdHV0ZWQgdGhhdCBldmVyeWJvZHkgd291bGQgcmF0aGVyIHVuZGVydGFrZSB3aGF0IHRoZXkgc2VlIG90aGVycyBkbywgd2hldGhlciB0aGV5IGhhdmUgYW4gYXB0aXR1ZGUgZm9yIGl0IG9yIG5vdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubiBXb2xmZ2FuZyB2b24gR29ldGhlIgogIH0sCiAgewogICAgInRleHQiOiAiQ29uc2lkZXIgaG93IGhhcmQgaXQgaXMgdG8gY2hhbmdlIHlvdXJzZWxmIGFuZCB5b3UnbGwgdW5kZXJzdGFuZCB3aGF0IGxpdHRsZSBjaGFuY2UgeW91IGhhdmUgaW4gdHJ5aW5nIHRvIGNoYW5nZSBvdGhlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKYWNvYiBCcmF1ZGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGb3JnaXZlbmVzcyBpcyBjaG9vc2luZyB0byBsb3ZlLiBJdCBpcyB0aGUgZmlyc3Qgc2tpbGwgb2Ygc2VsZi1naXZpbmcgbG92ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vaGFuZGFzIEdhbmRoaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIG1ha2Ugb3VyIG93biBmb3J0dW5lcyBhbmQgd2UgY2FsbCB0aGVtIGZhdGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBEaXNyYWVsaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGNhbiBjaGFuZ2Ugb3VyIGxpdmVzLiBXZSBjYW4gZG8sIGhhdmUsIGFuZCBiZSBleGFjdGx5IHdoYXQgd2Ugd2lzaC4iLAogICAgImF0dHJpYnV0aW9uIjogIlRvbnkgUm9iYmlucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlByb2dyZXNzIGFsd2F5cyBpbnZvbHZlcyByaXNrcy4gWW91IGNhbid0IHN0ZWFsIHNlY29uZCBiYXNlIGFuZCBrZWVwIHlvdXIgZm9vdCBvbiBmaXJzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkZyZWRlcmljayBXaWxjb3giCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3Uga2ljayBhIHN0b25lIGluIGFuZ2VyLCB5b3UnbGwgaHVydCB5b3VyIG93biBmb290LiIsCiAgICAiYXR0cmlidXRpb24iOiAiS29yZWFuIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgYXJlIHRoZSBvbmx5IHBlcnNvbiBvbiBlYXJ0aCB3aG8gY2FuIHVzZSB5b3VyIGFiaWxpdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJaaWcgWmlnbGFyIgogIH0sCiAgewogICAgInRleHQiOiAiT3VyIGdyZWF0bmVzcyBsaWVzIG5vdCBzbyBtdWNoIGluIGJlaW5nIGFibGUgdG8gcmVtYWtlIHRoZSB3b3JsZCBhcyBiZWluZyBhYmxlIHRvIHJlbWFrZSBvdXJzZWx2ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYWhhdG1hIEdhbmRoaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgYmVsaWV2ZSB0aGF0IGV2ZXJ5IHBlcnNvbiBpcyBib3JuIHdpdGggdGFsZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWF5YSBBbmdlbG91IgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpcyBqdXN0IGEgY2hhbmNlIHRvIGdyb3cgYSBzb3VsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQS4gUG93ZWxsIERhdmllcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB3aW5kcyBhbmQgd2F2ZXMgYXJlIGFsd2F5cyBvbiB0aGUgc2lkZSBvZiB0aGUgYWJsZXN0IG5hdmlnYXRvcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFZHdhcmQgR2liYm9uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHdvcnN0IGJhbmtydXB0IGluIHRoZSB3b3JsZCBpcyB0aGUgcGVyc29uIHdobyBoYXMgbG9zdCBoaXMgZW50aHVzaWFzbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkguIFcuIEFybm9sZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxldCBtZSB0ZWxsIHlvdSB0aGUgc2VjcmV0IHRoYXQgaGFzIGxlZCBtZSB0byBteSBnb2FsOiBteSBzdHJlbmd0aCBsaWVzIHNvbGVseSBpbiBteSB0ZW5hY2l0eSIsCiAgICAiYXR0cmlidXRpb24iOiAiTG91aXMgUGFzdGV1ciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlVudGlsIHlvdSBtYWtlIHBlYWNlIHdpdGggd2hvIHlvdSBhcmUsIHlvdSdsbCBuZXZlciBiZSBjb250ZW50IHdpdGggd2hhdCB5b3UgaGF2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRvcmlzIE1vcnRtYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbG1vc3QgZXZlcnl0aGluZyBjb21lcyBmcm9tIG5vdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yaSBBbWllbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtaW51dGUgeW91IHNldHRsZSBmb3IgbGVzcyB0aGFuIHlvdSBkZXNlcnZlLCB5b3UgZ2V0IGV2ZW4gbGVzcyB0aGFuIHlvdSBzZXR0bGVkIGZvci4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hdXJlZW4gRG93ZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzbWFsbGVzdCBmbG93ZXIgaXMgYSB0aG91Z2h0LCBhIGxpZmUgYW5zd2VyaW5nIHRvIHNvbWUgZmVhdHVyZSBvZiB0aGUgR3JlYXQgV2hvbGUsIG9mIHdob20gdGhleSBoYXZlIGEgcGVyc2lzdGVudCBpbnR1aXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIb25vcmUgZGUgQmFsemFjIgogIH0sCiAgewogICAgInRleHQiOiAiRG8gbm90IGR3ZWxsIGluIHRoZSBwYXN0LCBkbyBub3QgZHJlYW0gb2YgdGhlIGZ1dHVyZSwgY29uY2VudHJhdGUgdGhlIG1pbmQgb24gdGhlIHByZXNlbnQgbW9tZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiQXMgYW4gb3JnYW5pemVyIEkgc3RhcnQgZnJvbSB3aGVyZSB0aGUgd29ybGQgaXMsIGFzIGl0IGlzLCBub3QgYXMgSSB3b3VsZCBsaWtlIGl0IHRvIGJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2F1bCBBbGluc2t5IgogIH0sCiAgewogICAgInRleHQiOiAiUHJlanVkaWNlIGlzIGEgYnVyZGVuIHRoYXQgY29uZnVzZXMgdGhlIHBhc3QsIHRocmVhdGVucyB0aGUgZnV0dXJlIGFuZCByZW5kZXJzIHRoZSBwcmVzZW50IGluYWNjZXNzaWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1heWEgQW5nZWxvdSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJvbGQgaXMgbm90IHRoZSBhY3Qgb2YgZm9vbGlzaG5lc3MgYnV0IHRoZSBhdHRyaWJ1dGUgYW5kIGlubmVyIHN0cmVuZ3RoIHRvIGFjdCB3aGVuIG90aGVycyB3aWxsIG5vdCBzbyBhcyB0byBtb3ZlIGZvcndhcmQgbm90IGJhY2t3YXJkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJWaWN0b3J5IGJlbG9uZ3MgdG8gdGhlIG1vc3QgcGVyc2V2ZXJpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBCb25hcGFydGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFZmZpY2llbmN5IGlzIGRvaW5nIHRoaW5ncyByaWdodDsgZWZmZWN0aXZlbmVzcyBpcyBkb2luZyB0aGUgcmlnaHQgdGhpbmdzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGV0ZXIgRHJ1Y2tlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdvb2QgcGVvcGxlIGFyZSBnb29kIGJlY2F1c2UgdGhleSd2ZSBjb21lIHRvIHdpc2RvbSB0aHJvdWdoIGZhaWx1cmUuIFdlIGdldCB2ZXJ5IGxpdHRsZSB3aXNkb20gZnJvbSBzdWNjZXNzLCB5b3Uga25vdy4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gU2Fyb3lhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW4gYmUgd2hhdCB5b3Ugd2FudCB0byBiZS4gWW91IGhhdmUgdGhlIHBvd2VyIHdpdGhpbiBhbmQgd2Ugd2lsbCBoZWxwIHlvdSBhbHdheXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vIHZhbGlkIHBsYW5zIGZvciB0aGUgZnV0dXJlIGNhbiBiZSBtYWRlIGJ5IHRob3NlIHdobyBoYXZlIG5vIGNhcGFjaXR5IGZvciBsaXZpbmcgbm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxhbiBXYXR0cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIHRoZSBsb25nIHJ1biB3ZSBnZXQgbm8gbW9yZSB0aGFuIHdlIGhhdmUgYmVlbiB3aWxsaW5nIHRvIHJpc2sgZ2l2aW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2hlbGRvbiBLb3BwIgogIH0sCiAgewogICAgInRleHQiOiAiSSdtIG5vdCBhZnJhaWQgb2Ygc3Rvcm1zLCBmb3IgSW0gbGVhcm5pbmcgaG93IHRvIHNhaWwgbXkgc2hpcC4iLAogICAgImF0dHJpYnV0aW9uIjogIkxvdWlzYSBBbGNvdHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJPcGVuIG1pbmRzIGxlYWQgdG8gb3BlbiBkb29ycy4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBtdXN0IGRvIHRoZSB0aGluZ3MgeW91IHRoaW5rIHlvdSBjYW5ub3QgZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGVhbm9yIFJvb3NldmVsdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldpc2UgbWVuIHRhbGsgYmVjYXVzZSB0aGV5IGhhdmUgc29tZXRoaW5nIHRvIHNheTsgZm9vbHMsIGJlY2F1c2UgdGhleSBoYXZlIHRvIHNheSBzb21ldGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQbGF0byIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtb3N0IGJlYXV0aWZ1bCB0aGluZ3MgaW4gdGhlIHdvcmxkIGNhbm5vdCBiZSBzZWVuIG9yIGV2ZW4gdG91Y2hlZC4gVGhleSBtdXN0IGJlIGZlbHQgd2l0aCB0aGUgaGVhcnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZWxlbiBLZWxsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeSBwZXJzb24sIGFsbCB0aGUgZXZlbnRzIG9mIHlvdXIgbGlmZSBhcmUgdGhlcmUgYmVjYXVzZSB5b3UgaGF2ZSBkcmF3biB0aGVtIHRoZXJlLiBXaGF0IHlvdSBjaG9vc2UgdG8gZG8gd2l0aCB0aGVtIGlzIHVwIHRvIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpY2hhcmQgQmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtb3N0IHN1Y2Nlc3NmdWwgcGVvcGxlIGFyZSB0aG9zZSB3aG8gYXJlIGdvb2QgYXQgcGxhbiBCLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmFtZXMgWW9ya2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJQcm9zcGVyaXR5IGRlcGVuZHMgbW9yZSBvbiB3YW50aW5nIHdoYXQgeW91IGhhdmUgdGhhbiBoYXZpbmcgd2hhdCB5b3Ugd2FudC4iLAogICAgImF0dHJpYnV0aW9uIjogIkdlb2ZmcmV5IEYuIEFiZXJ0IgogIH0sCiAgewogICAgInRleHQiOiAiQ2hhbmNlIGlzIGFsd2F5cyBwb3dlcmZ1bC4gTGV0IHlvdXIgaG9vayBiZSBhbHdheXMgY2FzdDsgaW4gdGhlIHBvb2wgd2hlcmUgeW91IGxlYXN0IGV4cGVjdCBpdCwgdGhlcmUgd2lsbCBiZSBhIGZpc2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPdmlkIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgSSBrbm93IHdoYXQgbG92ZSBpcywgaXQgaXMgYmVjYXVzZSBvZiB5b3UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZXJtYW5uIEhlc3NlIgogIH0sCiAgewogICAgInRleHQiOiAiTGVhcm4gdG8gbGlzdGVuLiBPcHBvcnR1bml0eSBjb3VsZCBiZSBrbm9ja2luZyBhdCB5b3VyIGRvb3IgdmVyeSBzb2Z0bHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmFuayBUeWdlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0J3Mgbm90IHdobyB5b3UgYXJlIHRoYXQgaG9sZHMgeW91IGJhY2ssIGl0J3Mgd2hvIHlvdSB0aGluayB5b3UncmUgbm90LiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiQW50aWNpcGF0ZSB0aGUgZGlmZmljdWx0IGJ5IG1hbmFnaW5nIHRoZSBlYXN5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFsbCBvdXIga25vd2xlZGdlIGhhcyBpdHMgb3JpZ2lucyBpbiBvdXIgcGVyY2VwdGlvbnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMZW9uYXJkbyBkYSBWaW5jaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1vcmUgb2Z0ZW4gdGhhbiBub3QsIGFuZ2VyIGlzIGFjdHVhbGx5IGFuIGluZGljYXRpb24gb2Ygd2Vha25lc3MgcmF0aGVyIHRoYW4gb2Ygc3RyZW5ndGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGZvY3VzIG9uIHJlc3VsdHMsIHlvdSB3aWxsIG5ldmVyIGNoYW5nZS4gSWYgeW91IGZvY3VzIG9uIGNoYW5nZSwgeW91IHdpbGwgZ2V0IHJlc3VsdHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKYWNrIERpeG9uIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIGRpZmZpY3VsdCB0aGluZ3MgaGF2ZSB0aGVpciBvcmlnaW4gaW4gdGhhdCB3aGljaCBpcyBlYXN5LCBhbmQgZ3JlYXQgdGhpbmdzIGluIHRoYXQgd2hpY2ggaXMgc21hbGwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgaXMgb25seSBvbmUgd2F5IHRvIGhhcHBpbmVzcyBhbmQgdGhhdCBpcyB0byBjZWFzZSB3b3JyeWluZyBhYm91dCB0aGluZ3Mgd2hpY2ggYXJlIGJleW9uZCB0aGUgcG93ZXIgb2Ygb3VyIHdpbGwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGluIGxpZmUgaXMgdG8gYmUgZmVhcmVkLCBpdCBpcyBvbmx5IHRvIGJlIHVuZGVyc3Rvb2QuIE5vdyBpcyB0aGUgdGltZSB0byB1bmRlcnN0YW5kIG1vcmUsIHNvIHRoYXQgd2UgbWF5IGZlYXIgbGVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmllIEN1cmllIgogIH0sCiAgewogICAgInRleHQiOiAiQWx3YXlzIGRvIHlvdXIgYmVzdC4gV2hhdCB5b3UgcGxhbnQgbm93LCB5b3Ugd2lsbCBoYXJ2ZXN0IGxhdGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT2cgTWFuZGlubyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJlc3BlY3Qgc2hvdWxkIGJlIGVhcm5lZCBieSBhY3Rpb25zLCBhbmQgbm90IGFjcXVpcmVkIGJ5IHllYXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbmsgV3JpZ2h0IgogIH0sCiAgewogICAgInRleHQiOiAiV2UgY2Fubm90IGRpcmVjdCB0aGUgd2luZCBidXQgd2UgY2FuIGFkanVzdCB0aGUgc2FpbHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGhhcHBlbnMgdW5sZXNzIGZpcnN0IHdlIGRyZWFtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybCBTYW5kYnVyZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBhdGllbmNlIGlzIHRoZSBjb21wYW5pb24gb2Ygd2lzZG9tLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2FpbnQgQXVndXN0aW5lIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgYmV0dGVyIHRvIGhhdmUgZW5vdWdoIGlkZWFzIGZvciBzb21lIG9mIHRoZW0gdG8gYmUgd3JvbmcsIHRoYW4gdG8gYmUgYWx3YXlzIHJpZ2h0IGJ5IGhhdmluZyBubyBpZGVhcyBhdCBhbGwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFZHdhcmQgZGUgQm9ubyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5dGhpbmcgaGFzIGJlYXV0eSwgYnV0IG5vdCBldmVyeW9uZSBzZWVzIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiT25seSB3aGVuIHdlIGFyZSBubyBsb25nZXIgYWZyYWlkIGRvIHdlIGJlZ2luIHRvIGxpdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb3JvdGh5IFRob21wc29uIgogIH0sCiAgewogICAgInRleHQiOiAiQmVhdXR5IGlzIG5vdCBpbiB0aGUgZmFjZTsgYmVhdXR5IGlzIGEgbGlnaHQgaW4gdGhlIGhlYXJ0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2FobGlsIEdpYnJhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgaGVhciBhbmQgSSBmb3JnZXQuIEkgc2VlIGFuZCBJIHJlbWVtYmVyLiBJIGRvIGFuZCBJIHVuZGVyc3RhbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDaGFuZ2Ugd2lsbCBub3QgY29tZSBpZiB3ZSB3YWl0IGZvciBzb21lIG90aGVyIHBlcnNvbiBvciBzb21lIG90aGVyIHRpbWUuIFdlIGFyZSB0aGUgb25lcyB3ZXZlIGJlZW4gd2FpdGluZyBmb3IuIFdlIGFyZSB0aGUgY2hhbmdlIHRoYXQgd2Ugc2Vlay4iLAogICAgImF0dHJpYnV0aW9uIjogIkJhcmFjayBPYmFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBwb3NzaWJpbGl0aWVzIGFyZSBudW1lcm91cyBvbmNlIHdlIGRlY2lkZSB0byBhY3QgYW5kIG5vdCByZWFjdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkdlb3JnZSBCZXJuYXJkIFNoYXciCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBub3Qgb25lIGJpZyBjb3NtaWMgbWVhbmluZyBmb3IgYWxsLCB0aGVyZSBpcyBvbmx5IHRoZSBtZWFuaW5nIHdlIGVhY2ggZ2l2ZSB0byBvdXIgbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuYWlzIE5pbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGFyZSB0d28gcHJpbWFyeSBjaG9pY2VzIGluIGxpZmU6IHRvIGFjY2VwdCBjb25kaXRpb25zIGFzIHRoZXkgZXhpc3QsIG9yIGFjY2VwdCByZXNwb25zaWJpbGl0eSBmb3IgY2hhbmdpbmcgdGhlbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRlbmlzIFdhaXRsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJLbm93LCBmaXJzdCwgd2hvIHlvdSBhcmUsIGFuZCB0aGVuIGFkb3JuIHlvdXJzZWxmIGFjY29yZGluZ2x5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXBpY3RldHVzIgogIH0sCiAgewogICAgInRleHQiOiAiQWJpbGl0eSBpcyB3aGF0IHlvdSdyZSBjYXBhYmxlIG9mIGRvaW5nLiBNb3RpdmF0aW9uIGRldGVybWluZXMgd2hhdCB5b3UgZG8uQXR0aXR1ZGUgZGV0ZXJtaW5lcyBob3cgd2VsbCB5b3UgZG8gaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMb3UgSG9sdHoiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGlzIHNvIHN0cm9uZyBhcyBnZW50bGVuZXNzLiBOb3RoaW5nIGlzIHNvIGdlbnRsZSBhcyByZWFsIHN0cmVuZ3RoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbmNlcyBkZSBTYWxlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgYWxsb3cgbXkgaW50dWl0aW9uIHRvIGxlYWQgbXkgcGF0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hbnVlbCBQdWlnIgogIH0sCiAgewogICAgInRleHQiOiAiTXl0aHMgd2hpY2ggYXJlIGJlbGlldmVkIGluIHRlbmQgdG8gYmVjb21lIHRydWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZ2UgT3J3ZWxsIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGNhbid0IGZlZWQgYSBodW5kcmVkIHBlb3BsZSwgdGhlbiBmZWVkIGp1c3Qgb25lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTW90aGVyIFRlcmVzYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkh1bWFuIGJlaW5ncywgd2hvIGFyZSBhbG1vc3QgdW5pcXVlIGluIGhhdmluZyB0aGUgYWJpbGl0eSB0byBsZWFybiBmcm9tIHRoZSBleHBlcmllbmNlIG9mIG90aGVycywgYXJlIGFsc28gcmVtYXJrYWJsZSBmb3IgdGhlaXIgYXBwYXJlbnQgZGlzaW5jbGluYXRpb24gdG8gZG8gc28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb3VnbGFzIEFkYW1zIgogIH0sCiAgewogICAgInRleHQiOiAiR2l2ZSB0aGFua3MgZm9yIGEgbGl0dGxlIGFuZCB5b3Ugd2lsbCBmaW5kIGEgbG90LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGF1c2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSBkaXNjb3ZlciB5b3VyIG1pc3Npb24sIHlvdSB3aWxsIGZlZWwgaXRzIGRlbWFuZC4gSXQgd2lsbCBmaWxsIHlvdSB3aXRoIGVudGh1c2lhc20gYW5kIGEgYnVybmluZyBkZXNpcmUgdG8gZ2V0IHRvIHdvcmsgb24gaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXLiBDbGVtZW50IFN0b25lIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGNhbid0IGxldCBwcmFpc2Ugb3IgY3JpdGljaXNtIGdldCB0byB5b3UuIEl0J3MgYSB3ZWFrbmVzcyB0byBnZXQgY2F1Z2h0IHVwIGluIGVpdGhlciBvbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIFdvb2RlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIHRoZSBncmVhdGVzdCBvZiBhbGwgbWlzdGFrZXMgdG8gZG8gbm90aGluZyBiZWNhdXNlIHlvdSBjYW4gb25seSBkbyBsaXR0bGUgLSBkbyB3aGF0IHlvdSBjYW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTeWRuZXkgU21pdGgiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZm9vbGlzaCBtYW4gc2Vla3MgaGFwcGluZXNzIGluIHRoZSBkaXN0YW5jZSwgdGhlIHdpc2UgZ3Jvd3MgaXQgdW5kZXIgaGlzIGZlZXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKYW1lcyBPcHBlbmhlaW0iCiAgfSwKICB7CiAgICAidGV4dCI6ICJTdWNjZXNzIGlzIGdldHRpbmcgd2hhdCB5b3Ugd2FudC4gSGFwcGluZXNzIGlzIHdhbnRpbmcgd2hhdCB5b3UgZ2V0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsZSBDYXJuZWdpZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtb3N0IGZvcm1pZGFibGUgd2VhcG9uIGFnYWluc3QgZXJyb3JzIG9mIGV2ZXJ5IGtpbmQgaXMgcmVhc29uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIFBhaW5lIgogIH0sCiAgewogICAgInRleHQiOiAiT24gZXZlcnkgdGhvcm4sIGRlbGlnaHRmdWwgd2lzZG9tIGdyb3dzLCBJbiBldmVyeSByaWxsIGEgc3dlZXQgaW5zdHJ1Y3Rpb24gZmxvd3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFZHdhcmQgWW91bmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJTaWxlbmNlIGlzIGRlZXAgYXMgRXRlcm5pdHksIFNwZWVjaCBpcyBzaGFsbG93IGFzIFRpbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXJseWxlIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGNhdXRpb3VzIHNlbGRvbSBlcnIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTdHJlbmd0aCBkb2VzIG5vdCBjb21lIGZyb20gcGh5c2ljYWwgY2FwYWNpdHkuIEl0IGNvbWVzIGZyb20gYW4gaW5kb21pdGFibGUgd2lsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1haGF0bWEgR2FuZGhpIgogIH0sCiAgewogICAgInRleHQiOiAiTWFuIGlzIGVxdWFsbHkgaW5jYXBhYmxlIG9mIHNlZWluZyB0aGUgbm90aGluZ25lc3MgZnJvbSB3aGljaCBoZSBlbWVyZ2VzIGFuZCB0aGUgaW5maW5pdHkgaW4gd2hpY2ggaGUgaXMgZW5ndWxmZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCbGFpc2UgUGFzY2FsIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGRheSB5b3UgZGVjaWRlIHRvIGRvIGl0IGlzIHlvdXIgbHVja3kgZGF5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmFwYW5lc2UgcHJvdmVyYiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlZm9yZSB5b3UgY2FuIGluc3BpcmUgd2l0aCBlbW90aW9uLCB5b3UgbXVzdCBiZSBzd2FtcGVkIHdpdGggaXQgeW91cnNlbGYuIEJlZm9yZSB5b3UgY2FuIG1vdmUgdGhlaXIgdGVhcnMsIHlvdXIgb3duIG11c3QgZmxvdy4gVG8gY29udmluY2UgdGhlbSwgeW91IG11c3QgeW91cnNlbGYgYmVsaWV2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiQXMgd2UgZ3JvdyBhcyB1bmlxdWUgcGVyc29ucywgd2UgbGVhcm4gdG8gcmVzcGVjdCB0aGUgdW5pcXVlbmVzcyBvZiBvdGhlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgU2NodWxsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDb3VyYWdlIGlzIGdvaW5nIGZyb20gZmFpbHVyZSB0byBmYWlsdXJlIHdpdGhvdXQgbG9zaW5nIGVudGh1c2lhc20uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaW5zdG9uIENodXJjaGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFsbCBoYXZlIHByb2JsZW1zLiBUaGUgd2F5IHdlIHNvbHZlIHRoZW0gaXMgd2hhdCBtYWtlcyB1cyBkaWZmZXJlbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaWNrZWQgcGVvcGxlIGFyZSBhbHdheXMgc3VycHJpc2VkIHRvIGZpbmQgYWJpbGl0eSBpbiB0aG9zZSB0aGF0IGFyZSBnb29kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFycXVpcyBWYXV2ZW5hcmd1ZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbnl0aGluZyB5b3UgcmVhbGx5IHdhbnQsIHlvdSBjYW4gYXR0YWluLCBpZiB5b3UgcmVhbGx5IGdvIGFmdGVyIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5IGdpZnQgZnJvbSBhIGZyaWVuZCBpcyBhIHdpc2ggZm9yIHlvdXIgaGFwcGluZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiTGV0IHlvdXJzZWxmIGJlIHNpbGVudGx5IGRyYXduIGJ5IHRoZSBzdHJvbmdlciBwdWxsIG9mIHdoYXQgeW91IHJlYWxseSBsb3ZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUnVtaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoYXQgdGhlIGNhdGVycGlsbGFyIGNhbGxzIHRoZSBlbmQgb2YgdGhlIHdvcmxkLCB0aGUgbWFzdGVyIGNhbGxzIGEgYnV0dGVyZmx5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiT25jZSB5b3UgY2hvb3NlIGhvcGUsIGFueXRoaW5ncyBwb3NzaWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNocmlzdG9waGVyIFJlZXZlIgogIH0sCiAgewogICAgInRleHQiOiAiT25seSBkbyB3aGF0IHlvdXIgaGVhcnQgdGVsbHMgeW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUHJpbmNlc3MgRGlhbmEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIGlzIGEgZ2lmdCwgYW5kIGl0IG9mZmVycyB1cyB0aGUgcHJpdmlsZWdlLCBvcHBvcnR1bml0eSwgYW5kIHJlc3BvbnNpYmlsaXR5IHRvIGdpdmUgc29tZXRoaW5nIGJhY2sgYnkgYmVjb21pbmcgbW9yZSIsCiAgICAiYXR0cmlidXRpb24iOiAiQW50aG9ueSBSb2JiaW5zIgogIH0sCiAgewogICAgInRleHQiOiAiTGliZXJ0eSwgdGFraW5nIHRoZSB3b3JkIGluIGl0cyBjb25jcmV0ZSBzZW5zZSwgY29uc2lzdHMgaW4gdGhlIGFiaWxpdHkgdG8gY2hvb3NlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2ltb25lIFdlaWwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGRvbid0IGJlbGlldmUgaW4gZmFpbHVyZS4gSXQncyBub3QgZmFpbHVyZSBpZiB5b3UgZW5qb3llZCB0aGUgcHJvY2Vzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk9wcmFoIFdpbmZyZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHcmF0aXR1ZGUgaXMgbm90IG9ubHkgdGhlIGdyZWF0ZXN0IG9mIHZpcnR1ZXMsIGJ1dCB0aGUgcGFyZW4ndCBvZiBhbGwgdGhlIG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNpY2VybyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBiZXN0IGN1cmUgZm9yIHRoZSBib2R5IGlzIGEgcXVpZXQgbWluZC4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEJvbmFwYXJ0ZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IGp1ZGdlIGVhY2ggZGF5IGJ5IHRoZSBoYXJ2ZXN0IHlvdSByZWFwIGJ1dCBieSB0aGUgc2VlZHMgeW91IHBsYW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IFN0ZXZlbnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV4cGVyaWVuY2UgaXMgbm90IHdoYXQgaGFwcGVucyB0byBhIG1hbi4gSXQgaXMgd2hhdCBhIG1hbiBkb2VzIHdpdGggd2hhdCBoYXBwZW5zIHRvIGhpbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsZG91cyBIdXhsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgbGVhc3QgbW92ZW1lbnQgaXMgb2YgaW1wb3J0YW5jZSB0byBhbGwgbmF0dXJlLiBUaGUgZW50aXJlIG9jZWFuIGlzIGFmZmVjdGVkIGJ5IGEgcGViYmxlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmxhaXNlIFBhc2NhbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdlbnVpbmUgbG92ZSBzaG91bGQgZmlyc3QgYmUgZGlyZWN0ZWQgYXQgb25lc2VsZiAtIGlmIHdlIGRvIG5vdCBsb3ZlIG91cnNlbHZlcywgaG93IGNhbiB3ZSBsb3ZlIG90aGVycz8iLAogICAgImF0dHJpYnV0aW9uIjogIkRhbGFpIExhbWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIb3cgd29uZGVyZnVsIHRoYXQgd2UgaGF2ZSBtZXQgd2l0aCBhIHBhcmFkb3guIE5vdyB3ZSBoYXZlIHNvbWUgaG9wZSBvZiBtYWtpbmcgcHJvZ3Jlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOaWVscyBCb2hyIgogIH0sCiAgewogICAgInRleHQiOiAiSW0gbm90IGluIHRoaXMgd29ybGQgdG8gbGl2ZSB1cCB0byB5b3VyIGV4cGVjdGF0aW9ucyBhbmQgeW91J3JlIG5vdCBpbiB0aGlzIHdvcmxkIHRvIGxpdmUgdXAgdG8gbWluZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJydWNlIExlZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRha2UgdGltZSB0byBkZWxpYmVyYXRlLCBidXQgd2hlbiB0aGUgdGltZSBmb3IgYWN0aW9uIGhhcyBhcnJpdmVkLCBzdG9wIHRoaW5raW5nIGFuZCBnbyBpbi4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEJvbmFwYXJ0ZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhlIHdobyBleHBlcmllbmNlcyB0aGUgdW5pdHkgb2YgbGlmZSBzZWVzIGhpcyBvd24gU2VsZiBpbiBhbGwgYmVpbmdzLCBhbmQgYWxsIGJlaW5ncyBpbiBoaXMgb3duIFNlbGYsIGFuZCBsb29rcyBvbiBldmVyeXRoaW5nIHdpdGggYW4gaW1wYXJ0aWFsIGV5ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWV0aW1lcyB0aGUgbW9zdCBpbXBvcnRhbnQgdGhpbmcgaW4gYSB3aG9sZSBkYXkgaXMgdGhlIHJlc3Qgd2UgdGFrZSBiZXR3ZWVuIHR3byBkZWVwIGJyZWF0aHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFdHR5IEhpbGxlc3VtIgogIH0sCiAgewogICAgInRleHQiOiAiR3JlYXQgaWRlYXMgb2Z0ZW4gcmVjZWl2ZSB2aW9sZW50IG9wcG9zaXRpb24gZnJvbSBtZWRpb2NyZSBtaW5kcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBTdXBlcmlvciBNYW4gaXMgYXdhcmUgb2YgUmlnaHRlb3VzbmVzcywgdGhlIGluZmVyaW9yIG1hbiBpcyBhd2FyZSBvZiBhZHZhbnRhZ2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCB0YWtlcyBjb3VyYWdlIHRvIGdyb3cgdXAgYW5kIGJlY29tZSB3aG8geW91IHJlYWxseSBhcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFLiBFLiBDdW1taW5ncyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZvciBldmVyeXRoaW5nIHRoYXQgbGl2ZXMgaXMgaG9seSwgbGlmZSBkZWxpZ2h0cyBpbiBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBCbGFrZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJldHRlciB0aGFuIGEgdGhvdXNhbmQgaG9sbG93IHdvcmRzIGlzIG9uZSB3b3JkIHRoYXQgYnJpbmdzIHBlYWNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiQmVnaW4sIGJlIGJvbGQsIGFuZCB2ZW50dXJlIHRvIGJlIHdpc2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIb3JhY2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGb3J0dW5lIGZhdm91cnMgdGhlIGJyYXZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVmlyZ2lsIgogIH0sCiAgewogICAgInRleHQiOiAiVGVuc2lvbiBpcyB3aG8geW91IHRoaW5rIHlvdSBzaG91bGQgYmUuIFJlbGF4YXRpb24gaXMgd2hvIHlvdSBhcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGluZXNlIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3Ugd2lsbCBub3QgYmUgcHVuaXNoZWQgZm9yIHlvdXIgYW5nZXIsIHlvdSB3aWxsIGJlIHB1bmlzaGVkIGJ5IHlvdXIgYW5nZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgdGhpbmcgYWx3YXlzIGhhcHBlbnMgdGhhdCB5b3UgcmVhbGx5IGJlbGlldmUgaW47IGFuZCB0aGUgYmVsaWVmIGluIGEgdGhpbmcgbWFrZXMgaXQgaGFwcGVuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbmsgV3JpZ2h0IgogIH0sCiAgewogICAgInRleHQiOiAiSGFwcGluZXNzIGlzIHdoZW4gd2hhdCB5b3UgdGhpbmssIHdoYXQgeW91IHNheSwgYW5kIHdoYXQgeW91IGRvIGFyZSBpbiBoYXJtb255LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTW9oYW5kYXMgR2FuZGhpIgogIH0sCiAgewogICAgInRleHQiOiAiQWx3YXlzIHRlbGwgdGhlIHRydXRoLiBUaGF0IHdheSwgeW91IGRvbid0IGhhdmUgdG8gcmVtZW1iZXIgd2hhdCB5b3Ugc2FpZC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmsgVHdhaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2Fubm90IGhhdmUgd2hhdCB5b3UgZG8gbm90IHdhbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIEFjb3N0YSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvIGxvbmcgYXMgYSBwZXJzb24gaXMgY2FwYWJsZSBvZiBzZWxmLXJlbmV3YWwgdGhleSBhcmUgYSBsaXZpbmcgYmVpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yaS1GcmVkZXJpYyBBbWllbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBvbmx5IGxpbWl0IHRvIG91ciByZWFsaXphdGlvbiBvZiB0b21vcnJvdyB3aWxsIGJlIG91ciBkb3VidHMgb2YgdG9kYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmFua2xpbiBSb29zZXZlbHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJLZWVwaW5nIGEgbGl0dGxlIGFoZWFkIG9mIGNvbmRpdGlvbnMgaXMgb25lIG9mIHRoZSBzZWNyZXRzIG9mIGJ1c2luZXNzLCB0aGUgdHJhaWxlciBzZWxkb20gZ29lcyBmYXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFybGVzIFNjaHdhYiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIG1vcmUgaW1wb3J0YW50IHRvIGtub3cgd2hlcmUgeW91IGFyZSBnb2luZyB0aGFuIHRvIGdldCB0aGVyZSBxdWlja2x5LiBEbyBub3QgbWlzdGFrZSBhY3Rpdml0eSBmb3IgYWNoaWV2ZW1lbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYWJlbCBOZXdjb21iZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGdvb2QgcmVzdCBpcyBoYWxmIHRoZSB3b3JrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgaXMgbm8gZ3JlYXQgZ2VuaXVzIHdpdGhvdXQgc29tZSB0b3VjaCBvZiBtYWRuZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2VuZWNhIgogIH0sCiAgewogICAgInRleHQiOiAiRm9sbG93IHlvdXIgaW5zdGluY3RzLiBUaGF0IGlzIHdoZXJlIHRydWUgd2lzZG9tIG1hbmlmZXN0cyBpdHNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPcHJhaCBXaW5mcmV5IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHRydXRoIHlvdSBiZWxpZXZlIGFuZCBjbGluZyB0byBtYWtlcyB5b3UgdW5hdmFpbGFibGUgdG8gaGVhciBhbnl0aGluZyBuZXcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQZW1hIENob2Ryb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeW9uZSBjYW4gdGFzdGUgc3VjY2VzcyB3aGVuIHRoZSBnb2luZyBpcyBlYXN5LCBidXQgZmV3IGtub3cgaG93IHRvIHRhc3RlIHZpY3Rvcnkgd2hlbiB0aW1lcyBnZXQgdG91Z2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5dGhpbmcgdGhhdCBoYXBwZW5zIGhhcHBlbnMgYXMgaXQgc2hvdWxkLCBhbmQgaWYgeW91IG9ic2VydmUgY2FyZWZ1bGx5LCB5b3Ugd2lsbCBmaW5kIHRoaXMgdG8gYmUgc28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJjdXMgQXVyZWxpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCbGVzc2VkIGlzIHRoZSBwZXJzb24gd2hvIGlzIHRvbyBidXN5IHRvIHdvcnJ5IGluIHRoZSBkYXl0aW1lLCBhbmQgdG9vIHNsZWVweSB0byB3b3JyeSBhdCBuaWdodC4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlbyBBaWttYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3VyIG91dGxvb2sgb24gbGlmZSBpcyBhIGRpcmVjdCByZWZsZWN0aW9uIG9uIGhvdyBtdWNoIHlvdSBsaWtlIHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTHVsdWxlbW9uIgogIH0sCiAgewogICAgInRleHQiOiAiTW9zdCBvZiB0aGUgc2hhZG93cyBvZiBsaWZlIGFyZSBjYXVzZWQgYnkgc3RhbmRpbmcgaW4gb3VyIG93biBzdW5zaGluZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIFdhbGRvIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgcmVhbGx5IHVuaGFwcHkgcGVyc29uIGlzIHRoZSBvbmUgd2hvIGxlYXZlcyB1bmRvbmUgd2hhdCB0aGV5IGNhbiBkbywgYW5kIHN0YXJ0cyBkb2luZyB3aGF0IHRoZXkgZG9uJ3QgdW5kZXJzdGFuZDsgbm8gd29uZGVyIHRoZXkgY29tZSB0byBncmllZi4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubiBXb2xmZ2FuZyB2b24gR29ldGhlIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGhlaWdodCBvZiB5b3VyIGFjY29tcGxpc2htZW50cyB3aWxsIGVxdWFsIHRoZSBkZXB0aCBvZiB5b3VyIGNvbnZpY3Rpb25zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTY29sYXZpbm8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBsb3N0IGJlY2F1c2Ugd2UgdG9sZCBvdXJzZWx2ZXMgd2UgbG9zdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlbyBUb2xzdG95IgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGNvcnJlY3QgeW91ciBtaW5kLCB0aGUgcmVzdCBvZiB5b3VyIGxpZmUgd2lsbCBmYWxsIGludG8gcGxhY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG9ubHkgZGlmZmVyZW5jZSBiZXR3ZWVuIHlvdXIgYWJpbGl0aWVzIGFuZCBvdGhlcnMgaXMgdGhlIGFiaWxpdHkgdG8gcHV0IHlvdXJzZWxmIGluIHRoZWlyIHNob2VzIGFuZCBhY3R1YWxseSB0cnkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMZW9uYXJkbyBSdWl6IgogIH0sCiAgewogICAgInRleHQiOiAiQXMgeW91IHRoaW5rLCBzbyBzaGFsbCB5b3UgYmVjb21lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJ1Y2UgTGVlIgogIH0sCiAgewogICAgInRleHQiOiAiTXkgbWFtYSBhbHdheXMgc2FpZDogbGlmZSdzIGxpa2UgYSBib3ggb2YgY2hvY29sYXRlIC0geW91IG5ldmVyIGtub3cgd2hhdCB5b3UgZ29ubmEgZ2V0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRm9ycmVzdCBHdW1wIgogIH0sCiAgewogICAgInRleHQiOiAiVHJ1c3Qgb25seSBtb3ZlbWVudC4gTGlmZSBoYXBwZW5zIGF0IHRoZSBsZXZlbCBvZiBldmVudHMsIG5vdCBvZiB3b3Jkcy4gVHJ1c3QgbW92ZW1lbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGZyZWQgQWRsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgbW9zdCBkZWNpc2l2ZSBhY3Rpb25zIG9mIG91ciBsaWZlLi4uIGFyZSBtb3N0IG9mdGVuIHVuY29uc2lkZXJlZCBhY3Rpb25zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW5kcmUgR2lkZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzbWFsbGVzdCBhY3Qgb2Yga2luZG5lc3MgaXMgd29ydGggbW9yZSB0aGFuIHRoZSBncmFuZGVzdCBpbnRlbnRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPc2NhciBXaWxkZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBsb29rIGludG8geW91ciBvd24gaGVhcnQsIGFuZCB5b3UgZmluZCBub3RoaW5nIHdyb25nIHRoZXJlLCB3aGF0IGlzIHRoZXJlIHRvIHdvcnJ5IGFib3V0PyBXaGF0IGlzIHRoZXJlIHRvIGZlYXI/IiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaXN0ZW4gdG8gd2hhdCB5b3Uga25vdyBpbnN0ZWFkIG9mIHdoYXQgeW91IGZlYXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJPbmUgd2hvIGlzIHRvbyBpbnNpc3RlbnQgb24gaGlzIG93biB2aWV3cywgZmluZHMgZmV3IHRvIGFncmVlIHdpdGggaGltLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vIG9uZSBoYXMgYSBmaW5lciBjb21tYW5kIG9mIGxhbmd1YWdlIHRoYW4gdGhlIHBlcnNvbiB3aG8ga2VlcHMgaGlzIG1vdXRoIHNodXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTYW0gUmF5YnVybiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdXIgZnJpZW5kcyB3aWxsIGtub3cgeW91IGJldHRlciBpbiB0aGUgZmlyc3QgbWludXRlIHlvdSBtZWV0IHRoYW4geW91ciBhY3F1YWludGFuY2VzIHdpbGwga25vdyB5b3UgaW4gYSB0aG91c2FuZCB5ZWFycy4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpY2hhcmQgQmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldvcmsgd2hpbGUgeW91IGhhdmUgdGhlIGxpZ2h0LiBZb3UgYXJlIHJlc3BvbnNpYmxlIGZvciB0aGUgdGFsZW50IHRoYXQgaGFzIGJlZW4gZW50cnVzdGVkIHRvIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbnJpLUZyZWRlcmljIEFtaWVsIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHJpZ2h0IHdheSBpcyBub3QgYWx3YXlzIHRoZSBwb3B1bGFyIGFuZCBlYXN5IHdheS4gU3RhbmRpbmcgZm9yIHJpZ2h0IHdoZW4gaXQgaXMgdW5wb3B1bGFyIGlzIGEgdHJ1ZSB0ZXN0IG9mIG1vcmFsIGNoYXJhY3Rlci4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmdhcmV0IFNtaXRoIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHdlYWsgY2FuIG5ldmVyIGZvcmdpdmUuIEZvcmdpdmVuZXNzIGlzIHRoZSBhdHRyaWJ1dGUgb2YgdGhlIHN0cm9uZy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vaGFuZGFzIEdhbmRoaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZpbmUgd29yZHMgYW5kIGFuIGluc2ludWF0aW5nIGFwcGVhcmFuY2UgYXJlIHNlbGRvbSBhc3NvY2lhdGVkIHdpdGggdHJ1ZSB2aXJ0dWUiLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBncmVhdGVzdCBiYXJyaWVyIHRvIHN1Y2Nlc3MgaXMgdGhlIGZlYXIgb2YgZmFpbHVyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVyaWtzc29uIgogIH0sCiAgewogICAgInRleHQiOiAiR29kIGFsd2F5cyB0YWtlcyB0aGUgc2ltcGxlc3Qgd2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IEVpbnN0ZWluIgogIH0sCiAgewogICAgInRleHQiOiAiSW1hZ2luYXRpb24gaXMgdGhlIGhpZ2hlc3Qga2l0ZSBvbmUgY2FuIGZseS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhdXJlbiBCYWNhbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgcGVyc29uIHdobyBsaXZlcyBsaWZlIGZ1bGx5LCBnbG93aW5nIHdpdGggbGlmZSdzIGVuZXJneSwgaXMgdGhlIHBlcnNvbiB3aG8gbGl2ZXMgYSBzdWNjZXNzZnVsIGxpZmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWlzYWt1IElrZWRhIgogIH0sCiAgewogICAgInRleHQiOiAiUGVvcGxlIHdobyBzYXkgaXQgY2Fubm90IGJlIGRvbmUgc2hvdWxkIG5vdCBpbnRlcnJ1cHQgdGhvc2Ugd2hvIGFyZSBkb2luZyBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoaW5lc2UgcHJvdmVyYiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhpc3Rvcnkgd2lsbCBiZSBraW5kIHRvIG1lIGZvciBJIGludGVuZCB0byB3cml0ZSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiTm8gb25lIHNhdmVzIHVzIGJ1dCBvdXJzZWx2ZXMuIE5vIG9uZSBjYW4gYW5kIG5vIG9uZSBtYXkuIFdlIG91cnNlbHZlcyBtdXN0IHdhbGsgdGhlIHBhdGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgdHJ1dGggd2hpY2ggaGFzIG1hZGUgdXMgZnJlZSB3aWxsIGluIHRoZSBlbmQgbWFrZSB1cyBnbGFkIGFsc28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGZWxpeCBBZGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdvb2QgYWN0aW9ucyBnaXZlIHN0cmVuZ3RoIHRvIG91cnNlbHZlcyBhbmQgaW5zcGlyZSBnb29kIGFjdGlvbnMgaW4gb3RoZXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGxhdG8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBpbXBvc3NpYmxlIHRvIGZlZWwgZ3JhdGVmdWwgYW5kIGRlcHJlc3NlZCBpbiB0aGUgc2FtZSBtb21lbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYW9taSBXaWxsaWFtcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIG11c3QgbGVhcm4gb3VyIGxpbWl0cy4gV2UgYXJlIGFsbCBzb21ldGhpbmcsIGJ1dCBub25lIG9mIHVzIGFyZSBldmVyeXRoaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmxhaXNlIFBhc2NhbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vdGhpbmcgd2lsbCB3b3JrIHVubGVzcyB5b3UgZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXlhIEFuZ2Vsb3UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCbGF6ZSB3aXRoIHRoZSBmaXJlIHRoYXQgaXMgbmV2ZXIgZXh0aW5ndWlzaGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTHVpc2EgU2lnZWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbiBzZXBhcmF0ZW5lc3MgbGllcyB0aGUgd29ybGQncyBncmVhdCBtaXNlcnksIGluIGNvbXBhc3Npb24gbGllcyB0aGUgd29ybGQncyB0cnVlIHN0cmVuZ3RoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiWW91LCB5b3Vyc2VsZiwgYXMgbXVjaCBhcyBhbnlib2R5IGluIHRoZSBlbnRpcmUgdW5pdmVyc2UsIGRlc2VydmUgeW91ciBsb3ZlIGFuZCBhZmZlY3Rpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgcGFzdCBoYXMgbm8gcG93ZXIgdG8gc3RvcCB5b3UgZnJvbSBiZWluZyBwcmVzZW50IG5vdy4gT25seSB5b3VyIGdyaWV2YW5jZSBhYm91dCB0aGUgcGFzdCBjYW4gZG8gdGhhdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkVja2hhcnQgVG9sbGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgYWNjZXB0IHRoZSBleHBlY3RhdGlvbnMgb2Ygb3RoZXJzLCBlc3BlY2lhbGx5IG5lZ2F0aXZlIG9uZXMsIHRoZW4geW91IG5ldmVyIHdpbGwgY2hhbmdlIHRoZSBvdXRjb21lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWljaGFlbCBKb3JkYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgYXJlIHBhdGllbnQgaW4gb25lIG1vbWVudCBvZiBhbmdlciwgeW91IHdpbGwgZXNjYXBlIG9uZSBodW5kcmVkIGRheXMgb2Ygc29ycm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hpbmVzZSBwcm92ZXJiIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGJpZ2dlc3QgYWR2ZW50dXJlIHlvdSBjYW4gZXZlciB0YWtlIGlzIHRvIGxpdmUgdGhlIGxpZmUgb2YgeW91ciBkcmVhbXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPcHJhaCBXaW5mcmV5IgogIH0sCiAgewogICAgInRleHQiOiAiQWN0IGFzIGlmIHdoYXQgeW91IGRvIG1ha2VzIGEgZGlmZmVyZW5jZS4gSXQgZG9lcy4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gSmFtZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8ga25vd3MgdGhhdCBlbm91Z2ggaXMgZW5vdWdoIHdpbGwgYWx3YXlzIGhhdmUgZW5vdWdoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgcm9sbGluZyBzdG9uZSBnYXRoZXJzIG5vIG1vc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQdWJsaWxpdXMgU3lydXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCB3YWl0LiBUaGUgdGltZSB3aWxsIG5ldmVyIGJlIGp1c3QgcmlnaHQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdGV2ZXIgaGFwcGVucywgdGFrZSByZXNwb25zaWJpbGl0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIlRvbnkgUm9iYmlucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB0cnVlc3Qgd2lzZG9tIGlzIGEgcmVzb2x1dGUgZGV0ZXJtaW5hdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEJvbmFwYXJ0ZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBhcmUgaW1wb3J0YW50IGVub3VnaCB0byBhc2sgYW5kIHlvdSBhcmUgYmxlc3NlZCBlbm91Z2ggdG8gcmVjZWl2ZSBiYWNrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkx1Y2sgaXMgd2hhdCBoYXBwZW5zIHdoZW4gcHJlcGFyYXRpb24gbWVldHMgb3Bwb3J0dW5pdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTZW5lY2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIGl0IGlzIG9idmlvdXMgdGhhdCB0aGUgZ29hbHMgY2Fubm90IGJlIHJlYWNoZWQsIGRvbid0IGFkanVzdCB0aGUgZ29hbHMsIGFkanVzdCB0aGUgYWN0aW9uIHN0ZXBzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHdvcmxkIGlzIHJvdW5kIGFuZCB0aGUgcGxhY2Ugd2hpY2ggbWF5IHNlZW0gbGlrZSB0aGUgZW5kIG1heSBhbHNvIGJlIHRoZSBiZWdpbm5pbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJJdnkgQmFrZXIgUHJpZXN0IgogIH0sCiAgewogICAgInRleHQiOiAiV2UgYXJlIHRoZSBsZWF2ZXMgb2Ygb25lIGJyYW5jaCwgdGhlIGRyb3BzIG9mIG9uZSBzZWEsIHRoZSBmbG93ZXJzIG9mIG9uZSBnYXJkZW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKZWFuIExhY29yZGFpcmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciBtaXN0YWtlIG1vdGlvbiBmb3IgYWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXJuZXN0IEhlbWluZ3dheSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZlZWxpbmcgYW5kIGxvbmdpbmcgYXJlIHRoZSBtb3RpdmUgZm9yY2VzIGJlaGluZCBhbGwgaHVtYW4gZW5kZWF2b3IgYW5kIGh1bWFuIGNyZWF0aW9ucy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9mIGNvdXJzZSB0aGVyZSBpcyBubyBmb3JtdWxhIGZvciBzdWNjZXNzIGV4Y2VwdCBwZXJoYXBzIGFuIHVuY29uZGl0aW9uYWwgYWNjZXB0YW5jZSBvZiBsaWZlIGFuZCB3aGF0IGl0IGJyaW5ncy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFydGh1ciBSdWJpbnN0ZWluIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gbGl2ZSBhIHB1cmUgdW5zZWxmaXNoIGxpZmUsIG9uZSBtdXN0IGNvdW50IG5vdGhpbmcgYXMgb25lcyBvd24gaW4gdGhlIG1pZHN0IG9mIGFidW5kYW5jZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZpcnN0IHNheSB0byB5b3Vyc2VsZiB3aGF0IHlvdSB3b3VsZCBiZTsgYW5kIHRoZW4gZG8gd2hhdCB5b3UgaGF2ZSB0byBkby4iLAogICAgImF0dHJpYnV0aW9uIjogIkVwaWN0ZXR1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9ubHkgdGhyb3VnaCBvdXIgY29ubmVjdGVkbmVzcyB0byBvdGhlcnMgY2FuIHdlIHJlYWxseSBrbm93IGFuZCBlbmhhbmNlIHRoZSBzZWxmLiBBbmQgb25seSB0aHJvdWdoIHdvcmtpbmcgb24gdGhlIHNlbGYgY2FuIHdlIGJlZ2luIHRvIGVuaGFuY2Ugb3VyIGNvbm5lY3RlZG5lc3MgdG8gb3RoZXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGFycmlldCBMZXJuZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2Fubm90IGJlIGxvbmVseSBpZiB5b3UgbGlrZSB0aGUgcGVyc29uIHlvdSdyZSBhbG9uZSB3aXRoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRydXN0IHlvdXJzZWxmLiBZb3Uga25vdyBtb3JlIHRoYW4geW91IHRoaW5rIHlvdSBkby4iLAogICAgImF0dHJpYnV0aW9uIjogIkJlbmphbWluIFNwb2NrIgogIH0sCiAgewogICAgInRleHQiOiAiQXV0dW1uIGlzIGEgc2Vjb25kIHNwcmluZyB3aGVuIGV2ZXJ5IGxlYWYgaXMgYSBmbG93ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgQ2FtdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeSBwcm9ibGVtIGhhcyBhIGdpZnQgZm9yIHlvdSBpbiBpdHMgaGFuZHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgYmlyZCBvZiBwYXJhZGlzZSBhbGlnaHRzIG9ubHkgdXBvbiB0aGUgaGFuZCB0aGF0IGRvZXMgbm90IGdyYXNwLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBCZXJyeSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldpbm5lcnMgaGF2ZSBzaW1wbHkgZm9ybWVkIHRoZSBoYWJpdCBvZiBkb2luZyB0aGluZ3MgbG9zZXJzIGRvbid0IGxpa2UgdG8gZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgR3JheSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkVhY2ggdGltZSB3ZSBmYWNlIGEgZmVhciwgd2UgZ2FpbiBzdHJlbmd0aCwgY291cmFnZSwgYW5kIGNvbmZpZGVuY2UgaW4gdGhlIGRvaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGZpcnN0IGR1dHkgb2YgYSBodW1hbiBiZWluZyBpcyB0byBhc3N1bWUgdGhlIHJpZ2h0IGZ1bmN0aW9uYWwgcmVsYXRpb25zaGlwIHRvIHNvY2lldHkgLSBtb3JlIGJyaWVmbHksIHRvIGZpbmQgeW91ciByZWFsIGpvYiwgYW5kIGRvIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hhcmxvdHRlIFBlcmtpbnMgR2lsbWFuIgogIH0sCiAgewogICAgInRleHQiOiAiQmUgbm90IGFuZ3J5IHRoYXQgeW91IGNhbm5vdCBtYWtlIG90aGVycyBhcyB5b3Ugd2lzaCB0aGVtIHRvIGJlLCBzaW5jZSB5b3UgY2Fubm90IG1ha2UgeW91cnNlbGYgYXMgeW91IHdpc2ggdG8gYmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaG9tYXMgS2VtcGlzIgogIH0sCiAgewogICAgInRleHQiOiAiTXVzaWMgaW4gdGhlIHNvdWwgY2FuIGJlIGhlYXJkIGJ5IHRoZSB1bml2ZXJzZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGlzIHNvZnRlciBvciBtb3JlIGZsZXhpYmxlIHRoYW4gd2F0ZXIsIHlldCBub3RoaW5nIGNhbiByZXNpc3QgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiSG9sZGluZyBvbiB0byBhbmdlciBpcyBsaWtlIGdyYXNwaW5nIGEgaG90IGNvYWwgd2l0aCB0aGUgaW50ZW50IG9mIHRocm93aW5nIGl0IGF0IHNvbWVvbmUgZWxzZTsgeW91IGFyZSB0aGUgb25lIHdobyBnZXRzIGJ1cm5lZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgdGhpbmsgeW91IGNhbiBoYXZlIG1vZGVyYXRlIHN1Y2Nlc3MgYnkgY29weWluZyBzb21ldGhpbmcgZWxzZSwgYnV0IGlmIHlvdSByZWFsbHkgd2FudCB0byBrbm9jayBpdCBvdXQgb2YgdGhlIHBhcmssIHlvdSBoYXZlIHRvIGRvIHNvbWV0aGluZyBkaWZmZXJlbnQgYW5kIHRha2UgY2hhbmNlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlZSBXb21hY2siCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIHdpc2UgbWFuIHdpbGwgbWFrZSBtb3JlIG9wcG9ydHVuaXRpZXMgdGhhbiBoZSBmaW5kcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkZyYW5jaXMgQmFjb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3VyIHZpc2lvbiB3aWxsIGJlY29tZSBjbGVhciBvbmx5IHdoZW4geW91IGNhbiBsb29rIGludG8geW91ciBvd24gaGVhcnQuIFdobyBsb29rcyBvdXRzaWRlLCBkcmVhbXM7IHdobyBsb29rcyBpbnNpZGUsIGF3YWtlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNhcmwgSnVuZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIGJldHRlciB0byB0cmF2ZWwgd2VsbCB0aGFuIHRvIGFycml2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIG9ubHkgb25lIHN1Y2Nlc3MgLSB0byBiZSBhYmxlIHRvIHNwZW5kIHlvdXIgbGlmZSBpbiB5b3VyIG93biB3YXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaHJpc3RvcGhlciBNb3JsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIHdvdWxkIG1haW50YWluIHRoYXQgdGhhbmtzIGFyZSB0aGUgaGlnaGVzdCBmb3JtIG9mIHRob3VnaHQsIGFuZCB0aGF0IGdyYXRpdHVkZSBpcyBoYXBwaW5lc3MgZG91YmxlZCBieSB3b25kZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHLiBLLiBDaGVzdGVydG9uIgogIH0sCiAgewogICAgInRleHQiOiAiTmV2ZXIgdGVsbCBtZSB0aGUgc2t5J3MgdGhlIGxpbWl0IHdoZW4gdGhlcmUgYXJlIGZvb3RwcmludHMgb24gdGhlIG1vb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciwgbmV2ZXIsIG5ldmVyIGdpdmUgdXAuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaW5zdG9uIENodXJjaGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNldHRpbmcgZ29hbHMgaXMgdGhlIGZpcnN0IHN0ZXAgaW4gdHVybmluZyB0aGUgaW52aXNpYmxlIGludG8gdGhlIHZpc2libGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb255IFJvYmJpbnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMZWFkZXJzIGFyZW4ndCBib3JuIHRoZXkgYXJlIG1hZGUuIEFuZCB0aGV5IGFyZSBtYWRlIGp1c3QgbGlrZSBhbnl0aGluZyBlbHNlLCB0aHJvdWdoIGhhcmQgd29yay4gQW5kIHRoYXQncyB0aGUgcHJpY2Ugd2VsbCBoYXZlIHRvIHBheSB0byBhY2hpZXZlIHRoYXQgZ29hbCwgb3IgYW55IGdvYWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJWaW5jZSBMb21iYXJkaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldvcmsgZm9yIHNvbWV0aGluZyBiZWNhdXNlIGl0IGlzIGdvb2QsIG5vdCBqdXN0IGJlY2F1c2UgaXQgc3RhbmRzIGEgY2hhbmNlIHRvIHN1Y2NlZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJWYWNsYXYgSGF2ZWwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJPdXIgbGl2ZXMgYXJlIHRoZSBvbmx5IG1lYW5pbmdmdWwgZXhwcmVzc2lvbiBvZiB3aGF0IHdlIGJlbGlldmUgYW5kIGluIFdob20gd2UgYmVsaWV2ZS4gQW5kIHRoZSBvbmx5IHJlYWwgd2VhbHRoLCBmb3IgYW55IG9mIHVzLCBsaWVzIGluIG91ciBmYWl0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIkdvcmRvbiBIaW5ja2xleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhdmluZyBub3RoaW5nLCBub3RoaW5nIGNhbiBoZSBsb3NlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlbGlldmUgZGVlcCBkb3duIGluIHlvdXIgaGVhcnQgdGhhdCB5b3UncmUgZGVzdGluZWQgdG8gZG8gZ3JlYXQgdGhpbmdzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9lIFBhdGVybm8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbGwgdGhlIHdvcmxkIGlzIGEgc3RhZ2UsIEFuZCBhbGwgdGhlIG1lbiBhbmQgd29tZW4gbWVyZWx5IHBsYXllcnMuVGhleSBoYXZlIHRoZWlyIGV4aXRzIGFuZCBlbnRyYW5jZXM7IEVhY2ggbWFuIGluIGhpcyB0aW1lIHBsYXlzIG1hbnkgcGFydHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIFNoYWtlc3BlYXJlIgogIH0sCiAgewogICAgInRleHQiOiAiT3VyIGdyZWF0ZXN0IGdsb3J5IGlzIG5vdCBpbiBuZXZlciBmYWlsaW5nIGJ1dCByaXNpbmcgZXZlcnl0aW1lIHdlIGZhbGwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBiZXR0ZXIgdG8gdGFrZSBtYW55IHNtYWxsIHN0ZXBzIGluIHRoZSByaWdodCBkaXJlY3Rpb24gdGhhbiB0byBtYWtlIGEgZ3JlYXQgbGVhcCBmb3J3YXJkIG9ubHkgdG8gc3R1bWJsZSBiYWNrd2FyZC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHdlIGhhdmUgYSBwb3NpdGl2ZSBtZW50YWwgYXR0aXR1ZGUsIHRoZW4gZXZlbiB3aGVuIHN1cnJvdW5kZWQgYnkgaG9zdGlsaXR5LCB3ZSBzaGFsbCBub3QgbGFjayBpbm5lciBwZWFjZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhbGFpIExhbWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNb25leSB3YXMgbmV2ZXIgYSBiaWcgbW90aXZhdGlvbiBmb3IgbWUsIGV4Y2VwdCBhcyBhIHdheSB0byBrZWVwIHNjb3JlLiBUaGUgcmVhbCBleGNpdGVtZW50IGlzIHBsYXlpbmcgdGhlIGdhbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb25hbGQgVHJ1bXAiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeW9uZSBpcyBhIGdlbml1cyBhdCBsZWFzdCBvbmNlIGEgeWVhci4gQSByZWFsIGdlbml1cyBoYXMgaGlzIG9yaWdpbmFsIGlkZWFzIGNsb3NlciB0b2dldGhlci4iLAogICAgImF0dHJpYnV0aW9uIjogIkdlb3JnIExpY2h0ZW5iZXJnIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG1vc3QgaW1wb3J0YW50IHBvaW50IGlzIHRvIGFjY2VwdCB5b3Vyc2VsZiBhbmQgc3RhbmQgb24geW91ciB0d28gZmVldC4iLAogICAgImF0dHJpYnV0aW9uIjogIlNodW5yeXUgU3V6dWtpIgogIH0sCiAgewogICAgInRleHQiOiAiU3VjY2VzcyBpcyBub3QgdGhlIGtleSB0byBoYXBwaW5lc3MuIEhhcHBpbmVzcyBpcyB0aGUga2V5IHRvIHN1Y2Nlc3MuIElmIHlvdSBsb3ZlIHdoYXQgeW91IGFyZSBkb2luZywgeW91IHdpbGwgYmUgc3VjY2Vzc2Z1bC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBTY2h3ZWl0emVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGhhcHB5IGFuZCBlZmZpY2llbnQgcGVvcGxlIGluIHRoaXMgd29ybGQgYXJlIHRob3NlIHdobyBhY2NlcHQgdHJvdWJsZSBhcyBhIG5vcm1hbCBkZXRhaWwgb2YgaHVtYW4gbGlmZSBhbmQgcmVzb2x2ZSB0byBjYXBpdGFsaXplIGl0IHdoZW4gaXQgY29tZXMgYWxvbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJILiBCZXJ0cmFtIExld2lzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGxlYXN0IG9mIHRoaW5ncyB3aXRoIGEgbWVhbmluZyBpcyB3b3J0aCBtb3JlIGluIGxpZmUgdGhhbiB0aGUgZ3JlYXRlc3Qgb2YgdGhpbmdzIHdpdGhvdXQgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXJsIEp1bmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJSZW1lbWJlcmluZyBhIHdyb25nIGlzIGxpa2UgY2FycnlpbmcgYSBidXJkZW4gb24gdGhlIG1pbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIGlzIGNoYW5nZS4gR3Jvd3RoIGlzIG9wdGlvbmFsLiBDaG9vc2Ugd2lzZWx5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2FyZW4gQ2xhcmsiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUcm91YmxlIGlzIG9ubHkgb3Bwb3J0dW5pdHkgaW4gd29yayBjbG90aGVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgSi4gS2Fpc2VyIgogIH0sCiAgewogICAgInRleHQiOiAiSSB0aGluayBhbmQgdGhhdCBpcyBhbGwgdGhhdCBJIGFtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2F5bmUgRHllciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzZWNyZXQgb2Ygc3VjY2VzcyBpcyBjb25zdGFuY3kgdG8gcHVycG9zZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJlbmphbWluIERpc3JhZWxpIgogIH0sCiAgewogICAgInRleHQiOiAiR29vZCB0aW1iZXIgZG9lcyBub3QgZ3JvdyB3aXRoIGVhc2U7IHRoZSBzdHJvbmdlciB0aGUgd2luZCwgdGhlIHN0cm9uZ2VyIHRoZSB0cmVlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkouIFdpbGxhcmQgTWFycmlvdHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZXR0ZXIgdG8gaGF2ZSBsb3ZlZCBhbmQgbG9zdCwgdGhhbiB0byBoYXZlIG5ldmVyIGxvdmVkIGF0IGFsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIlN0LiBBdWd1c3RpbmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgcG9vciBtYW4gaXMgbm90IGhlIHdobyBpcyB3aXRob3V0IGEgY2VudCwgYnV0IGhlIHdobyBpcyB3aXRob3V0IGEgZHJlYW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIYXJyeSBLZW1wIgogIH0sCiAgewogICAgInRleHQiOiAiV2l0aG91dCBwYXNzaW9uIG1hbiBpcyBhIG1lcmUgbGF0ZW50IGZvcmNlIGFuZCBwb3NzaWJpbGl0eSwgbGlrZSB0aGUgZmxpbnQgd2hpY2ggYXdhaXRzIHRoZSBzaG9jayBvZiB0aGUgaXJvbiBiZWZvcmUgaXQgY2FuIGdpdmUgZm9ydGggaXRzIHNwYXJrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW1pZWwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNb3N0IHBvd2VyZnVsIGlzIGhlIHdobyBoYXMgaGltc2VsZiBpbiBoaXMgb3duIHBvd2VyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2VuZWNhIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGRpZmZlcmVuY2UgYmV0d2VlbiB3aGF0IHdlIGRvIGFuZCB3aGF0IHdlIGFyZSBjYXBhYmxlIG9mIGRvaW5nIHdvdWxkIHN1ZmZpY2UgdG8gc29sdmUgbW9zdCBvZiB0aGUgd29ybGRzIHByb2JsZW1zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTW9oYW5kYXMgR2FuZGhpIgogIH0sCiAgewogICAgInRleHQiOiAiR2l2ZSBhIG1hbiBhIGZpc2ggYW5kIHlvdSBmZWVkIGhpbSBmb3IgYSBkYXkuIFRlYWNoIGhpbSBob3cgdG8gZmlzaCBhbmQgeW91IGZlZWQgaGltIGZvciBhIGxpZmV0aW1lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV4cGVyaWVuY2Uga2VlcHMgYSBkZWFyIHNjaG9vbCwgYnV0IGZvb2xzIHdpbGwgbGVhcm4gaW4gbm8gb3RoZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBGcmFua2xpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgd2FsayBzbG93bHksIGJ1dCBJIG5ldmVyIHdhbGsgYmFja3dhcmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBYnJhaGFtIExpbmNvbG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZm9vbGlzaCBtYW4gc2Vla3MgaGFwcGluZXNzIGluIHRoZSBkaXN0YW5jZTsgdGhlIHdpc2UgZ3Jvd3MgaXQgdW5kZXIgaGlzIGZlZXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKYW1lcyBPcGVuaGVpbSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgZ2F2ZSBteSBsaWZlIHRvIGJlY29tZSB0aGUgcGVyc29uIEkgYW0gcmlnaHQgbm93LiBXYXMgaXQgd29ydGggaXQ/IiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyB3aXRoIHdvcmRzIGFzIHdpdGggc3VuYmVhbXMuIFRoZSBtb3JlIHRoZXkgYXJlIGNvbmRlbnNlZCwgdGhlIGRlZXBlciB0aGV5IGJ1cm4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgU291dGhleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJhZCB0aW1lcyBoYXZlIGEgc2NpZW50aWZpYyB2YWx1ZS4gVGhlc2UgYXJlIG9jY2FzaW9ucyBhIGdvb2QgbGVhcm5lciB3b3VsZCBub3QgbWlzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZSB0aGUgY2hpZWYgYnV0IG5ldmVyIHRoZSBsb3JkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIG1heSBlbmNvdW50ZXIgbWFueSBkZWZlYXRzIGJ1dCB3ZSBtdXN0IG5vdCBiZSBkZWZlYXRlZC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1heWEgQW5nZWxvdSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvZGF5IGlzIHRoZSB0b21vcnJvdyB5b3Ugd29ycmllZCBhYm91dCB5ZXN0ZXJkYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciBwdXQgb2ZmIHRpbGwgdG9tb3Jyb3cgd2hhdCB5b3UgY2FuIGRvIHRvZGF5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIEplZmZlcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFsbCB0aGUgZ3JlYXQgcGVyZm9ybWVycyBJIGhhdmUgd29ya2VkIHdpdGggYXJlIGZ1ZWxsZWQgYnkgYSBwZXJzb25hbCBkcmVhbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gRWxpb3QiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBtdXN0IG92ZXJjb21lIHRoZSBub3Rpb24gdGhhdCB3ZSBtdXN0IGJlIHJlZ3VsYXIuIEl0IHJvYnMgeW91IG9mIHRoZSBjaGFuY2UgdG8gYmUgZXh0cmFvcmRpbmFyeSBhbmQgbGVhZHMgeW91IHRvIHRoZSBtZWRpb2NyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlV0YSBIYWdlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoaXMgd29ybGQsIGFmdGVyIGFsbCBvdXIgc2NpZW5jZSBhbmQgc2NpZW5jZXMsIGlzIHN0aWxsIGEgbWlyYWNsZTsgd29uZGVyZnVsLCBpbnNjcnV0YWJsZSwgbWFnaWNhbCBhbmQgbW9yZSwgdG8gd2hvc29ldmVyIHdpbGwgdGhpbmsgb2YgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaG9tYXMgQ2FybHlsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1ha2UgdGhlIG1vc3Qgb2YgeW91cnNlbGYgZm9yIHRoYXQgaXMgYWxsIHRoZXJlIGlzIG9mIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBvbmx5IG9uZSBzdWNjZXNzIC0gdG8gYmUgYWJsZSB0byBzcGVuZCB5b3VyIGxpZmUgaW4geW91ciBvd24gd2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hyaXN0b3BoZXIgTW9ybGV5IgogIH0sCiAgewogICAgInRleHQiOiAiV2UgcmVhZCB0aGUgd29ybGQgd3JvbmcgYW5kIHNheSB0aGF0IGl0IGRlY2VpdmVzIHVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFiaW5kcmFuYXRoIFRhZ29yZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRob3NlIHdobyBhcmUgZnJlZSBvZiByZXNlbnRmdWwgdGhvdWdodHMgc3VyZWx5IGZpbmQgcGVhY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGF0IHdlIHRoaW5rLCB3ZSBiZWNvbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIHN0cmVuZ3RoZW5zIGF1dGhvcml0eSBzbyBtdWNoIGFzIHNpbGVuY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMZW9uYXJkbyBkYSBWaW5jaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhvdyB3ZSBzcGVuZCBvdXIgZGF5cyBpcywgb2YgY291cnNlLCBob3cgd2Ugc3BlbmQgb3VyIGxpdmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW5uaWUgRGlsbGFyZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIHRocmVlIHdvcmRzIEkgY2FuIHN1bSB1cCBldmVyeXRoaW5nIEl2ZSBsZWFybmVkIGFib3V0IGxpZmU6IGl0IGdvZXMgb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgRnJvc3QiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaG8gd2UgYXJlIG5ldmVyIGNoYW5nZXMuIFdobyB3ZSB0aGluayB3ZSBhcmUgZG9lcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcnkgQWxtYW5hYyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vIG1hdHRlciBob3cgY2FyZWZ1bGx5IHlvdSBwbGFuIHlvdXIgZ29hbHMgdGhleSB3aWxsIG5ldmVyIGJlIG1vcmUgdGhhdCBwaXBlIGRyZWFtcyB1bmxlc3MgeW91IHB1cnN1ZSB0aGVtIHdpdGggZ3VzdG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXLiBDbGVtZW50IFN0b25lIgogIH0sCiAgewogICAgInRleHQiOiAiQXBwcmVjaWF0aW9uIGlzIHRoZSBoaWdoZXN0IGZvcm0gb2YgcHJheWVyLCBmb3IgaXQgYWNrbm93bGVkZ2VzIHRoZSBwcmVzZW5jZSBvZiBnb29kIHdoZXJldmVyIHlvdSBzaGluZSB0aGUgbGlnaHQgb2YgeW91ciB0aGFua2Z1bCB0aG91Z2h0cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYW4gQ29oZW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSBjb21lIHRvIHRoZSBlbmQgb2YgeW91ciByb3BlLCB0aWUgYSBrbm90IGFuZCBoYW5nIG9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbmtsaW4gUm9vc2V2ZWx0IgogIH0sCiAgewogICAgInRleHQiOiAiWW91ciBhdHRpdHVkZSwgbm90IHlvdXIgYXB0aXR1ZGUsIHdpbGwgZGV0ZXJtaW5lIHlvdXIgYWx0aXR1ZGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJaaWcgWmlnbGFyIgogIH0sCiAgewogICAgInRleHQiOiAiSSBoYXZlIG5vIHNwZWNpYWwgdGFsZW50LiBJIGFtIG9ubHkgcGFzc2lvbmF0ZWx5IGN1cmlvdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJTb21ldGltZXMgb3VyIGZhdGUgcmVzZW1ibGVzIGEgZnJ1aXQgdHJlZSBpbiB3aW50ZXIuIFdobyB3b3VsZCB0aGluayB0aGF0IHRob3NlIGJyYW5jaGVzIHdvdWxkIHR1cm4gZ3JlZW4gYWdhaW4gYW5kIGJsb3Nzb20sIGJ1dCB3ZSBob3BlIGl0LCB3ZSBrbm93IGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9oYW5uIFdvbGZnYW5nIHZvbiBHb2V0aGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaG8gbG9va3Mgb3V0c2lkZSwgZHJlYW1zOyB3aG8gbG9va3MgaW5zaWRlLCBhd2FrZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXJsIEp1bmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaHJvdWdoIHBlcnNldmVyYW5jZSBtYW55IHBlb3BsZSB3aW4gc3VjY2VzcyBvdXQgb2Ygd2hhdCBzZWVtZWQgZGVzdGluZWQgdG8gYmUgY2VydGFpbiBmYWlsdXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVuamFtaW4gRGlzcmFlbGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDaGFuZ2UgaXMgdGhlIGxhdyBvZiBsaWZlLiBBbmQgdGhvc2Ugd2hvIGxvb2sgb25seSB0byB0aGUgcGFzdCBvciBwcmVzZW50IGFyZSBjZXJ0YWluIHRvIG1pc3MgdGhlIGZ1dHVyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gS2VubmVkeSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIGJldHRlciB0byB1bmRlcnN0YW5kIGEgbGl0dGxlIHRoYW4gdG8gbWlzdW5kZXJzdGFuZCBhIGxvdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuYXRvbGUgRnJhbmNlIgogIH0sCiAgewogICAgInRleHQiOiAiUGFzc2lvbiBjcmVhdGVzIHRoZSBkZXNpcmUgZm9yIG1vcmUgYW5kIGFjdGlvbiBmdWVsbGVkIGJ5IHBhc3Npb24gY3JlYXRlcyBhIGZ1dHVyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIGFjdGlvbiByZXN1bHRzIGZyb20gdGhvdWdodCwgc28gaXQgaXMgdGhvdWdodHMgdGhhdCBtYXR0ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTYWkgQmFiYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFkdmVyc2l0eSBjYXVzZXMgc29tZSBtZW4gdG8gYnJlYWssIG90aGVycyB0byBicmVhayByZWNvcmRzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBXYXJkIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHdpc2UgbWFuIGRvZXMgbm90IGxheSB1cCBoaXMgb3duIHRyZWFzdXJlcy4gVGhlIG1vcmUgaGUgZ2l2ZXMgdG8gb3RoZXJzLCB0aGUgbW9yZSBoZSBoYXMgZm9yIGhpcyBvd24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiV2lzZG9tIGlzIGtub3dpbmcgd2hhdCB0byBkbyBuZXh0OyBTa2lsbCBpcyBrbm93aW5nIGhvdyBvdCBkbyBpdCwgYW5kIFZpcnR1ZSBpcyBkb2luZyBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhdmlkIEpvcmRhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNhbiBtaWxlcyB0cnVseSBzZXBhcmF0ZSB5b3UgZnJvbSBmcmllbmRzLi4uIElmIHlvdSB3YW50IHRvIGJlIHdpdGggc29tZW9uZSB5b3UgbG92ZSwgYXJlbid0IHlvdSBhbHJlYWR5IHRoZXJlPyIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiV2l0aCByZWFsaXphdGlvbiBvZiBvbmVzIG93biBwb3RlbnRpYWwgYW5kIHNlbGYtY29uZmlkZW5jZSBpbiBvbmVzIGFiaWxpdHksIG9uZSBjYW4gYnVpbGQgYSBiZXR0ZXIgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiQXMgd2UgcmlzayBvdXJzZWx2ZXMsIHdlIGdyb3cuIEVhY2ggbmV3IGV4cGVyaWVuY2UgaXMgYSByaXNrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbiBXYXRzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbnR1aXRpb24gaXMgdGhlIHZlcnkgZm9yY2Ugb3IgYWN0aXZpdHkgb2YgdGhlIHNvdWwgaW4gaXRzIGV4cGVyaWVuY2UgdGhyb3VnaCB3aGF0ZXZlciBoYXMgYmVlbiB0aGUgZXhwZXJpZW5jZSBvZiB0aGUgc291bCBpdHNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yeSBSZWVkIgogIH0sCiAgewogICAgInRleHQiOiAiRnJvbSBlcnJvciB0byBlcnJvciBvbmUgZGlzY292ZXJzIHRoZSBlbnRpcmUgdHJ1dGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTaWdtdW5kIEZyZXVkIgogIH0sCiAgewogICAgInRleHQiOiAiRm9ydHVuZSBiZWZyaWVuZHMgdGhlIGJvbGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIERyeWRlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgaGF2ZSBvZnRlbiByZWdyZXR0ZWQgbXkgc3BlZWNoLCBuZXZlciBteSBzaWxlbmNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUHVibGlsaXVzIFN5cnVzIgogIH0sCiAgewogICAgInRleHQiOiAiQSBsaWZlIHNwZW50IG1ha2luZyBtaXN0YWtlcyBpcyBub3Qgb25seSBtb3JlIGhvbm91cmFibGUgYnV0IG1vcmUgdXNlZnVsIHRoYW4gYSBsaWZlIHNwZW50IGluIGRvaW5nIG5vdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZXJuYXJkIFNoYXciCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGFtIGEgbWFuIG9mIGZpeGVkIGFuZCB1bmJlbmRpbmcgcHJpbmNpcGxlcywgdGhlIGZpcnN0IG9mIHdoaWNoIGlzIHRvIGJlIGZsZXhpYmxlIGF0IGFsbCB0aW1lcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkV2ZXJldHQgRGlya3NlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNhbiB5b3UgaW1hZ2luZSB3aGF0IEkgd291bGQgZG8gaWYgSSBjb3VsZCBkbyBhbGwgSSBjYW4/IiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTdW4gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiRnJlZWRvbSBpcyBub3Qgd29ydGggaGF2aW5nIGlmIGl0IGRvZXMgbm90IGNvbm5vdGUgZnJlZWRvbSB0byBlcnIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNb2hhbmRhcyBHYW5kaGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbGxvdyB0aGUgd29ybGQgdG8gbGl2ZSBhcyBpdCBjaG9vc2VzLCBhbmQgYWxsb3cgeW91cnNlbGYgdG8gbGl2ZSBhcyB5b3UgY2hvb3NlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiSG93ZXZlciBtYW55IGhvbHkgd29yZHMgeW91IHJlYWQsIEhvd2V2ZXIgbWFueSB5b3Ugc3BlYWssIFdoYXQgZ29vZCB3aWxsIHRoZXkgZG8geW91IElmIHlvdSBkbyBub3QgYWN0IG9uIHVwb24gdGhlbT8iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgY2Fubm90IHNheSB3aGV0aGVyIHRoaW5ncyB3aWxsIGdldCBiZXR0ZXIgaWYgd2UgY2hhbmdlOyB3aGF0IEkgY2FuIHNheSBpcyB0aGV5IG11c3QgY2hhbmdlIGlmIHRoZXkgYXJlIHRvIGdldCBiZXR0ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZyBMaWNodGVuYmVyZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBjYW4gZHJlYW0gaXQsIHlvdSBjYW4gZG8gaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYWx0IERpc25leSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgZGVzdHJveSBteSBlbmVtaWVzIHdoZW4gSSBtYWtlIHRoZW0gbXkgZnJpZW5kcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFicmFoYW0gTGluY29sbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSB0aGluayB5b3UgY2FuLCB5b3UgY2FuLiBBbmQgaWYgeW91IHRoaW5rIHlvdSBjYW4ndCwgeW91J3JlIHJpZ2h0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgRm9yZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4geW91IGJlZ2luIHRvIHRvdWNoIHlvdXIgaGVhcnQgb3IgbGV0IHlvdXIgaGVhcnQgYmUgdG91Y2hlZCwgeW91IGJlZ2luIHRvIGRpc2NvdmVyIHRoYXQgaXQncyBib3R0b21sZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVtYSBDaG9kcm9uIgogIH0sCiAgewogICAgInRleHQiOiAiQmxlc3NlZCBpcyB0aGUgbWFuIHdobyBleHBlY3RzIG5vdGhpbmcsIGZvciBoZSBzaGFsbCBuZXZlciBiZSBkaXNhcHBvaW50ZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGV4YW5kZXIgUG9wZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IHRha2VzIGJvdGggc3Vuc2hpbmUgYW5kIHJhaW4gdG8gbWFrZSBhIHJhaW5ib3cuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWdodCB0b21vcnJvdyB3aXRoIHRvZGF5ISIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxpemFiZXRoIEJyb3duaW5nIgogIH0sCiAgewogICAgInRleHQiOiAiQnkgYmVsaWV2aW5nIHBhc3Npb25hdGVseSBpbiBzb21ldGhpbmcgdGhhdCBkb2VzIG5vdCB5ZXQgZXhpc3QsIHdlIGNyZWF0ZSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIk5pa29zIEthemFudHpha2lzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgYXJlIHRoaW5ncyBzbyBkZWVwIGFuZCBjb21wbGV4IHRoYXQgb25seSBpbnR1aXRpb24gY2FuIHJlYWNoIGl0IGluIG91ciBzdGFnZSBvZiBkZXZlbG9wbWVudCBhcyBodW1hbiBiZWluZ3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIEFzdGluIgogIH0sCiAgewogICAgInRleHQiOiAiT3VyIGxpdmVzIGFyZSBhIHN1bSB0b3RhbCBvZiB0aGUgY2hvaWNlcyB3ZSBoYXZlIG1hZGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYXluZSBEeWVyIgogIH0sCiAgewogICAgInRleHQiOiAiTm8gcGVzc2ltaXN0IGV2ZXIgZGlzY292ZXJlZCB0aGUgc2VjcmV0cyBvZiB0aGUgc3RhcnMsIG9yIHNhaWxlZCB0byBhbiB1bmNoYXJ0ZWQgbGFuZCwgb3Igb3BlbmVkIGEgbmV3IGhlYXZlbiB0byB0aGUgaHVtYW4gc3Bpcml0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVsZW4gS2VsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiU21pbGUsIGJyZWF0aGUgYW5kIGdvIHNsb3dseS4iLAogICAgImF0dHJpYnV0aW9uIjogIlRoaWNoIE5oYXQgSGFuaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGNhbiBvbmx5IGJlIHNhaWQgdG8gYmUgYWxpdmUgaW4gdGhvc2UgbW9tZW50cyB3aGVuIG91ciBoZWFydHMgYXJlIGNvbnNjaW91cyBvZiBvdXIgdHJlYXN1cmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvcm50b24gV2lsZGVyIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCBkbyB3ZSBsaXZlIGZvciwgaWYgaXQgaXMgbm90IHRvIG1ha2UgbGlmZSBsZXNzIGRpZmZpY3VsdCBmb3IgZWFjaCBvdGhlcj8iLAogICAgImF0dHJpYnV0aW9uIjogIkdlb3JnZSBFbGlvdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNyZWF0aXZpdHkgY29tZXMgZnJvbSB0cnVzdC4gVHJ1c3QgeW91ciBpbnN0aW5jdHMuIEFuZCBuZXZlciBob3BlIG1vcmUgdGhhbiB5b3Ugd29yay4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpdGEgTWFlIEJyb3duIgogIH0sCiAgewogICAgInRleHQiOiAiRmVhciBncm93cyBpbiBkYXJrbmVzczsgaWYgeW91IHRoaW5rIHRoZXJlcyBhIGJvZ2V5bWFuIGFyb3VuZCwgdHVybiBvbiB0aGUgbGlnaHQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb3JvdGh5IFRob21wc29uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyZWF0ZXN0IGFudGlkb3RlIHRvIGluc2VjdXJpdHkgYW5kIHRoZSBzZW5zZSBvZiBmZWFyIGlzIGNvbXBhc3Npb24gLSBpdCBicmluZ3Mgb25lIGJhY2sgdG8gdGhlIGJhc2lzIG9mIG9uZSdzIGlubmVyIHN0cmVuZ3RoIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiVmlzaW9uIHdpdGhvdXQgYWN0aW9uIGlzIGEgZGF5ZHJlYW0uIEFjdGlvbiB3aXRob3V0IHZpc2lvbiBpcyBhIG5pZ2h0bWFyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkphcGFuZXNlIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3VyIGJvZHkgaXMgcHJlY2lvdXMuIEl0IGlzIG91ciB2ZWhpY2xlIGZvciBhd2FrZW5pbmcuIFRyZWF0IGl0IHdpdGggY2FyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGJlIGZ1bGx5IGFsaXZlLCBmdWxseSBodW1hbiwgYW5kIGNvbXBsZXRlbHkgYXdha2UgaXMgdG8gYmUgY29udGludWFsbHkgdGhyb3duIG91dCBvZiB0aGUgbmVzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlBlbWEgQ2hvZHJvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRob3NlIHdobyBkcmVhbSBieSBkYXkgYXJlIGNvZ25pemFudCBvZiBtYW55IHRoaW5ncyB3aGljaCBlc2NhcGUgdGhvc2Ugd2hvIGRyZWFtIG9ubHkgYnkgbmlnaHQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFZGdhciBBbGxhbiBQb2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJQYXRpZW5jZSBhbmQgcGVyc2V2ZXJhbmNlIGhhdmUgYSBtYWdpY2FsIGVmZmVjdCBiZWZvcmUgd2hpY2ggZGlmZmljdWx0aWVzIGRpc2FwcGVhciBhbmQgb2JzdGFjbGVzIHZhbmlzaC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gQWRhbXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbHdheXMgYmUgeW91cnNlbGYsIGV4cHJlc3MgeW91cnNlbGYsIGhhdmUgZmFpdGggaW4geW91cnNlbGYsIGRvIG5vdCBnbyBvdXQgYW5kIGxvb2sgZm9yIGEgc3VjY2Vzc2Z1bCBwZXJzb25hbGl0eSBhbmQgZHVwbGljYXRlIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJ1Y2UgTGVlIgogIH0sCiAgewogICAgInRleHQiOiAiQSBtYW4gc2VlcyBpbiB0aGUgd29ybGQgd2hhdCBoZSBjYXJyaWVzIGluIGhpcyBoZWFydC4iLAogICAgImF0dHJpYnV0aW9uIjogIkdvZXRoZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV4cGVyaWVuY2UgY2FuIG9ubHkgYmUgZ2FpbmVkIGJ5IGRvaW5nIG5vdCBieSB0aGlua2luZyBvciBkcmVhbWluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG1lYW5pbmcgSSBwaWNrZWQsIHRoZSBvbmUgdGhhdCBjaGFuZ2VkIG15IGxpZmU6IE92ZXJjb21lIGZlYXIsIGJlaG9sZCB3b25kZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJPbmUgdGhhdCBkZXNpcmVzIHRvIGV4Y2VsIHNob3VsZCBlbmRlYXZvdXIgaW4gdGhvc2UgdGhpbmdzIHRoYXQgYXJlIGluIHRoZW1zZWx2ZXMgbW9zdCBleGNlbGxlbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeSBtYW4gZGllcy4gTm90IGV2ZXJ5IG1hbiByZWFsbHkgbGl2ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJObyBhbGliaSB3aWxsIHNhdmUgeW91IGZyb20gYWNjZXB0aW5nIHRoZSByZXNwb25zaWJpbGl0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEhpbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3Ugc3VycmVuZGVyIHRvIHRoZSB3aW5kLCB5b3UgY2FuIHJpZGUgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUb25pIE1vcnJpc29uIgogIH0sCiAgewogICAgInRleHQiOiAiWW91ciB3b3JrIGlzIHRvIGRpc2NvdmVyIHlvdXIgd29ybGQgYW5kIHRoZW4gd2l0aCBhbGwgeW91ciBoZWFydCBnaXZlIHlvdXJzZWxmIHRvIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGRvbid0IGRyb3duIGJ5IGZhbGxpbmcgaW4gd2F0ZXIuIFlvdSBkcm93biBieSBzdGF5aW5nIHRoZXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiB5b3UgbWVldCBzb21lb25lIGJldHRlciB0aGFuIHlvdXJzZWxmLCB0dXJuIHlvdXIgdGhvdWdodHMgdG8gYmVjb21pbmcgaGlzIGVxdWFsLiBXaGVuIHlvdSBtZWV0IHNvbWVvbmUgbm90IGFzIGdvb2QgYXMgeW91IGFyZSwgbG9vayB3aXRoaW4gYW5kIGV4YW1pbmUgeW91ciBvd24gc2VsZi4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtb3JlIHlvdSBjYXJlLCB0aGUgc3Ryb25nZXIgeW91IGNhbiBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkppbSBSb2huIgogIH0sCiAgewogICAgInRleHQiOiAiSSBuZXZlciBzZWUgd2hhdCBoYXMgYmVlbiBkb25lOyBJIG9ubHkgc2VlIHdoYXQgcmVtYWlucyB0byBiZSBkb25lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyaWUgQ3VyaWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUb2RheSwgZ2l2ZSBhIHN0cmFuZ2VyIGEgc21pbGUgd2l0aG91dCB3YWl0aW5nIGZvciBpdCBtYXkgYmUgdGhlIGpveSB0aGV5IG5lZWQgdG8gaGF2ZSBhIGdyZWF0IGRheS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgb25seSBwb3NzaWJsZSB0byBsaXZlIGhhcHBpbHkgZXZlciBhZnRlciBvbiBhIGRheSB0byBkYXkgYmFzaXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJnYXJldCBCb25uYW5vIgogIH0sCiAgewogICAgInRleHQiOiAiV2Uga25vdyB0aGUgdHJ1dGgsIG5vdCBvbmx5IGJ5IHRoZSByZWFzb24sIGJ1dCBieSB0aGUgaGVhcnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCbGFpc2UgUGFzY2FsIgogIH0sCiAgewogICAgInRleHQiOiAiTmV2ZXIgZGVueSBhIGRpYWdub3NpcywgYnV0IGRvIGRlbnkgdGhlIG5lZ2F0aXZlIHZlcmRpY3QgdGhhdCBtYXkgZ28gd2l0aCBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIk5vcm1hbiBDb3VzaW5zIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyZWF0ZXN0IG1pc3Rha2UgeW91IGNhbiBtYWtlIGluIGxpZmUgaXMgdG8gYmUgY29udGludWFsbHkgZmVhcmluZyB5b3Ugd2lsbCBtYWtlIG9uZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVsYmVydCBIdWJiYXJkIgogIH0sCiAgewogICAgInRleHQiOiAiQmVpbmcgcmlnaHQgaXMgaGlnaGx5IG92ZXJyYXRlZC4gRXZlbiBhIHN0b3BwZWQgY2xvY2sgaXMgcmlnaHQgdHdpY2UgYSBkYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RpY2UgdGhhdCB0aGUgc3RpZmZlc3QgdHJlZSBpcyBtb3N0IGVhc2lseSBjcmFja2VkLCB3aGlsZSB0aGUgYmFtYm9vIG9yIHdpbGxvdyBzdXJ2aXZlcyBieSBiZW5kaW5nIHdpdGggdGhlIHdpbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCcnVjZSBMZWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIYXBwaW5lc3MgaXMgYXMgYSBidXR0ZXJmbHkgd2hpY2gsIHdoZW4gcHVyc3VlZCwgaXMgYWx3YXlzIGJleW9uZCBvdXIgZ3Jhc3AsIGJ1dCB3aGljaCBpZiB5b3Ugd2lsbCBzaXQgZG93biBxdWlldGx5LCBtYXkgYWxpZ2h0IHVwb24geW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmF0aGFuaWVsIEhhd3Rob3JuZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxpZmUgaXMgYSBzdWNjZXNzaW9uIG9mIG1vbWVudHMuIFRvIGxpdmUgZWFjaCBvbmUgaXMgdG8gc3VjY2VlZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvcml0YSBLZW50IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGV4ZXJjaXNlIG9mIGFuIGV4dHJhb3JkaW5hcnkgZ2lmdCBpcyB0aGUgc3VwcmVtZXN0IHBsZWFzdXJlIGluIGxpZmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJrIFR3YWluIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGJlc3QgcGxhY2UgdG8gZmluZCBhIGhlbHBpbmcgaGFuZCBpcyBhdCB0aGUgZW5kIG9mIHlvdXIgb3duIGFybS4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZvcmdpdmVuZXNzIGRvZXMgbm90IGNoYW5nZSB0aGUgcGFzdCwgYnV0IGl0IGRvZXMgZW5sYXJnZSB0aGUgZnV0dXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGF1bCBCb2VzZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlbGwgZG9uZSBpcyBiZXR0ZXIgdGhhbiB3ZWxsIHNhaWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBGcmFua2xpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIktlZXAgdHJ1ZSB0byB0aGUgZHJlYW1zIG9mIHRoeSB5b3V0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIkZyaWVkcmljaCB2b24gU2NoaWxsZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgb25seSB3YXkgdG8gdGVsbCB0aGUgdHJ1dGggaXMgdG8gc3BlYWsgd2l0aCBraW5kbmVzcy4gT25seSB0aGUgd29yZHMgb2YgYSBsb3ZpbmcgbWFuIGNhbiBiZSBoZWFyZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbnJ5IFRob3JlYXUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIYXBwaW5lc3MgaXMgbm90IHNvbWV0aGluZyByZWFkeSBtYWRlLiBJdCBjb21lcyBmcm9tIHlvdXIgb3duIGFjdGlvbnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGxvbmdlciB3ZSBkd2VsbCBvbiBvdXIgbWlzZm9ydHVuZXMsIHRoZSBncmVhdGVyIGlzIHRoZWlyIHBvd2VyIHRvIGhhcm0gdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJWb2x0YWlyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIEEgaXMgc3VjY2VzcyBpbiBsaWZlLCB0aGVuIEEgZXF1YWxzIHggcGx1cyB5IHBsdXMgei4gV29yayBpcyB4OyB5IGlzIHBsYXk7IGFuZCB6IGlzIGtlZXBpbmcgeW91ciBtb3V0aCBzaHV0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IEVpbnN0ZWluIgogIH0sCiAgewogICAgInRleHQiOiAiUGVhY2UgY2Fubm90IGJlIGtlcHQgYnkgZm9yY2UuIEl0IGNhbiBvbmx5IGJlIGFjaGlldmVkIGJ5IHVuZGVyc3RhbmRpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBsZXQgdG9kYXkncyBkaXNhcHBvaW50bWVudHMgY2FzdCBhIHNoYWRvdyBvbiB0b21vcnJvdydzIGRyZWFtcy4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBncmVhdGVzdCBkYW5nZXIgZm9yIG1vc3Qgb2YgdXMgaXMgbm90IHRoYXQgb3VyIGFpbSBpcyB0b28gaGlnaCBhbmQgd2UgbWlzcyBpdCwgYnV0IHRoYXQgaXQgaXMgdG9vIGxvdyBhbmQgd2UgcmVhY2ggaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNaWNoZWxhbmdlbG8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3Ugd2lsbCBuZXZlciBiZSBoYXBweSBpZiB5b3UgY29udGludWUgdG8gc2VhcmNoIGZvciB3aGF0IGhhcHBpbmVzcyBjb25zaXN0cyBvZi4gWW91IHdpbGwgbmV2ZXIgbGl2ZSBpZiB5b3UgYXJlIGxvb2tpbmcgZm9yIHRoZSBtZWFuaW5nIG9mIGxpZmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgQ2FtdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgd2lubmVyIGFpbid0IHRoZSBvbmUgd2l0aCB0aGUgZmFzdGVzdCBjYXIgaXQncyB0aGUgb25lIHdobyByZWZ1c2VzIHRvIGxvc2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxlIEVhcm5oYXJkdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIHN1Y2NlZWQsIHdlIG11c3QgZmlyc3QgYmVsaWV2ZSB0aGF0IHdlIGNhbi4iLAogICAgImF0dHJpYnV0aW9uIjogIk1pY2hhZWwgS29yZGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIHdpbGwgbm90IGJlIGNvbmNlcm5lZCBhdCBvdGhlciBtZW4gaXMgbm90IGtub3dpbmcgbWU7SSB3aWxsIGJlIGNvbmNlcm5lZCBhdCBteSBvd24gd2FudCBvZiBhYmlsaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiQWNjZXB0IHRoZSB0aGluZ3MgdG8gd2hpY2ggZmF0ZSBiaW5kcyB5b3UsIGFuZCBsb3ZlIHRoZSBwZW9wbGUgd2l0aCB3aG9tIGZhdGUgYnJpbmdzIHlvdSB0b2dldGhlciwgYnV0IGRvIHNvIHdpdGggYWxsIHlvdXIgaGVhcnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJjdXMgQXVyZWxpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbiBpbnZhc2lvbiBvZiBhcm1pZXMgY2FuIGJlIHJlc2lzdGVkLCBidXQgbm90IGFuIGlkZWEgd2hvc2UgdGltZSBoYXMgY29tZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlZpY3RvciBIdWdvIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHBlcnNvbmFsIGxpZmUgZGVlcGx5IGxpdmVkIGFsd2F5cyBleHBhbmRzIGludG8gdHJ1dGhzIGJleW9uZCBpdHNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbmFpcyBOaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCdzIG5vdCB3aGF0IGhhcHBlbnMgdG8geW91LCBidXQgaG93IHlvdSByZWFjdCB0byBpdCB0aGF0IG1hdHRlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJPYnN0YWNsZXMgYXJlIHRob3NlIHRoaW5ncyB5b3Ugc2VlIHdoZW4geW91IHRha2UgeW91ciBleWVzIG9mZiB0aGUgZ29hbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhhbm5haCBNb3JlIgogIH0sCiAgewogICAgInRleHQiOiAiRGlmZmljdWx0aWVzIGFyZSB0aGluZ3MgdGhhdCBzaG93IGEgcGVyc29uIHdoYXQgdGhleSBhcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGlsZSB3ZSB0cnkgdG8gdGVhY2ggb3VyIGNoaWxkcmVuIGFsbCBhYm91dCBsaWZlLCBvdXIgY2hpbGRyZW4gdGVhY2ggdXMgd2hhdCBsaWZlIGlzIGFsbCBhYm91dC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuZ2VsYSBTY2h3aW5kdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdXIgc2FjcmVkIHNwYWNlIGlzIHdoZXJlIHlvdSBjYW4gZmluZCB5b3Vyc2VsZiBhZ2FpbiBhbmQgYWdhaW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb3NlcGggQ2FtcGJlbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNYWtlIHRoZSBtb3N0IG9mIHlvdXJzZWxmLCBmb3IgdGhhdCBpcyBhbGwgdGhlcmUgaXMgb2YgeW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxvdHMgb2YgcGVvcGxlIHdhbnQgdG8gcmlkZSB3aXRoIHlvdSBpbiB0aGUgbGltbywgYnV0IHdoYXQgeW91IHdhbnQgaXMgc29tZW9uZSB3aG8gd2lsbCB0YWtlIHRoZSBidXMgd2l0aCB5b3Ugd2hlbiB0aGUgbGltbyBicmVha3MgZG93bi4iLAogICAgImF0dHJpYnV0aW9uIjogIk9wcmFoIFdpbmZyZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMb3ZlIGlzIHRoZSBtYXN0ZXIga2V5IHRoYXQgb3BlbnMgdGhlIGdhdGVzIG9mIGhhcHBpbmVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk9saXZlciBIb2xtZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGluayBmb3IgeW91cnNlbHZlcyBhbmQgbGV0IG90aGVycyBlbmpveSB0aGUgcHJpdmlsZWdlIHRvIGRvIHNvIHRvby4iLAogICAgImF0dHJpYnV0aW9uIjogIlZvbHRhaXJlIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgb25lIGFkdmFuY2VzIGNvbmZpZGVudGx5IGluIHRoZSBkaXJlY3Rpb24gb2YgaGlzIGRyZWFtLCBhbmQgZW5kZWF2b3VycyB0byBsaXZlIHRoZSBsaWZlIHdoaWNoIGhlIGhhZCBpbWFnaW5lcywgaGUgd2lsbCBtZWV0IHdpdGggYSBzdWNjZXNzIHVuZXhwZWN0ZWQgaW4gY29tbW9uIGhvdXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgRGF2aWQgVGhvcmVhdSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzZWNyZXQgb2Ygam95IGluIHdvcmsgaXMgY29udGFpbmVkIGluIG9uZSB3b3JkIC0gZXhjZWxsZW5jZS4gVG8ga25vdyBob3cgdG8gZG8gc29tZXRoaW5nIHdlbGwgaXMgdG8gZW5qb3kgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQZWFybCBCdWNrIgogIH0sCiAgewogICAgInRleHQiOiAiVGhpcyBpcyB0aGUgZmluYWwgdGVzdCBvZiBhIGdlbnRsZW1hbjogaGlzIHJlc3BlY3QgZm9yIHRob3NlIHdobyBjYW4gYmUgb2Ygbm8gcG9zc2libGUgdmFsdWUgdG8gaGltLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBMeW9uIFBoZWxwcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHRoZSBzaG9lIGRvZXNuJ3QgZml0LCBtdXN0IHdlIGNoYW5nZSB0aGUgZm9vdD8iLAogICAgImF0dHJpYnV0aW9uIjogIkdsb3JpYSBTdGVpbmVtIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGxpZ2h0IGEgbGFtcCBmb3Igc29tZWJvZHksIGl0IHdpbGwgYWxzbyBicmlnaHRlbiB5b3VyIHBhdGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGZpbmUgcXVvdGF0aW9uIGlzIGEgZGlhbW9uZCBvbiB0aGUgZmluZ2VyIG9mIGEgbWFuIG9mIHdpdCwgYW5kIGEgcGViYmxlIGluIHRoZSBoYW5kIG9mIGEgZm9vbC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvc2VwaCBSb3V4IgogIH0sCiAgewogICAgInRleHQiOiAiTWFuIGNhbm5vdCBkaXNjb3ZlciBuZXcgb2NlYW5zIHVubGVzcyBoZSBoYXMgdGhlIGNvdXJhZ2UgdG8gbG9zZSBzaWdodCBvZiB0aGUgc2hvcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbmRyZSBHaWRlIgogIH0sCiAgewogICAgInRleHQiOiAiSW4gcml2ZXJzLCB0aGUgd2F0ZXIgdGhhdCB5b3UgdG91Y2ggaXMgdGhlIGxhc3Qgb2Ygd2hhdCBoYXMgcGFzc2VkIGFuZCB0aGUgZmlyc3Qgb2YgdGhhdCB3aGljaCBjb21lczsgc28gd2l0aCBwcmVzZW50IHRpbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMZW9uYXJkbyBkYSBWaW5jaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgZ29vZCBkZWNpc2lvbiBpcyBiYXNlZCBvbiBrbm93bGVkZ2UgYW5kIG5vdCBvbiBudW1iZXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGxhdG8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbyBub3Qgd2FzdGUgeW91cnNlbGYgaW4gcmVqZWN0aW9uLCBub3IgYmFyayBhZ2FpbnN0IHRoZSBiYWQsIGJ1dCBjaGFudCB0aGUgYmVhdXR5IG9mIHRoZSBnb29kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk51bWJlcmxlc3MgYXJlIHRoZSB3b3JsZHMgd29uZGVycywgYnV0IG5vbmUgbW9yZSB3b25kZXJmdWwgdGhhbiBtYW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTb3Bob2NsZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBrZWVwIHRoZSBib2R5IGluIGdvb2QgaGVhbHRoIGlzIGEgZHV0eS4uLiBvdGhlcndpc2Ugd2Ugc2hhbGwgbm90IGJlIGFibGUgdG8ga2VlcCBvdXIgbWluZCBzdHJvbmcgYW5kIGNsZWFyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiTGVhcm5pbmcgd2l0aG91dCByZWZsZWN0aW9uIGlzIGEgd2FzdGUsIHJlZmxlY3Rpb24gd2l0aG91dCBsZWFybmluZyBpcyBkYW5nZXJvdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBub3RoaW5nIGltcG9zc2libGUgdG8gaGltIHdobyB3aWxsIHRyeS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsZXhhbmRlciB0aGUgR3JlYXQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIb3cgbWFueSBjYXJlcyBvbmUgbG9zZXMgd2hlbiBvbmUgZGVjaWRlcyBub3QgdG8gYmUgc29tZXRoaW5nIGJ1dCB0byBiZSBzb21lb25lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29jbyBDaGFuZWwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHb2FscyBhcmUgdGhlIGZ1ZWwgaW4gdGhlIGZ1cm5hY2Ugb2YgYWNoaWV2ZW1lbnQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCcmlhbiBUcmFjeSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFsbCBzZWFzb25zIGFyZSBiZWF1dGlmdWwgZm9yIHRoZSBwZXJzb24gd2hvIGNhcnJpZXMgaGFwcGluZXNzIHdpdGhpbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkhvcmFjZSBGcmllc3MiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSBhcmUgY29udGVudCB0byBiZSBzaW1wbHkgeW91cnNlbGYgYW5kIGRvbid0IGNvbXBhcmUgb3IgY29tcGV0ZSwgZXZlcnlib2R5IHdpbGwgcmVzcGVjdCB5b3UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGNvbmRpdGlvbnMgb2YgY29ucXVlc3QgYXJlIGFsd2F5cyBlYXN5LiBXZSBoYXZlIGJ1dCB0byB0b2lsIGF3aGlsZSwgZW5kdXJlIGF3aGlsZSwgYmVsaWV2ZSBhbHdheXMsIGFuZCBuZXZlciB0dXJuIGJhY2suIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTZW5lY2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZZXN0ZXJkYXkgaXMgaGlzdG9yeS4gVG9tb3Jyb3cgaXMgYSBteXN0ZXJ5LiBBbmQgdG9kYXk/IFRvZGF5IGlzIGEgZ2lmdCB0aGF0J3Mgd2h5IHRoZXkgY2FsbCBpdCB0aGUgcHJlc2VudC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxvdmUgYXQgZmlyc3Qgc2lnaHQgaXMgZWFzeSB0byB1bmRlcnN0YW5kOyBpdHMgd2hlbiB0d28gcGVvcGxlIGhhdmUgYmVlbiBsb29raW5nIGF0IGVhY2ggb3RoZXIgZm9yIGEgbGlmZXRpbWUgdGhhdCBpdCBiZWNvbWVzIGEgbWlyYWNsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFteSBCbG9vbSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIHRoZSBtaWRkbGUgb2YgZXZlcnkgZGlmZmljdWx0eSBsaWVzIG9wcG9ydHVuaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IEVpbnN0ZWluIgogIH0sCiAgewogICAgInRleHQiOiAiQmUgc3VyZSB5b3UgcHV0IHlvdXIgZmVldCBpbiB0aGUgcmlnaHQgcGxhY2UsIHRoZW4gc3RhbmQgZmlybS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFicmFoYW0gTGluY29sbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB0aW1lIHlvdSB0aGluayB5b3UncmUgbWlzc2luZywgbWlzc2VzIHlvdSB0b28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJZbWJlciBEZWxlY3RvIgogIH0sCiAgewogICAgInRleHQiOiAiQW4gb3B0aW1pc3QgaXMgYSBwZXJzb24gd2hvIHNlZXMgYSBncmVlbiBsaWdodCBldmVyeXdoZXJlLCB3aGlsZSB0aGUgcGVzc2ltaXN0IHNlZXMgb25seSB0aGUgcmVkIHNwb3RsaWdodC4uLiBUaGUgdHJ1bHkgd2lzZSBwZXJzb24gaXMgY29sb3VyLWJsaW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IFNjaHdlaXR6ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGVuZGVhdm91ciB0byBiZSB3aXNlIHdoZW4gSSBjYW5ub3QgYmUgbWVycnksIGVhc3kgd2hlbiBJIGNhbm5vdCBiZSBnbGFkLCBjb250ZW50IHdpdGggd2hhdCBjYW5ub3QgYmUgbWVuZGVkIGFuZCBwYXRpZW50IHdoZW4gdGhlcmUgaXMgbm8gcmVkcmVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkVsaXphYmV0aCBNb250YWd1IgogIH0sCiAgewogICAgInRleHQiOiAiSWYgdGhlIHNpbmdsZSBtYW4gcGxhbnQgaGltc2VsZiBpbmRvbWl0YWJseSBvbiBoaXMgaW5zdGluY3RzLCBhbmQgdGhlcmUgYWJpZGUsIHRoZSBodWdlIHdvcmxkIHdpbGwgY29tZSByb3VuZCB0byBoaW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiQ2hhcmFjdGVyIGlzIGxpa2UgYSB0cmVlIGFuZCByZXB1dGF0aW9uIGxpa2UgYSBzaGFkb3cuIFRoZSBzaGFkb3cgaXMgd2hhdCB3ZSB0aGluayBvZiBpdDsgdGhlIHRyZWUgaXMgdGhlIHJlYWwgdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBYnJhaGFtIExpbmNvbG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBjYW5ub3QgaG9sZCBhIHRvcmNoIHRvIGxpZ2h0IGFub3RoZXIncyBwYXRoIHdpdGhvdXQgYnJpZ2h0ZW5pbmcgb3VyIG93bi4iLAogICAgImF0dHJpYnV0aW9uIjogIkJlbiBTd2VldGxhbmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGdvYWwgd2l0aG91dCBhIHBsYW4gaXMganVzdCBhIHdpc2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYXJyeSBFbGRlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBoYXJkZXIgeW91IGZhbGwsIHRoZSBoaWdoZXIgeW91IGJvdW5jZS4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vIHNub3dmbGFrZSBpbiBhbiBhdmFsYW5jaGUgZXZlciBmZWVscyByZXNwb25zaWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlZvbHRhaXJlIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gZW5qb3kgbGlmZSwgd2UgbXVzdCB0b3VjaCBtdWNoIG9mIGl0IGxpZ2h0bHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJWb2x0YWlyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIG11c3QgbmV2ZXIgZm9yZ2V0IHRoYXQgaXQgaXMgdGhyb3VnaCBvdXIgYWN0aW9ucywgd29yZHMsIGFuZCB0aG91Z2h0cyB0aGF0IHdlIGhhdmUgYSBjaG9pY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTb2d5YWwgUmlucG9jaGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgbW9tZW50IG9uZSBnaXZlcyBjbG9zZSBhdHRlbnRpb24gdG8gYW55dGhpbmcsIGl0IGJlY29tZXMgYSBteXN0ZXJpb3VzLCBhd2Vzb21lLCBpbmRlc2NyaWJhYmx5IG1hZ25pZmljZW50IHdvcmxkIGluIGl0c2VsZi4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbnJ5IE1pbGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBzbWlsZSB3aGVuIG5vIG9uZSBlbHNlIGlzIGFyb3VuZCwgeW91IHJlYWxseSBtZWFuIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW5keSBSb29uZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZSB0aGUgY2hhbmdlIHRoYXQgeW91IHdhbnQgdG8gc2VlIGluIHRoZSB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vaGFuZGFzIEdhbmRoaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZlYXIgaXMgYSBkYXJrcm9vbSB3aGVyZSBuZWdhdGl2ZXMgZGV2ZWxvcC4iLAogICAgImF0dHJpYnV0aW9uIjogIlVzbWFuIEFzaWYiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBtdXN0IGJlY29tZSB0aGUgY2hhbmdlIHdlIHdhbnQgdG8gc2VlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFoYXRtYSBHYW5kaGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHaXZpbmcgdXAgZG9lc24ndCBhbHdheXMgbWVhbiB5b3UgYXJlIHdlYWsuIFNvbWV0aW1lcyBpdCBtZWFucyB0aGF0IHlvdSBhcmUgc3Ryb25nIGVub3VnaCB0byBsZXQgZ28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNb3N0IG9mIG91ciBvYnN0YWNsZXMgd291bGQgbWVsdCBhd2F5IGlmLCBpbnN0ZWFkIG9mIGNvd2VyaW5nIGJlZm9yZSB0aGVtLCB3ZSBzaG91bGQgbWFrZSB1cCBvdXIgbWluZHMgdG8gd2FsayBib2xkbHkgdGhyb3VnaCB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT3Jpc29uIE1hcmRlbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIHNlZSB0aGluZ3MgaW4gdGhlIHNlZWQsIHRoYXQgaXMgZ2VuaXVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSB3aXNoIHRvIGJlIGEgd3JpdGVyLCB3cml0ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVwaWN0ZXR1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9uZSBtdXN0IGJlIGZvbmQgb2YgcGVvcGxlIGFuZCB0cnVzdCB0aGVtIGlmIG9uZSBpcyBub3QgdG8gbWFrZSBhIG1lc3Mgb2YgbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkUuIE0uIEZvcnN0ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBhcmUgd2hhdCB3ZSB0aGluay4gQWxsIHRoYXQgd2UgYXJlIGFyaXNlcyB3aXRoIG91ciB0aG91Z2h0cy4gV2l0aCBvdXIgdGhvdWdodHMsIHdlIG1ha2UgdGhlIHdvcmxkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiSSBoYXZlIGFsd2F5cyB0aG91Z2h0IHRoZSBhY3Rpb25zIG9mIG1lbiB0aGUgYmVzdCBpbnRlcnByZXRlcnMgb2YgdGhlaXIgdGhvdWdodHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIExvY2tlIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpcyBub3QgbWVhc3VyZWQgYnkgdGhlIGJyZWF0aHMgeW91IHRha2UsIGJ1dCBieSBpdHMgYnJlYXRodGFraW5nIG1vbWVudHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNaWNoYWVsIFZhbmNlIgogIH0sCiAgewogICAgInRleHQiOiAiU2V0dGluZyBhbiBleGFtcGxlIGlzIG5vdCB0aGUgbWFpbiBtZWFucyBvZiBpbmZsdWVuY2luZyBhbm90aGVyLCBpdCBpcyB0aGUgb25seSBtZWFucy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSB3YW50IHRoaW5ncyB0byBiZSBkaWZmZXJlbnQsIHBlcmhhcHMgdGhlIGFuc3dlciBpcyB0byBiZWNvbWUgZGlmZmVyZW50IHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTm9ybWFuIFBlYWxlIgogIH0sCiAgewogICAgInRleHQiOiAiT25lIG5lZWRzIHNvbWV0aGluZyB0byBiZWxpZXZlIGluLCBzb21ldGhpbmcgZm9yIHdoaWNoIG9uZSBjYW4gaGF2ZSB3aG9sZS1oZWFydGVkIGVudGh1c2lhc20uIE9uZSBuZWVkcyB0byBmZWVsIHRoYXQgb25lcyBsaWZlIGhhcyBtZWFuaW5nLCB0aGF0IG9uZSBpcyBuZWVkZWQgaW4gdGhpcyB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhhbm5haCBTZW5lc2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgZmluZCB5b3Vyc2VsZiBpbiBhIGhvbGUsIHRoZSBmaXJzdCB0aGluZyB0byBkbyBpcyBzdG9wIGRpZ2dpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsIFJvZ2VycyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldobyBzb3dzIHZpcnR1ZSByZWFwcyBob25vdXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMZW9uYXJkbyBkYSBWaW5jaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRhbGsgZG9lc24ndCBjb29rIHJpY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGluZXNlIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgbWluZCB1bmxlYXJucyB3aXRoIGRpZmZpY3VsdHkgd2hhdCBpdCBoYXMgbG9uZyBsZWFybmVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU2VuZWNhIgogIH0sCiAgewogICAgInRleHQiOiAiRG8gbm90IGdvIHdoZXJlIHRoZSBwYXRoIG1heSBsZWFkLCBnbyBpbnN0ZWFkIHdoZXJlIHRoZXJlIGlzIG5vIHBhdGggYW5kIGxlYXZlIGEgdHJhaWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgd2UgYXJlIGZhY2luZyBpbiB0aGUgcmlnaHQgZGlyZWN0aW9uLCBhbGwgd2UgaGF2ZSB0byBkbyBpcyBrZWVwIG9uIHdhbGtpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHbyBwdXQgeW91ciBjcmVlZCBpbnRvIHRoZSBkZWVkLiBOb3Igc3BlYWsgd2l0aCBkb3VibGUgdG9uZ3VlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRpbWUgaXMgbm90IGEgbWVhc3VyZSB0aGUgbGVuZ3RoIG9mIGEgZGF5IG9yIG1vbnRoIG9yIHllYXIgYnV0IG1vcmUgYSBtZWFzdXJlIG9mIHdoYXQgeW91IGhhdmUgYWNjb21wbGlzaGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBlZmZlY3RpdmVseSBjb21tdW5pY2F0ZSwgd2UgbXVzdCByZWFsaXplIHRoYXQgd2UgYXJlIGFsbCBkaWZmZXJlbnQgaW4gdGhlIHdheSB3ZSBwZXJjZWl2ZSB0aGUgd29ybGQgYW5kIHVzZSB0aGlzIHVuZGVyc3RhbmRpbmcgYXMgYSBndWlkZSB0byBvdXIgY29tbXVuaWNhdGlvbiB3aXRoIG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFudGhvbnkgUm9iYmlucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgc2luZ2xlIGNvbnZlcnNhdGlvbiBhY3Jvc3MgdGhlIHRhYmxlIHdpdGggYSB3aXNlIHBlcnNvbiBpcyB3b3J0aCBhIG1vbnRocyBzdHVkeSBvZiBib29rcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoaW5lc2UgcHJvdmVyYiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRpbWUgaXMgdGhlIHdpc2VzdCBjb3Vuc2VsbG9yIG9mIGFsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIlBlcmljbGVzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHdheSB3ZSBjb21tdW5pY2F0ZSB3aXRoIG90aGVycyBhbmQgd2l0aCBvdXJzZWx2ZXMgdWx0aW1hdGVseSBkZXRlcm1pbmVzIHRoZSBxdWFsaXR5IG9mIG91ciBsaXZlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlRvbnkgUm9iYmlucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFiaWxpdHkgd2lsbCBuZXZlciBjYXRjaCB1cCB3aXRoIHRoZSBkZW1hbmQgZm9yIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiQmUgeW91cnNlbGY7IGV2ZXJ5b25lIGVsc2UgaXMgYWxyZWFkeSB0YWtlbi4iLAogICAgImF0dHJpYnV0aW9uIjogIk9zY2FyIFdpbGRlIgogIH0sCiAgewogICAgInRleHQiOiAiR29vZCB0aG91Z2h0cyBhcmUgbm8gYmV0dGVyIHRoYW4gZ29vZCBkcmVhbXMsIHVubGVzcyB0aGV5IGJlIGV4ZWN1dGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk91ciBkaXN0cnVzdCBpcyB2ZXJ5IGV4cGVuc2l2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8gdGFsa3MgbW9yZSBpcyBzb29uZXIgZXhoYXVzdGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IGNyeSBiZWNhdXNlIGl0J3Mgb3Zlci4gU21pbGUgYmVjYXVzZSBpdCBoYXBwZW5lZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkRyLiBTZXVzcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IHNtb3RoZXIgZWFjaCBvdGhlci4gTm8gb25lIGNhbiBncm93IGluIHRoZSBzaGFkZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlbyBGLiBCdXNjYWdsaWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiBhIG1hbiBkb2VzIGhpcyBiZXN0LCB3aGF0IGVsc2UgaXMgdGhlcmU/IiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZ2UgUGF0dG9uIgogIH0sCiAgewogICAgInRleHQiOiAiV2l0aCB0aGUgcmVhbGl6YXRpb24gb2Ygb25lcyBvd24gcG90ZW50aWFsIGFuZCBzZWxmLWNvbmZpZGVuY2UgaW4gb25lcyBhYmlsaXR5LCBvbmUgY2FuIGJ1aWxkIGEgYmV0dGVyIHdvcmxkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgYW0gYWx3YXlzIGRvaW5nIHRoYXQgd2hpY2ggSSBjYW4gbm90IGRvLCBpbiBvcmRlciB0aGF0IEkgbWF5IGxlYXJuIGhvdyB0byBkbyBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlBhYmxvIFBpY2Fzc28iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgaGFwcGluZXNzIHRoYXQgaXMgZ2VudWluZWx5IHNhdGlzZnlpbmcgaXMgYWNjb21wYW5pZWQgYnkgdGhlIGZ1bGxlc3QgZXhlcmNpc2Ugb2Ygb3VyIGZhY3VsdGllcyBhbmQgdGhlIGZ1bGxlc3QgcmVhbGl6YXRpb24gb2YgdGhlIHdvcmxkIGluIHdoaWNoIHdlIGxpdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZXJ0cmFuZCBSdXNzZWxsIgogIH0sCiAgewogICAgInRleHQiOiAiRGlmZmljdWx0aWVzIGluY3JlYXNlIHRoZSBuZWFyZXIgd2UgZ2V0IHRvIHRoZSBnb2FsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9oYW5uIFdvbGZnYW5nIHZvbiBHb2V0aGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeSBkYXkgbWF5IG5vdCBiZSBnb29kLCBidXQgdGhlcmUncyBzb21ldGhpbmcgZ29vZCBpbiBldmVyeSBkYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2FuIHRlbGwgd2hldGhlciBhIG1hbiBpcyBjbGV2ZXIgYnkgaGlzIGFuc3dlcnMuIFlvdSBjYW4gdGVsbCB3aGV0aGVyIGEgbWFuIGlzIHdpc2UgYnkgaGlzIHF1ZXN0aW9ucy4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hZ3VpYiBNYWhmb3V6IgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHRydXRoIG9mIHRoZSBtYXR0ZXIgaXMgdGhhdCB5b3UgYWx3YXlzIGtub3cgdGhlIHJpZ2h0IHRoaW5nIHRvIGRvLiBUaGUgaGFyZCBwYXJ0IGlzIGRvaW5nIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTm9ybWFuIFNjaHdhcnprb3BmIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgdGhhdCByZXNwZWN0cyBoaW1zZWxmIGlzIHNhZmUgZnJvbSBvdGhlcnM7IGhlIHdlYXJzIGEgY29hdCBvZiBtYWlsIHRoYXQgbm9uZSBjYW4gcGllcmNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgTG9uZ2ZlbGxvdyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gdGhlIHNvbHV0aW9uIGlzIHNpbXBsZSwgR29kIGlzIGFuc3dlcmluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIHVuZGVyc3RhbmQgdGhlIGhlYXJ0IGFuZCBtaW5kIG9mIGEgcGVyc29uLCBsb29rIG5vdCBhdCB3aGF0IGhlIGhhcyBhbHJlYWR5IGFjaGlldmVkLCBidXQgYXQgd2hhdCBoZSBhc3BpcmVzIHRvIGRvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2FobGlsIEdpYnJhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBuZWVkIGNoYW9zIGluIHlvdXIgc291bCB0byBnaXZlIGJpcnRoIHRvIGEgZGFuY2luZyBzdGFyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmlldHpzY2hlIgogIH0sCiAgewogICAgInRleHQiOiAiV2l0aCBldmVyeSBleHBlcmllbmNlLCB5b3UgYWxvbmUgYXJlIHBhaW50aW5nIHlvdXIgb3duIGNhbnZhcywgdGhvdWdodCBieSB0aG91Z2h0LCBjaG9pY2UgYnkgY2hvaWNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiT3ByYWggV2luZnJleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIEkgYW0gbm90IGZvciBteXNlbGYsIHdobyB3aWxsIGJlIGZvciBtZT8gSWYgSSBhbSBub3QgZm9yIG90aGVycywgd2hhdCBhbSBJPyBBbmQgaWYgbm90IG5vdywgd2hlbj8iLAogICAgImF0dHJpYnV0aW9uIjogIlJhYmJpIEhpbGxlbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5IHNpeHR5IHNlY29uZHMgeW91IHNwZW5kIGFuZ3J5LCB1cHNldCBvciBtYWQsIGlzIGEgZnVsbCBtaW51dGUgb2YgaGFwcGluZXNzIHlvdSdsbCBuZXZlciBnZXQgYmFjay4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGNhbm5vdCBzb2x2ZSBvdXIgcHJvYmxlbXMgd2l0aCB0aGUgc2FtZSB0aGlua2luZyB3ZSB1c2VkIHdoZW4gd2UgY3JlYXRlZCB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IEVpbnN0ZWluIgogIH0sCiAgewogICAgInRleHQiOiAiSGFwcGluZXNzIG1haW5seSBjb21lcyBmcm9tIG91ciBvd24gYXR0aXR1ZGUsIHJhdGhlciB0aGFuIGZyb20gZXh0ZXJuYWwgZmFjdG9ycy4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhbGFpIExhbWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgd29ybGQgaXMgYWx3YXlzIGluIG1vdmVtZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVi4gTmFpcGF1bCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNpbGVuY2UgaXMgYSBmZW5jZSBhcm91bmQgd2lzZG9tLiIsCiAgICAiYXR0cmlidXRpb24iOiAiR2VybWFuIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDaGFuZ2UgeW91ciB0aG91Z2h0cywgY2hhbmdlIHlvdXIgbGlmZSEiLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIG5vdCBzbyBpbXBvcnRhbnQgdG8ga25vdyBldmVyeXRoaW5nIGFzIHRvIGFwcHJlY2lhdGUgd2hhdCB3ZSBsZWFybi4iLAogICAgImF0dHJpYnV0aW9uIjogIkhhbm5haCBNb3JlIgogIH0sCiAgewogICAgInRleHQiOiAiTWVhbmluZyBpcyBub3Qgd2hhdCB5b3Ugc3RhcnQgd2l0aCBidXQgd2hhdCB5b3UgZW5kIHVwIHdpdGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQZXRlciBFbGJvdyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gd2UgcXVpdCB0aGlua2luZyBwcmltYXJpbHkgYWJvdXQgb3Vyc2VsdmVzIGFuZCBvdXIgb3duIHNlbGYtcHJlc2VydmF0aW9uLCB3ZSB1bmRlcmdvIGEgdHJ1bHkgaGVyb2ljIHRyYW5zZm9ybWF0aW9uIG9mIGNvbnNjaW91c25lc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb3NlcGggQ2FtcGJlbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgYnJlYWsgeW91ciBuZWNrLCBpZiB5b3UgaGF2ZSBub3RoaW5nIHRvIGVhdCwgaWYgeW91ciBob3VzZSBpcyBvbiBmaXJlLCB0aGVuIHlvdSBnb3QgYSBwcm9ibGVtLiBFdmVyeXRoaW5nIGVsc2UgaXMgaW5jb252ZW5pZW5jZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJvYmVydCBGdWxnaHVtIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGhhcyBpbWFnaW5hdGlvbiB3aXRob3V0IGxlYXJuaW5nIGhhcyB3aW5ncyBidXQgbm8gZmVldC4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvc2VwaCBKb3ViZXJ0IgogIH0sCiAgewogICAgInRleHQiOiAiSnVzdCBhcyBtdWNoIGFzIHdlIHNlZSBpbiBvdGhlcnMgd2UgaGF2ZSBpbiBvdXJzZWx2ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIEhhemxpdHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTaW1wbHkgcHV0LCB5b3UgYmVsaWV2ZXIgdGhhdCB0aGluZ3Mgb3IgcGVvcGxlIG1ha2UgeW91IHVuaGFwcHksIGJ1dCB0aGlzIGlzIG5vdCBhY2N1cmF0ZS4gWW91IG1ha2UgeW91cnNlbGYgdW5oYXBweS4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZGVhbHMgYXJlIGFuIGltYWdpbmF0aXZlIHVuZGVyc3RhbmRpbmcgb2YgdGhhdCB3aGljaCBpcyBkZXNpcmFibGUgaW4gdGhhdCB3aGljaCBpcyBwb3NzaWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldhbHRlciBMaXBwbWFubiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldpdGhvdXQgbGVhcHMgb2YgaW1hZ2luYXRpb24sIG9yIGRyZWFtaW5nLCB3ZSBsb3NlIHRoZSBleGNpdGVtZW50IG9mIHBvc3NpYmlsaXRpZXMuIERyZWFtaW5nLCBhZnRlciBhbGwsIGlzIGEgZm9ybSBvZiBwbGFubmluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkdsb3JpYSBTdGVpbmVtIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgY2Fubm90IGNoYW5nZSBvdXIgbWVtb3JpZXMsIGJ1dCB3ZSBjYW4gY2hhbmdlIHRoZWlyIG1lYW5pbmcgYW5kIHRoZSBwb3dlciB0aGV5IGhhdmUgb3ZlciB1cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhdmlkIFNlYW1hbnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGhlcm8gaXMgbm8gYnJhdmVyIHRoYW4gYW4gb3JkaW5hcnkgbWFuLCBidXQgaGUgaXMgYnJhdmVyIGZpdmUgbWludXRlcyBsb25nZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBXYWxkbyBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGFyZSB0aGUgb25seSBwZXJzb24gb24gRWFydGggd2hvIGNhbiB1c2UgeW91ciBhYmlsaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiWmlnIFppZ2xhciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlR1cm4geW91ciBmYWNlIHRvd2FyZCB0aGUgc3VuIGFuZCB0aGUgc2hhZG93cyB3aWxsIGZhbGwgYmVoaW5kIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hb3JpIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgbWFuIHdobyB0cnVzdHMgbWVuIHdpbGwgbWFrZSBmZXdlciBtaXN0YWtlcyB0aGFuIGhlIHdobyBkaXN0cnVzdHMgdGhlbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNhdm91ciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgbWFuJ3MgZHJlYW1zIGFyZSBhbiBpbmRleCB0byBoaXMgZ3JlYXRuZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiWmFkb2sgUmFiaW5vd2l0eiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV4YW1wbGUgaGFzIG1vcmUgZm9sbG93ZXJzIHRoYW4gcmVhc29uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hyaXN0aWFuIEJvdmVlIgogIH0sCiAgewogICAgInRleHQiOiAiSSBhbSBhbHdheXMgZG9pbmcgdGhhdCB3aGljaCBJIGNhbm5vdCBkbywgaW4gb3JkZXIgdGhhdCBJIG1heSBsZWFybiBob3cgdG8gZG8gaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQYWJsbyBQaWNhc3NvIgogIH0sCiAgewogICAgInRleHQiOiAiRXZlcnl0aGluZyB0aGF0IGlycml0YXRlcyB1cyBhYm91dCBvdGhlcnMgY2FuIGxlYWQgdXMgdG8gYW4gdW5kZXJzdGFuZGluZyBvZiBvdXJzZWx2ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXJsIEp1bmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZSBnZW50bGUgZmlyc3Qgd2l0aCB5b3Vyc2VsZiBpZiB5b3Ugd2lzaCB0byBiZSBnZW50bGUgd2l0aCBvdGhlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW1hIFllc2hlIgogIH0sCiAgewogICAgInRleHQiOiAiT25lIHRvZGF5IGlzIHdvcnRoIHR3byB0b21vcnJvd3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBGcmFua2xpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldpdGhvdXQgZmFpdGgsIG5vdGhpbmcgaXMgcG9zc2libGUuIFdpdGggaXQsIG5vdGhpbmcgaXMgaW1wb3NzaWJsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcnkgQmV0aHVuZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoYXQgaXMgbmV3IGluIHRoZSB3b3JsZD8gTm90aGluZy4gV2hhdCBpcyBvbGQgaW4gdGhlIHdvcmxkPyBOb3RoaW5nLiBFdmVyeXRoaW5nIGhhcyBhbHdheXMgYmVlbiBhbmQgd2lsbCBhbHdheXMgYmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTYWkgQmFiYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5IGdyZWF0IGFkdmFuY2UgaW4gc2NpZW5jZSBoYXMgaXNzdWVkIGZyb20gYSBuZXcgYXVkYWNpdHkgb2YgdGhlIGltYWdpbmF0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBEZXdleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk11Y2ggd2lzZG9tIG9mdGVuIGdvZXMgd2l0aCBmZXdlciB3b3Jkcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlNvcGhvY2xlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgZHJlYW0gaXMgeW91ciBjcmVhdGl2ZSB2aXNpb24gZm9yIHlvdXIgbGlmZSBpbiB0aGUgZnV0dXJlLiBZb3UgbXVzdCBicmVhayBvdXQgb2YgeW91ciBjdXJyZW50IGNvbWZvcnQgem9uZSBhbmQgYmVjb21lIGNvbWZvcnRhYmxlIHdpdGggdGhlIHVuZmFtaWxpYXIgYW5kIHRoZSB1bmtub3duLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGVuaXMgV2FpdGxleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGNob29zZSBvdXIgZGVzdGlueSBpbiB0aGUgd2F5IHdlIHRyZWF0IG90aGVycy4iLAogICAgImF0dHJpYnV0aW9uIjogIldpdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFsbCB0aGF0IHdlIGFyZSBpcyB0aGUgcmVzdWx0IG9mIHdoYXQgd2UgaGF2ZSB0aG91Z2h0LiBUaGUgbWluZCBpcyBldmVyeXRoaW5nLiBXaGF0IHdlIHRoaW5rIHdlIGJlY29tZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoYXQgaXMgbm90IHN0YXJ0ZWQgdG9kYXkgaXMgbmV2ZXIgZmluaXNoZWQgdG9tb3Jyb3cuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHb2V0aGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHb29kIGFkdmljZSBpcyBhbHdheXMgY2VydGFpbiB0byBiZSBpZ25vcmVkLCBidXQgdGhhdCdzIG5vIHJlYXNvbiBub3QgdG8gZ2l2ZSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFnYXRoYSBDaHJpc3RpZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIGdyZWF0IGluIGFjdCwgYXMgeW91IGhhdmUgYmVlbiBpbiB0aG91Z2h0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9uZSB3aG8gYXNrcyBhIHF1ZXN0aW9uIGlzIGEgZm9vbCBmb3IgZml2ZSBtaW51dGVzOyBvbmUgd2hvIGRvZXMgbm90IGFzayBhIHF1ZXN0aW9uIHJlbWFpbnMgYSBmb29sIGZvcmV2ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBcnJpdmluZyBhdCBvbmUgcG9pbnQgaXMgdGhlIHN0YXJ0aW5nIHBvaW50IHRvIGFub3RoZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIERld2V5IgogIH0sCiAgewogICAgInRleHQiOiAiTWlzdGFrZXMgYXJlIGFsd2F5cyBmb3JnaXZhYmxlLCBpZiBvbmUgaGFzIHRoZSBjb3VyYWdlIHRvIGFkbWl0IHRoZW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCcnVjZSBMZWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVyZXZlciBhIG1hbiBtYXkgaGFwcGVuIHRvIHR1cm4sIHdoYXRldmVyIGEgbWFuIG1heSB1bmRlcnRha2UsIGhlIHdpbGwgYWx3YXlzIGVuZCB1cCBieSByZXR1cm5pbmcgdG8gdGhlIHBhdGggd2hpY2ggbmF0dXJlIGhhcyBtYXJrZWQgb3V0IGZvciBoaW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2hhbm4gV29sZmdhbmcgdm9uIEdvZXRoZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhcHBpbmVzcyBkb2VzIG5vdCBjb21lIGFib3V0IG9ubHkgZHVlIHRvIGV4dGVybmFsIGNpcmN1bXN0YW5jZXM7IGl0IG1haW5seSBkZXJpdmVzIGZyb20gaW5uZXIgYXR0aXR1ZGVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNsb3cgZG93biBhbmQgZW5qb3kgbGlmZS4gSXQncyBub3Qgb25seSB0aGUgc2NlbmVyeSB5b3UgbWlzcyBieSBnb2luZyB0b28gZmFzdCAtIHlvdSBhbHNvIG1pc3MgdGhlIHNlbnNlIG9mIHdoZXJlIHlvdSBhcmUgZ29pbmcgYW5kIHdoeS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVkZGllIENhbnRvciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhcHBpbmVzcyBpcyBmb3VuZCBpbiBkb2luZywgbm90IG1lcmVseSBwb3NzZXNzaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFyZSBhbGwgZmFjZWQgd2l0aCBhIHNlcmllcyBvZiBncmVhdCBvcHBvcnR1bml0aWVzIGJyaWxsaWFudGx5IGRpc2d1aXNlZCBhcyBpbXBvc3NpYmxlIHNpdHVhdGlvbnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFybGVzIFIuIFN3aW5kb2xsIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGNhbiBzdGFuZCB0YWxsIHdpdGhvdXQgc3RhbmRpbmcgb24gc29tZW9uZS4gWW91IGNhbiBiZSBhIHZpY3RvciB3aXRob3V0IGhhdmluZyB2aWN0aW1zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGFycmlldCBXb29kcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIk91ciBzdHJlbmd0aCBncm93cyBvdXQgb2Ygb3VyIHdlYWtuZXNzZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBXYWxkbyBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiU2FkbmVzcyBmbGllcyBhd2F5IG9uIHRoZSB3aW5ncyBvZiB0aW1lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmVhbiBkZSBsYSBGb250YWluZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFueSBvZiB1cyBjYW4gYWNoaWV2ZSB2aXJ0dWUsIGlmIGJ5IHZpcnR1ZSB3ZSBtZXJlbHkgbWVhbiB0aGUgYXZvaWRhbmNlIG9mIHRoZSB2aWNlcyB0aGF0IGRvIG5vdCBhdHRyYWN0IHVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IEx5bmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgd29ybGQgZG9lc24ndCBoYXBwZW4gdG8geW91IGl0IGhhcHBlbnMgZnJvbSB5b3UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSBkb3VidCB5b3VyIHBvd2VyLCB5b3UgZ2l2ZSBwb3dlciB0byB5b3VyIGRvdWJ0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSG9ub3JlIGRlIEJhbHphYyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBiZXN0IGFuZCBtb3N0IGJlYXV0aWZ1bCB0aGluZ3MgaW4gdGhlIHdvcmxkIGNhbm5vdCBiZSBzZWVuLCBub3IgdG91Y2hlZC4uLiBidXQgYXJlIGZlbHQgaW4gdGhlIGhlYXJ0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVsZW4gS2VsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiTG9zcyBpcyBub3RoaW5nIGVsc2UgYnV0IGNoYW5nZSxhbmQgY2hhbmdlIGlzIE5hdHVyZXMgZGVsaWdodC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIktlZXAgeW91cnNlbGYgdG8gdGhlIHN1bnNoaW5lIGFuZCB5b3UgY2Fubm90IHNlZSB0aGUgc2hhZG93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVsZW4gS2VsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiSSBoYXZlIGRvbmUgbXkgYmVzdDogdGhhdCBpcyBhYm91dCBhbGwgdGhlIHBoaWxvc29waHkgb2YgbGl2aW5nIG9uZSBuZWVkcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxpbi15dXRhbmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGFsd2F5cyB3YW50ZWQgdG8gYmUgc29tZWJvZHksIGJ1dCBJIHNob3VsZCBoYXZlIGJlZW4gbW9yZSBzcGVjaWZpYy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxpbHkgVG9tbGluIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHBlcnNvbiBib3JuIHdpdGggYSB0YWxlbnQgdGhleSBhcmUgbWVhbnQgdG8gdXNlIHdpbGwgZmluZCB0aGVpciBncmVhdGVzdCBoYXBwaW5lc3MgaW4gdXNpbmcgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2hhbm4gV29sZmdhbmcgdm9uIEdvZXRoZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIGEgY29udHJvdmVyc3kgdGhlIGluc3RhbnQgd2UgZmVlbCBhbmdlciB3ZSBoYXZlIGFscmVhZHkgY2Vhc2VkIHN0cml2aW5nIGZvciB0aGUgdHJ1dGgsIGFuZCBoYXZlIGJlZ3VuIHN0cml2aW5nIGZvciBvdXJzZWx2ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeSBhZHZlcnNpdHksIGV2ZXJ5IGZhaWx1cmUsIGV2ZXJ5IGhlYXJ0YWNoZSBjYXJyaWVzIHdpdGggaXQgdGhlIHNlZWQgb2YgYW4gZXF1YWwgb3IgZ3JlYXRlciBiZW5lZml0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFyZSB3aGF0IHdlIHJlcGVhdGVkbHkgZG8uIEV4Y2VsbGVuY2UsIHRoZW4sIGlzIG5vdCBhbiBhY3QgYnV0IGEgaGFiaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBcmlzdG90bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXb3JrIG91dCB5b3VyIG93biBzYWx2YXRpb24uIERvIG5vdCBkZXBlbmQgb24gb3RoZXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGNhbiBvbmx5IGdyb3cgaWYgeW91J3JlIHdpbGxpbmcgdG8gZmVlbCBhd2t3YXJkIGFuZCB1bmNvbWZvcnRhYmxlIHdoZW4geW91IHRyeSBzb21ldGhpbmcgbmV3LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJpYW4gVHJhY3kiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHaXZlIHdoYXRldmVyIHlvdSBhcmUgZG9pbmcgYW5kIHdob2V2ZXIgeW91IGFyZSB3aXRoIHRoZSBnaWZ0IG9mIHlvdXIgYXR0ZW50aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmltIFJvaG4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJQZW9wbGUgc2VsZG9tIG5vdGljZSBvbGQgY2xvdGhlcyBpZiB5b3Ugd2VhciBhIGJpZyBzbWlsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlZSBNaWxkb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZ25vcmFuY2UgbmV2ZXIgc2V0dGxlIGEgcXVlc3Rpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBEaXNyYWVsaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgcHJlZmVyIHRvIGJlIHRydWUgdG8gbXlzZWxmLCBldmVuIGF0IHRoZSBoYXphcmQgb2YgaW5jdXJyaW5nIHRoZSByaWRpY3VsZSBvZiBvdGhlcnMsIHJhdGhlciB0aGFuIHRvIGJlIGZhbHNlLCBhbmQgdG8gaW5jdXIgbXkgb3duIGFiaG9ycmVuY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmVkZXJpY2sgRG91Z2xhc3MiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZGVhcyBhcmUgdGhlIGJlZ2lubmluZyBwb2ludHMgb2YgYWxsIGZvcnR1bmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTmFwb2xlb24gSGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBrZXkgdG8gdHJhbnNmb3JtaW5nIG91ciBoZWFydHMgYW5kIG1pbmRzIGlzIHRvIGhhdmUgYW4gdW5kZXJzdGFuZGluZyBvZiBob3cgb3VyIHRob3VnaHRzIGFuZCBlbW90aW9ucyB3b3JrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsYWkgTGFtYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRob3VnaCBubyBvbmUgY2FuIGdvIGJhY2sgYW5kIG1ha2UgYSBicmFuZCBuZXcgc3RhcnQsIGFueW9uZSBjYW4gc3RhcnQgZnJvbSBub3cgYW5kIG1ha2UgYSBicmFuZCBuZXcgZW5kaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiTmV2ZXIgbWlzcyBhbiBvcHBvcnR1bml0eSB0byBtYWtlIG90aGVycyBoYXBweSwgZXZlbiBpZiB5b3UgaGF2ZSB0byBsZWF2ZSB0aGVtIGFsb25lIGluIG9yZGVyIHRvIGRvIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiU2luY2VyaXR5IGlzIHRoZSB3YXkgb2YgSGVhdmVuLiBUaGUgYXR0YWlubWVudCBvZiBzaW5jZXJpdHkgaXMgdGhlIHdheSBvZiBtZW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTdHVkeSB0aGUgcGFzdCwgaWYgeW91IHdvdWxkIGRpdmluZSB0aGUgZnV0dXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiVGhpbmsgaG93IGhhcmQgcGh5c2ljcyB3b3VsZCBiZSBpZiBwYXJ0aWNsZXMgY291bGQgdGhpbmsuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNdXJyYXkgR2VsbC1NYW5uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhleSBtdXN0IG9mdGVuIGNoYW5nZSwgd2hvIHdvdWxkIGJlIGNvbnN0YW50IGluIGhhcHBpbmVzcyBvciB3aXNkb20uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbHRob3VnaCB0aGVyZSBtYXkgYmUgdHJhZ2VkeSBpbiB5b3VyIGxpZmUsIHRoZXJlJ3MgYWx3YXlzIGEgcG9zc2liaWxpdHkgdG8gdHJpdW1waC4gSXQgZG9lc24ndCBtYXR0ZXIgd2hvIHlvdSBhcmUsIHdoZXJlIHlvdSBjb21lIGZyb20uIFRoZSBhYmlsaXR5IHRvIHRyaXVtcGggYmVnaW5zIHdpdGggeW91LiBBbHdheXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPcHJhaCBXaW5mcmV5IgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiBhbmdlciB1c2UgeW91ciBlbmVyZ3kgdG8gZG8gc29tZXRoaW5nIHByb2R1Y3RpdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDLiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW4gbmV2ZXIgY3Jvc3MgdGhlIG9jZWFuIHVubGVzcyB5b3UgaGF2ZSB0aGUgY291cmFnZSB0byBsb3NlIHNpZ2h0IG9mIHRoZSBzaG9yZS4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRob3VnaCBubyBvbmUgY2FuIGdvIGJhY2sgYW5kIG1ha2UgYSBicmFuZCBuZXcgc3RhcnQsIGFueW9uZSBjYW4gc3RhcnQgZnJvbSBub3QgYW5kIG1ha2UgYSBicmFuZCBuZXcgZW5kaW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybCBCYXJkIgogIH0sCiAgewogICAgInRleHQiOiAiRG9uJ3QgdGhpbmsgb2YgaXQgYXMgZmFpbHVyZS4gVGhpbmsgb2YgaXQgYXMgdGltZS1yZWxlYXNlZCBzdWNjZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IE9yYmVuIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGxlYXJuIHRvIHNwZWFrIGJ5IHNwZWFraW5nLCB0byBzdHVkeSBieSBzdHVkeWluZywgdG8gcnVuIGJ5IHJ1bm5pbmcsIHRvIHdvcmsgYnkgd29ya2luZzsgaW4ganVzdCB0aGUgc2FtZSB3YXksIHlvdSBsZWFybiB0byBsb3ZlIGJ5IGxvdmluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuYXRvbGUgRnJhbmNlIgogIH0sCiAgewogICAgInRleHQiOiAiSnVkZ2Ugbm90aGluZywgeW91IHdpbGwgYmUgaGFwcHkuIEZvcmdpdmUgZXZlcnl0aGluZywgeW91IHdpbGwgYmUgaGFwcGllci4gTG92ZSBldmVyeXRoaW5nLCB5b3Ugd2lsbCBiZSBoYXBwaWVzdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlNyaSBDaGlubW95IgogIH0sCiAgewogICAgInRleHQiOiAiTGlzdGVuIHRvIHlvdXIgaW50dWl0aW9uLiBJdCB3aWxsIHRlbGwgeW91IGV2ZXJ5dGhpbmcgeW91IG5lZWQgdG8ga25vdy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFudGhvbnkgRCdBbmdlbG8iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJdCBpcyBub3QgdGhlIHBvc3Nlc3Npb24gb2YgdHJ1dGgsIGJ1dCB0aGUgc3VjY2VzcyB3aGljaCBhdHRlbmRzIHRoZSBzZWVraW5nIGFmdGVyIGl0LCB0aGF0IGVucmljaGVzIHRoZSBzZWVrZXIgYW5kIGJyaW5ncyBoYXBwaW5lc3MgdG8gaGltLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWF4IFBsYW5jayIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9uZSBmYWlscyBmb3J3YXJkIHRvd2FyZCBzdWNjZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2hhcmxlcyBLZXR0ZXJpbmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJGcm9tIGxpdHRsZSBhY29ybnMgbWlnaHR5IG9ha3MgZG8gZ3Jvdy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFtZXJpY2FuIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2FuJ3QgdHJ1c3Qgd2l0aG91dCByaXNrIGJ1dCBuZWl0aGVyIGNhbiB5b3UgbGl2ZSBpbiBhIGNvY29vbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgbXVzdCBlbWJyYWNlIHBhaW4gYW5kIGJ1cm4gaXQgYXMgZnVlbCBmb3Igb3VyIGpvdXJuZXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJLZW5qaSBNaXlhemF3YSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4gSSBkYXJlIHRvIGJlIHBvd2VyZnVsLCB0byB1c2UgbXkgc3RyZW5ndGggaW4gdGhlIHNlcnZpY2Ugb2YgbXkgdmlzaW9uLCB0aGVuIGl0IGJlY29tZXMgbGVzcyBhbmQgbGVzcyBpbXBvcnRhbnQgd2hldGhlciBJIGFtIGFmcmFpZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkF1ZHJlIExvcmRlIgogIH0sCiAgewogICAgInRleHQiOiAiRG9uJ3QgY29tcHJvbWlzZSB5b3Vyc2VsZi4gWW91IGFyZSBhbGwgeW91J3ZlIGdvdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkphbmlzIEpvcGxpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBmcmVlIG1hbiBpcyBoZSB3aG8gZG9lcyBub3QgZmVhciB0byBnbyB0byB0aGUgZW5kIG9mIGhpcyB0aG91Z2h0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGVvbiBCbHVtIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGdyZWF0ZXN0IHdheSB0byBsaXZlIHdpdGggaG9ub3VyIGluIHRoaXMgd29ybGQgaXMgdG8gYmUgd2hhdCB3ZSBwcmV0ZW5kIHRvIGJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU29jcmF0ZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2FuIGNvbXBsYWluIGJlY2F1c2Ugcm9zZXMgaGF2ZSB0aG9ybnMsIG9yIHlvdSBjYW4gcmVqb2ljZSBiZWNhdXNlIHRob3JucyBoYXZlIHJvc2VzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiWmlnZ3kiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZSB0aGFua2Z1bCB3aGVuIHlvdSBkb24ndCBrbm93IHNvbWV0aGluZyBmb3IgaXQgZ2l2ZXMgeW91IHRoZSBvcHBvcnR1bml0eSB0byBsZWFybi4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGxlYXJuIHdoYXQgd2UgaGF2ZSBzYWlkIGZyb20gdGhvc2Ugd2hvIGxpc3RlbiB0byBvdXIgc3BlYWtpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJLZW5uZXRoIFBhdHRvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkxvdmUgaXMgbmV2ZXIgbG9zdC4gSWYgbm90IHJlY2lwcm9jYXRlZCwgaXQgd2lsbCBmbG93IGJhY2sgYW5kIHNvZnRlbiBhbmQgcHVyaWZ5IHRoZSBoZWFydC4iLAogICAgImF0dHJpYnV0aW9uIjogIldhc2hpbmd0b24gSXJ2aW5nIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gYWNjb21wbGlzaCBncmVhdCB0aGluZ3MsIHdlIG11c3Qgbm90IG9ubHkgYWN0LCBidXQgYWxzbyBkcmVhbTsgbm90IG9ubHkgcGxhbiwgYnV0IGFsc28gYmVsaWV2ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFuYXRvbGUgRnJhbmNlIgogIH0sCiAgewogICAgInRleHQiOiAiV2hlbiB5b3UgcmVhbGl6ZSBob3cgcGVyZmVjdCBldmVyeXRoaW5nIGlzIHlvdSB3aWxsIHRpbHQgeW91ciBoZWFkIGJhY2sgYW5kIGxhdWdoIGF0IHRoZSBza3kuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNZWRpb2NyaXR5IGtub3dzIG5vdGhpbmcgaGlnaGVyIHRoYW4gaXRzZWxmLCBidXQgdGFsZW50IGluc3RhbnRseSByZWNvZ25pemVzIGdlbml1cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFydGh1ciBDb25hbiBEb3lsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgcGVyc29uIHdobyBuZXZlciBtYWRlIGEgbWlzdGFrZSBuZXZlciB0cmllZCBhbnl0aGluZyBuZXcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGlzIHByZWRlc3RpbmVkOiBUaGUgb2JzdGFjbGVzIG9mIHlvdXIgcGFzdCBjYW4gYmVjb21lIHRoZSBnYXRld2F5cyB0aGF0IGxlYWQgdG8gbmV3IGJlZ2lubmluZ3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBCbHVtIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSdzIGNoYWxsZW5nZXMgYXJlIG5vdCBzdXBwb3NlZCB0byBwYXJhbHl6ZSB5b3UsIHRoZXkncmUgc3VwcG9zZWQgdG8gaGVscCB5b3UgZGlzY292ZXIgd2hvIHlvdSBhcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZXJuaWNlIFJlYWdvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW4ndCBjaG9vc2UgdXAgc2lkZXMgb24gYSByb3VuZCB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJLaW5kbmVzcyBpcyBtb3JlIGltcG9ydGFudCB0aGFuIHdpc2RvbSwgYW5kIHRoZSByZWNvZ25pdGlvbiBvZiB0aGlzIGlzIHRoZSBiZWdpbm5pbmcgb2Ygd2lzZG9tLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhlb2RvcmUgUnViaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgcGVyc29uIHdobyBtYWtlcyBhIHN1Y2Nlc3Mgb2YgbGl2aW5nIGlzIHRoZSBvbmUgd2hvIHNlZSBoaXMgZ29hbCBzdGVhZGlseSBhbmQgYWltcyBmb3IgaXQgdW5zd2VydmluZ2x5LiBUaGF0IGlzIGRlZGljYXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDZWNpbCBCLiBEZU1pbGxlIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGRvIHdoYXQgeW91J3ZlIGFsd2F5cyBkb25lLCB5b3UnbGwgZ2V0IHdoYXQgeW91dmUgYWx3YXlzIGdvdHRlbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlRvbnkgUm9iYmlucyIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4geW91IGp1ZGdlIGFub3RoZXIsIHlvdSBkbyBub3QgZGVmaW5lIHRoZW0sIHlvdSBkZWZpbmUgeW91cnNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYXluZSBEeWVyIgogIH0sCiAgewogICAgInRleHQiOiAiQW4gb3VuY2Ugb2YgZW1vdGlvbiBpcyBlcXVhbCB0byBhIHRvbiBvZiBmYWN0cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gSnVub3IiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGb3JnZXQgYWJvdXQgYWxsIHRoZSByZWFzb25zIHdoeSBzb21ldGhpbmcgbWF5IG5vdCB3b3JrLiBZb3Ugb25seSBuZWVkIHRvIGZpbmQgb25lIGdvb2QgcmVhc29uIHdoeSBpdCB3aWxsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IEFudGhvbnkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbWFnaW5hdGlvbiBpcyBtb3JlIGltcG9ydGFudCB0aGFuIGtub3dsZWRnZS4gRm9yIHdoaWxlIGtub3dsZWRnZSBkZWZpbmVzIGFsbCB3ZSBjdXJyZW50bHkga25vdyBhbmQgdW5kZXJzdGFuZCwgaW1hZ2luYXRpb24gcG9pbnRzIHRvIGFsbCB3ZSBtaWdodCB5ZXQgZGlzY292ZXIgYW5kIGNyZWF0ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vdCBldmVyeSBkaWZmaWN1bHQgYW5kIGRhbmdlcm91cyB0aGluZyBpcyBzdWl0YWJsZSBmb3IgdHJhaW5pbmcsIGJ1dCBvbmx5IHRoYXQgd2hpY2ggaXMgY29uZHVjaXZlIHRvIHN1Y2Nlc3MgaW4gYWNoaWV2aW5nIHRoZSBvYmplY3Qgb2Ygb3VyIGVmZm9ydC4iLAogICAgImF0dHJpYnV0aW9uIjogIkVwaWN0ZXR1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFnZSBkb2VzIG5vdCBwcm90ZWN0IHlvdSBmcm9tIGxvdmUuIEJ1dCBsb3ZlLCB0byBzb21lIGV4dGVudCwgcHJvdGVjdHMgeW91IGZyb20gYWdlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW5haXMgTmluIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IHRlYWNoIGJlc3Qgd2hhdCB5b3UgbW9zdCBuZWVkIHRvIGxlYXJuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHNlY3JldCB0byBhIHJpY2ggbGlmZSBpcyB0byBoYXZlIG1vcmUgYmVnaW5uaW5ncyB0aGFuIGVuZGluZ3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYXZlIFdlaW5iYXVtIgogIH0sCiAgewogICAgInRleHQiOiAiSW52ZW50IHlvdXIgd29ybGQuIFN1cnJvdW5kIHlvdXJzZWxmIHdpdGggcGVvcGxlLCBjb2xvciwgc291bmRzLCBhbmQgd29yayB0aGF0IG5vdXJpc2ggeW91LiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiUmVtZW1iZXIgYWx3YXlzIHRoYXQgeW91IG5vdCBvbmx5IGhhdmUgdGhlIHJpZ2h0IHRvIGJlIGFuIGluZGl2aWR1YWwsIHlvdSBoYXZlIGFuIG9ibGlnYXRpb24gdG8gYmUgb25lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxlYW5vciBSb29zZXZlbHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTZWxmLXRydXN0IGlzIHRoZSBmaXJzdCBzZWNyZXQgb2Ygc3VjY2Vzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgZG8gbm90IGNoYW5nZSBkaXJlY3Rpb24sIHlvdSBtYXkgZW5kIHVwIHdoZXJlIHlvdSBhcmUgaGVhZGluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGVuIHlvdSBsb3NlLCBkb24ndCBsb3NlIHRoZSBsZXNzb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTb21ldGhpbmcgb3BlbnMgb3VyIHdpbmdzLiBTb21ldGhpbmcgbWFrZXMgYm9yZWRvbSBhbmQgaHVydCBkaXNhcHBlYXIuIFNvbWVvbmUgZmlsbHMgdGhlIGN1cCBpbiBmcm9udCBvZiB1czogV2UgdGFzdGUgb25seSBzYWNyZWRuZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUnVtaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBhbW91bnQgb2YgaGFwcGluZXNzIHRoYXQgeW91IGhhdmUgZGVwZW5kcyBvbiB0aGUgYW1vdW50IG9mIGZyZWVkb20geW91IGhhdmUgaW4geW91ciBoZWFydC4iLAogICAgImF0dHJpYnV0aW9uIjogIlRoaWNoIE5oYXQgSGFuaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvbWUgcHVyc3VlIGhhcHBpbmVzcywgb3RoZXJzIGNyZWF0ZSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJlYWxpdHkgbGVhdmVzIGEgbG90IHRvIHRoZSBpbWFnaW5hdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gTGVubm9uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHVuaXZlcnNlIGlzIGZ1bGwgb2YgbWFnaWNhbCB0aGluZ3MsIHBhdGllbnRseSB3YWl0aW5nIGZvciBvdXIgd2l0cyB0byBncm93IHNoYXJwZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFZGVuIFBoaWxscG90dHMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgZG9uJ3QgbGlrZSBzb21ldGhpbmcsIGNoYW5nZSBpdC4gSWYgeW91IGNhbid0IGNoYW5nZSBpdCwgY2hhbmdlIHlvdXIgYXR0aXR1ZGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXlhIEFuZ2Vsb3UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBsZWF2ZSBhIHN0b25lIHVudHVybmVkLiBJdCdzIGFsd2F5cyBzb21ldGhpbmcsIHRvIGtub3cgeW91IGhhdmUgZG9uZSB0aGUgbW9zdCB5b3UgY291bGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFybGVzIERpY2tlbnMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZCByYXRoZXIgcmVncmV0IHRoZSB0aGluZ3MgdGhhdCBJIGhhdmUgZG9uZSB0aGFuIHRoZSB0aGluZ3MgdGhhdCBJIGhhdmUgbm90IGRvbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMdWNpbGxlIEJhbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDb25zaWRlciB0aGF0IG5vdCBvbmx5IGRvIG5lZ2F0aXZlIHRob3VnaHRzIGFuZCBlbW90aW9ucyBkZXN0cm95IG91ciBleHBlcmllbmNlIG9mIHBlYWNlLCB0aGV5IGFsc28gdW5kZXJtaW5lIG91ciBoZWFsdGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiU3RheSBhd2F5IGZyb20gd2hhdCBtaWdodCBoYXZlIGJlZW4gYW5kIGxvb2sgYXQgd2hhdCB3aWxsIGJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyc2hhIFBldHJpZSBTdWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBhcmUgRGl2aW5lIGVub3VnaCB0byBhc2sgYW5kIHdlIGFyZSBpbXBvcnRhbnQgZW5vdWdoIHRvIHJlY2VpdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYXluZSBEeWVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHVuaXZlcnNlIGlzIG1hZGUgb2Ygc3Rvcmllcywgbm90IGF0b21zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTXVyaWVsIFJ1a2V5c2VyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGFydCBvZiBwcm9ncmVzcyBpcyB0byBwcmVzZXJ2ZSBvcmRlciBhbWlkIGNoYW5nZSwgYW5kIHRvIHByZXNlcnZlIGNoYW5nZSBhbWlkIG9yZGVyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxmcmVkIFdoaXRlaGVhZCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtYXJrIG9mIHlvdXIgaWdub3JhbmNlIGlzIHRoZSBkZXB0aCBvZiB5b3VyIGJlbGllZiBpbiBpbmp1c3RpY2UgYW5kIHRyYWdlZHkuIFdoYXQgdGhlIGNhdGVycGlsbGFyIGNhbGxzIHRoZSBlbmQgb2YgdGhlIHdvcmxkLCB0aGUgTWFzdGVyIGNhbGxzIHRoZSBidXR0ZXJmbHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIGlzIGxpa2UgcmlkaW5nIGEgYmljeWNsZS4gVG8ga2VlcCB5b3VyIGJhbGFuY2UgeW91IG11c3Qga2VlcCBtb3ZpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgaGF2ZSB0byB0YWtlIGl0IGFzIGl0IGhhcHBlbnMsIGJ1dCB5b3Ugc2hvdWxkIHRyeSB0byBtYWtlIGl0IGhhcHBlbiB0aGUgd2F5IHlvdSB3YW50IHRvIHRha2UgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPbGQgR2VybWFuIHByb3ZlcmIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFZGlzb24gZmFpbGVkIDEwLDAwMCB0aW1lcyBiZWZvcmUgaGUgbWFkZSB0aGUgZWxlY3RyaWMgbGlnaHQuIERvIG5vdCBiZSBkaXNjb3VyYWdlZCBpZiB5b3UgZmFpbCBhIGZldyB0aW1lcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEhpbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB3ZSBjb3VsZCBsZWFybiB0byBsaWtlIG91cnNlbHZlcywgZXZlbiBhIGxpdHRsZSwgbWF5YmUgb3VyIGNydWVsdGllcyBhbmQgYW5nZXJzIG1pZ2h0IG1lbHQgYXdheS4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gU3RlaW5iZWNrIgogIH0sCiAgewogICAgInRleHQiOiAiSm95IGlzIHRoZSBiZXN0IG1ha2V1cC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFubmUgTGFtb3R0IgogIH0sCiAgewogICAgInRleHQiOiAiTGl2ZSB0aHJvdWdoIGZlZWxpbmcgYW5kIHlvdSB3aWxsIGxpdmUgdGhyb3VnaCBsb3ZlLiBGb3IgZmVlbGluZyBpcyB0aGUgbGFuZ3VhZ2Ugb2YgdGhlIHNvdWwsIGFuZCBmZWVsaW5nIGlzIHRydXRoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWF0dCBab3R0aSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNvdW50IHlvdXIgam95cyBpbnN0ZWFkIG9mIHlvdXIgd29lcy4gQ291bnQgeW91ciBmcmllbmRzIGluc3RlYWQgb2YgeW91ciBmb2VzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGZ1dHVyZSBpcyBhbiBvcGFxdWUgbWlycm9yLiBBbnlvbmUgd2hvIHRyaWVzIHRvIGxvb2sgaW50byBpdCBzZWVzIG5vdGhpbmcgYnV0IHRoZSBkaW0gb3V0bGluZXMgb2YgYW4gb2xkIGFuZCB3b3JyaWVkIGZhY2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKaW0gQmlzaG9wIgogIH0sCiAgewogICAgInRleHQiOiAiRG8gc29tZXRoaW5nIHdvbmRlcmZ1bCwgcGVvcGxlIG1heSBpbWl0YXRlIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IFNjaHdlaXR6ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIHNocmlua3Mgb3IgZXhwYW5kcyBpbiBwcm9wb3J0aW9uIHRvIG9uZSdzIGNvdXJhZ2UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbmFpcyBOaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJBY3Rpb24gbWF5IG5vdCBhbHdheXMgYnJpbmcgaGFwcGluZXNzOyBidXQgdGhlcmUgaXMgbm8gaGFwcGluZXNzIHdpdGhvdXQgYWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVuamFtaW4gRGlzcmFlbGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8gaXMgZml4ZWQgdG8gYSBzdGFyIGRvZXMgbm90IGNoYW5nZSBoaXMgbWluZC4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlb25hcmRvIGRhIFZpbmNpIgogIH0sCiAgewogICAgInRleHQiOiAiQWx3YXlzIGJlIHNtYXJ0ZXIgdGhhbiB0aGUgcGVvcGxlIHdobyBoaXJlIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlbmEgSG9ybmUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaXQgbGllcyBpbiByZWNvZ25pemluZyB0aGUgcmVzZW1ibGFuY2UgYW1vbmcgdGhpbmdzIHdoaWNoIGRpZmZlciBhbmQgdGhlIGRpZmZlcmVuY2UgYmV0d2VlbiB0aGluZ3Mgd2hpY2ggYXJlIGFsaWtlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFkYW1lIGRlIFN0YWVsIgogIH0sCiAgewogICAgInRleHQiOiAiTmF0dXJlIGlzIGEgbXV0YWJsZSBjbG91ZCB3aGljaCBpcyBhbHdheXMgYW5kIG5ldmVyIHRoZSBzYW1lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW5ldmVyIHNvbWV0aGluZyBuZWdhdGl2ZSBoYXBwZW5zIHRvIHlvdSwgdGhlcmUgaXMgYSBkZWVwIGxlc3NvbiBjb25jZWFsZWQgd2l0aGluIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWNraGFydCBUb2xsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBkYXkgaXMgYWxyZWFkeSBibGVzc2VkLCBmaW5kIHBlYWNlIHdpdGhpbiBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBjYW4ndCBzdG9wIHRoZSB3YXZlcywgYnV0IHlvdSBjYW4gbGVhcm4gdG8gc3VyZi4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvbiBLYWJhdC1aaW5uIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdGV2ZXIgd2UgZXhwZWN0IHdpdGggY29uZmlkZW5jZSBiZWNvbWVzIG91ciBvd24gc2VsZi1mdWxmaWxsaW5nIHByb3BoZWN5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnJpYW4gVHJhY3kiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIHNob3J0IHNheWluZyBvZnQgY29udGFpbnMgbXVjaCB3aXNkb20uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTb3Bob2NsZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDaGVyaXNoIHlvdXIgdmlzaW9ucyBhbmQgeW91ciBkcmVhbXMgYXMgdGhleSBhcmUgdGhlIGNoaWxkcmVuIG9mIHlvdXIgc291bCwgdGhlIGJsdWVwcmludHMgb2YgeW91ciB1bHRpbWF0ZSBhY2hpZXZlbWVudHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiVHJlYXQgcGVvcGxlIGFzIGlmIHRoZXkgd2VyZSB3aGF0IHRoZXkgb3VnaHQgdG8gYmUgYW5kIHlvdSBoZWxwIHRoZW0gdG8gYmVjb21lIHdoYXQgdGhleSBhcmUgY2FwYWJsZSBvZiBiZWluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubiBXb2xmZ2FuZyB2b24gR29ldGhlIgogIH0sCiAgewogICAgInRleHQiOiAiUGFyZW50cyBjYW4gb25seSBnaXZlIGdvb2QgYWR2aWNlIG9yIHB1dCB0aGVtIG9uIHRoZSByaWdodCBwYXRocywgYnV0IHRoZSBmaW5hbCBmb3JtaW5nIG9mIGEgcGVyc29ucyBjaGFyYWN0ZXIgbGllcyBpbiB0aGVpciBvd24gaGFuZHMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbm5lIEZyYW5rIgogIH0sCiAgewogICAgInRleHQiOiAiVGhvc2UgdGhhdCBrbm93LCBkby4gVGhvc2UgdGhhdCB1bmRlcnN0YW5kLCB0ZWFjaC4iLAogICAgImF0dHJpYnV0aW9uIjogIkFyaXN0b3RsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldyaW5rbGVzIHNob3VsZCBtZXJlbHkgaW5kaWNhdGUgd2hlcmUgc21pbGVzIGhhdmUgYmVlbi4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmsgVHdhaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJOb3RoaW5nIGlzIGEgd2FzdGUgb2YgdGltZSBpZiB5b3UgdXNlIHRoZSBleHBlcmllbmNlIHdpc2VseS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJvZGluIgogIH0sCiAgewogICAgInRleHQiOiAiUG9zaXRpdmUgdGhpbmtpbmcgd2lsbCBsZXQgeW91IGRvIGV2ZXJ5dGhpbmcgYmV0dGVyIHRoYW4gbmVnYXRpdmUgdGhpbmtpbmcgd2lsbC4iLAogICAgImF0dHJpYnV0aW9uIjogIlppZyBaaWdsYXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaG9zbyBsb3ZlcywgYmVsaWV2ZXMgdGhlIGltcG9zc2libGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFbGl6YWJldGggQnJvd25pbmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBlbnN1cmUgZ29vZCBoZWFsdGg6IGVhdCBsaWdodGx5LCBicmVhdGhlIGRlZXBseSwgbGl2ZSBtb2RlcmF0ZWx5LCBjdWx0aXZhdGUgY2hlZXJmdWxuZXNzLCBhbmQgbWFpbnRhaW4gYW4gaW50ZXJlc3QgaW4gbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gTG9uZGVuIgogIH0sCiAgewogICAgInRleHQiOiAiQmVsaWVmIGNvbnNpc3RzIGluIGFjY2VwdGluZyB0aGUgYWZmaXJtYXRpb25zIG9mIHRoZSBzb3VsOyBVbmJlbGllZiwgaW4gZGVueWluZyB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBoYXZlIHRvIGJlbGlldmUgaW4geW91cnNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTdW4gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiV2hpbGUgd2Ugc3RvcCB0byB0aGluaywgd2Ugb2Z0ZW4gbWlzcyBvdXIgb3Bwb3J0dW5pdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQdWJsaWxpdXMgU3lydXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaGF0IGxpZXMgYmVoaW5kIHVzIGFuZCB3aGF0IGxpZXMgYmVmb3JlIHVzIGFyZSBzbWFsbCBtYXR0ZXJzIGNvbXBhcmVkIHRvIHdoYXQgbGllcyB3aXRoaW4gdXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPbGl2ZXIgSG9sbWVzIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGNhbiBhZG9wdCB0aGUgYXR0aXR1ZGUgdGhlcmUgaXMgbm90aGluZyB5b3UgY2FuIGRvLCBvciB5b3UgY2FuIHNlZSB0aGUgY2hhbGxlbmdlIGFzIHlvdXIgY2FsbCB0byBhY3Rpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXRoZXJpbmUgUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEaWZmaWN1bHRpZXMgYXJlIG1lYW50IHRvIHJvdXNlLCBub3QgZGlzY291cmFnZS4gVGhlIGh1bWFuIHNwaXJpdCBpcyB0byBncm93IHN0cm9uZyBieSBjb25mbGljdC4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gQ2hhbm5pbmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZ3JlYXRlc3QgZGlzY292ZXJ5IG9mIG91ciBnZW5lcmF0aW9uIGlzIHRoYXQgaHVtYW4gYmVpbmdzIGNhbiBhbHRlciB0aGVpciBsaXZlcyBieSBhbHRlcmluZyB0aGVpciBhdHRpdHVkZXMgb2YgbWluZC4gQXMgeW91IHRoaW5rLCBzbyBzaGFsbCB5b3UgYmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaWxsaWFtIEphbWVzIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgbXVzdCBub3QgYWxsb3cgb3Vyc2VsdmVzIHRvIGJlY29tZSBsaWtlIHRoZSBzeXN0ZW0gd2Ugb3Bwb3NlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmlzaG9wIERlc21vbmQgVHV0dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5dGhpbmcgeW91IGNhbiBpbWFnaW5lIGlzIHJlYWwuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQYWJsbyBQaWNhc3NvIgogIH0sCiAgewogICAgInRleHQiOiAiT25lIHdobyBnYWlucyBzdHJlbmd0aCBieSBvdmVyY29taW5nIG9ic3RhY2xlcyBwb3NzZXNzZXMgdGhlIG9ubHkgc3RyZW5ndGggd2hpY2ggY2FuIG92ZXJjb21lIGFkdmVyc2l0eS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBTY2h3ZWl0emVyIgogIH0sCiAgewogICAgInRleHQiOiAiTm8gb25lIGhhcyBldmVyIGJlY29tZSBwb29yIGJ5IGdpdmluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFubmUgRnJhbmsiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIG1hbiBpcyBub3Qgd2hlcmUgaGUgbGl2ZXMgYnV0IHdoZXJlIGhlIGxvdmVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiQmUgYSBnb29kIGxpc3RlbmVyLiBZb3VyIGVhcnMgd2lsbCBuZXZlciBnZXQgeW91IGluIHRyb3VibGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGcmFuayBUeWdlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB0cnVlIHdheSB0byByZW5kZXIgb3Vyc2VsdmVzIGhhcHB5IGlzIHRvIGxvdmUgb3VyIHdvcmsgYW5kIGZpbmQgaW4gaXQgb3VyIHBsZWFzdXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRnJhbmNvaXNlIGRlIE1vdHRldmlsbGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGhhdmUgbmV2ZXIgYmVlbiBodXJ0IGJ5IGFueXRoaW5nIEkgZGlkbid0IHNheS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNhbHZpbiBDb29saWRnZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5ldmVyIGJlbmQgeW91ciBoZWFkLiBBbHdheXMgaG9sZCBpdCBoaWdoLiBMb29rIHRoZSB3b3JsZCByaWdodCBpbiB0aGUgZXllLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVsZW4gS2VsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHRydXRoIGlzIGFsd2F5cyBleGNpdGluZy4gU3BlYWsgaXQsIHRoZW4uIExpZmUgaXMgZHVsbCB3aXRob3V0IGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGVhcmwgQnVjayIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5dGhpbmcgeW91IGFyZSBhZ2FpbnN0IHdlYWtlbnMgeW91LiBFdmVyeXRoaW5nIHlvdSBhcmUgZm9yIGVtcG93ZXJzIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGRvIG5vdCBiZWxpZXZlIGluIGEgZmF0ZSB0aGF0IGZhbGxzIG9uIG1lbiBob3dldmVyIHRoZXkgYWN0OyBidXQgSSBkbyBiZWxpZXZlIGluIGEgZmF0ZSB0aGF0IGZhbGxzIG9uIHRoZW0gdW5sZXNzIHRoZXkgYWN0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgaGF2ZSBjb21taXR0ZWQgdGhlIEdvbGRlbiBSdWxlIHRvIG1lbW9yeTsgbGV0IHVzIG5vdyBjb21taXQgaXQgdG8gbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVkd2luIE1hcmtoYW0iCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeXRoaW5nIHRoYXQgaXJyaXRhdGVzIHVzIGFib3V0IG90aGVycyBjYW4gbGVhZCB1cyB0byBhIGJldHRlciB1bmRlcnN0YW5kaW5nIG9mIG91cnNlbHZlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNhcmwgSnVuZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkpveSBpcyB3aGF0IGhhcHBlbnMgdG8gdXMgd2hlbiB3ZSBhbGxvdyBvdXJzZWx2ZXMgdG8gcmVjb2duaXplIGhvdyBnb29kIHRoaW5ncyByZWFsbHkgYXJlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyaWFubmUgV2lsbGlhbXNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4geW91IHNlZSBhIG1hbiBvZiB3b3J0aCwgdGhpbmsgb2YgaG93IHlvdSBtYXkgZW11bGF0ZSBoaW0uIFdoZW4geW91IHNlZSBvbmUgd2hvIGlzIHVud29ydGh5LCBleGFtaW5lIHlvdXJzZWxmLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ29uZnVjaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiSW1hZ2luYXRpb24gZGlzcG9zZXMgb2YgZXZlcnl0aGluZzsgaXQgY3JlYXRlcyBiZWF1dHksIGp1c3RpY2UsIGFuZCBoYXBwaW5lc3MsIHdoaWNoIGFyZSBldmVyeXRoaW5nIGluIHRoaXMgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCbGFpc2UgUGFzY2FsIgogIH0sCiAgewogICAgInRleHQiOiAiRG8gbm90IGJlIGVtYmFycmFzc2VkIGJ5IHlvdXIgbWlzdGFrZXMuIE5vdGhpbmcgY2FuIHRlYWNoIHVzIGJldHRlciB0aGFuIG91ciB1bmRlcnN0YW5kaW5nIG9mIHRoZW0uIFRoaXMgaXMgb25lIG9mIHRoZSBiZXN0IHdheXMgb2Ygc2VsZi1lZHVjYXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaG9tYXMgQ2FybHlsZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9ubHkgdGhvc2Ugd2hvIGRhcmUgdG8gZmFpbCBncmVhdGx5IGNhbiBldmVyIGFjaGlldmUgZ3JlYXRseS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJvYmVydCBLZW5uZWR5IgogIH0sCiAgewogICAgInRleHQiOiAiRG9uJ3QgYmUgcHVzaGVkIGJ5IHlvdXIgcHJvYmxlbXM7IGJlIGxlZCBieSB5b3VyIGRyZWFtcy4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRpbWUgeW91IGVuam95IHdhc3RpbmcsIHdhcyBub3Qgd2FzdGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBMZW5ub24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgaGF2ZSBlbmVtaWVzPyBHb29kLiBUaGF0IG1lYW5zIHlvdSd2ZSBzdG9vZCB1cCBmb3Igc29tZXRoaW5nLCBzb21ldGltZSBpbiB5b3VyIGxpZmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaW5zdG9uIENodXJjaGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoYXQgd2UgdGhpbmsgZGV0ZXJtaW5lcyB3aGF0IGhhcHBlbnMgdG8gdXMsIHNvIGlmIHdlIHdhbnQgdG8gY2hhbmdlIG91ciBsaXZlcywgd2UgbmVlZCB0byBzdHJldGNoIG91ciBtaW5kcy4iLAogICAgImF0dHJpYnV0aW9uIjogIldheW5lIER5ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBtaXNzIGFsbCB0aGUgYmVhdXRpZnVsIGNvbG9ycyBvZiB0aGUgcmFpbmJvdyBsb29raW5nIGZvciB0aGF0IHBvdCBvZiBnb2xkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiVGltZSBzdGF5cyBsb25nIGVub3VnaCBmb3IgYW55b25lIHdobyB3aWxsIHVzZSBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkxlb25hcmRvIGRhIFZpbmNpIgogIH0sCiAgewogICAgInRleHQiOiAiTXkgYWR2aWNlIHRvIHlvdSBpcyBub3QgdG8gaW5xdWlyZSB3aHkgb3Igd2hpdGhlciwgYnV0IGp1c3QgZW5qb3kgeW91ciBpY2UgY3JlYW0gd2hpbGUgaXRzIG9uIHlvdXIgcGxhdGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaG9ybnRvbiBXaWxkZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGdlbSBjYW5ub3QgYmUgcG9saXNoZWQgd2l0aG91dCBmcmljdGlvbiwgbm9yIGEgbWFuIHBlcmZlY3RlZCB3aXRob3V0IHRyaWFscy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoaW5lc2UgcHJvdmVyYiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGJlIGdyZWF0IGlzIHRvIGJlIG1pc3VuZGVyc3Rvb2QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpcyBhIGxlYXJuaW5nIGV4cGVyaWVuY2UsIG9ubHkgaWYgeW91IGxlYXJuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiWW9naSBCZXJyYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBjYW5ub3QgYmUgc2lsZW50IGJlIGJyaWxsaWFudCBhbmQgdGhvdWdodGZ1bC4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG1vc3QgZGFuZ2Vyb3VzIHdheSB0byBsb3NlIHRpbWUgaXMgbm90IHRvIHNwZW5kIGl0IGhhdmluZyBmdW4sIGJ1dCB0byBzcGVuZCBpdCBkb2luZyBmYWtlIHdvcmsuIFdoZW4geW91IHNwZW5kIHRpbWUgaGF2aW5nIGZ1biwgeW91IGtub3cgeW91J3JlIGJlaW5nIHNlbGYtaW5kdWxnZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGF1bCBHcmFoYW0iCiAgfSwKICB7CiAgICAidGV4dCI6ICJIZSB3aG8gZGVsaWJlcmF0ZXMgZnVsbHkgYmVmb3JlIHRha2luZyBhIHN0ZXAgd2lsbCBzcGVuZCBoaXMgZW50aXJlIGxpZmUgb24gb25lIGxlZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoaW5lc2UgcHJvdmVyYiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkZvY3VzaW5nIHlvdXIgbGlmZSBzb2xlbHkgb24gbWFraW5nIGEgYnVjayBzaG93cyBhIHBvdmVydHkgb2YgYW1iaXRpb24uIEl0IGFza3MgdG9vIGxpdHRsZSBvZiB5b3Vyc2VsZi4gQW5kIGl0IHdpbGwgbGVhdmUgeW91IHVuZnVsZmlsbGVkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmFyYWNrIE9iYW1hIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG9ubHkgd2F5IHRvIGhhdmUgYSBmcmllbmQgaXMgdG8gYmUgb25lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmFscGggRW1lcnNvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk9uY2Ugd2UgYWNjZXB0IG91ciBsaW1pdHMsIHdlIGdvIGJleW9uZCB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxiZXJ0IEVpbnN0ZWluIgogIH0sCiAgewogICAgInRleHQiOiAiTWF5IG91ciBoZWFydHMgZ2FyZGVuIG9mIGF3YWtlbmluZyBibG9vbSB3aXRoIGh1bmRyZWRzIG9mIGZsb3dlcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaGljaCBOaGF0IEhhbmgiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbGwgb3VyIHRhbGVudHMgaW5jcmVhc2UgaW4gdGhlIHVzaW5nLCBhbmQgdGhlIGV2ZXJ5IGZhY3VsdHksIGJvdGggZ29vZCBhbmQgYmFkLCBzdHJlbmd0aGVuIGJ5IGV4ZXJjaXNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQW5uZSBCcm9udGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZ3JlYXRlc3QgZ29vZCB5b3UgY2FuIGRvIGZvciBhbm90aGVyIGlzIG5vdCBqdXN0IHNoYXJlIHlvdXIgcmljaGVzLCBidXQgcmV2ZWFsIHRvIHRoZW0gdGhlaXIgb3duLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVuamFtaW4gRGlzcmFlbGkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaXNoZXMgY2FuIGJlIHlvdXIgYmVzdCBhdmVudWUgb2YgZ2V0dGluZyB3aGF0IHlvdSB3YW50IHdoZW4geW91IHR1cm4gd2lzaGVzIGludG8gYWN0aW9uLiBBY3Rpb24gbW92ZXMgeW91ciB3aXNoIHRvIHRoZSBmb3JlZnJvbnQgZnJvbSB0aG91Z2h0IHRvIHJlYWxpdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBQdWxzaWZlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBwcmljZSBvZiBncmVhdG5lc3MgaXMgcmVzcG9uc2liaWxpdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXaW5zdG9uIENodXJjaGlsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzZWxmIGlzIG5vdCBzb21ldGhpbmcgcmVhZHktbWFkZSwgYnV0IHNvbWV0aGluZyBpbiBjb250aW51b3VzIGZvcm1hdGlvbiB0aHJvdWdoIGNob2ljZSBvZiBhY3Rpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIERld2V5IgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCB5b3UgYXJlIGlzIHdoYXQgeW91IGhhdmUgYmVlbi4gV2hhdCB5b3UnbGwgYmUgaXMgd2hhdCB5b3UgZG8gbm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiUmFpbmJvd3MgYXBvbG9naXplIGZvciBhbmdyeSBza2llcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlN5bHZpYSBWb2lyb2wiCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZWdpbiBhdCBvbmNlIHRvIGxpdmUgYW5kIGNvdW50IGVhY2ggc2VwYXJhdGUgZGF5IGFzIGEgc2VwYXJhdGUgbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlNlbmVjYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvIG5vdCBleHBlY3QgdGhlIHdvcmxkIHRvIGxvb2sgYnJpZ2h0LCBpZiB5b3UgaGFiaXR1YWxseSB3ZWFyIGdyYXktYnJvd24gZ2xhc3Nlcy4iLAogICAgImF0dHJpYnV0aW9uIjogIlRvbWFzIEVsaW90IgogIH0sCiAgewogICAgInRleHQiOiAiVGhpbmsgbGlrZSBhIG1hbiBvZiBhY3Rpb247IGFjdCBsaWtlIGEgbWFuIG9mIHRob3VnaHQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yaSBMLiBCZXJnc29uIgogIH0sCiAgewogICAgInRleHQiOiAiQSByZWFsbHkgZ3JlYXQgdGFsZW50IGZpbmRzIGl0cyBoYXBwaW5lc3MgaW4gZXhlY3V0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9oYW5uIFdvbGZnYW5nIHZvbiBHb2V0aGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJJ20gYSBncmVhdCBiZWxpZXZlciBpbiBsdWNrIGFuZCBJIGZpbmQgdGhlIGhhcmRlciBJIHdvcmssIHRoZSBtb3JlIEkgaGF2ZSBvZiBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIlRob21hcyBKZWZmZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJSZXNwb25zaWJpbGl0eSBpcyBub3QgaW5oZXJpdGVkLCBpdCBpcyBhIGNob2ljZSB0aGF0IGV2ZXJ5b25lIG5lZWRzIHRvIG1ha2UgYXQgc29tZSBwb2ludCBpbiB0aGVpciBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXZSBtdXN0IG5vdCBzYXkgZXZlcnkgbWlzdGFrZSBpcyBhIGZvb2xpc2ggb25lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2ljZXJvIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgYXJlIHR3byB3YXlzIHRvIHNsaWRlIGVhc2lseSB0aHJvdWdoIGxpZmU6IHRvIGJlbGlldmUgZXZlcnl0aGluZyBvciB0byBkb3VidCBldmVyeXRoaW5nOyBib3RoIHdheXMgc2F2ZSB1cyBmcm9tIHRoaW5raW5nLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxmcmVkIEtvcnp5YnNraSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvIHlvdSB3YW50IHRvIGtub3cgd2hvIHlvdSBhcmU/IERvbid0IGFzay4gQWN0ISBBY3Rpb24gd2lsbCBkZWxpbmVhdGUgYW5kIGRlZmluZSB5b3UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJUaG9tYXMgSmVmZmVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiTmV2ZXIgbGV0IGxhY2sgb2YgbW9uZXkgaW50ZXJmZXJlIHdpdGggaGF2aW5nIGZ1bi4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRha2UgcmVzdDsgYSBmaWVsZCB0aGF0IGhhcyByZXN0ZWQgZ2l2ZXMgYSBib3VudGlmdWwgY3JvcC4iLAogICAgImF0dHJpYnV0aW9uIjogIk92aWQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZWl0aGVyIGEgbG9mdHkgZGVncmVlIG9mIGludGVsbGlnZW5jZSBub3IgaW1hZ2luYXRpb24gbm9yIGJvdGggdG9nZXRoZXIgZ28gdG8gdGhlIG1ha2luZyBvZiBnZW5pdXMuIExvdmUsIGxvdmUsIGxvdmUsIHRoYXQgaXMgdGhlIHNvdWwgb2YgZ2VuaXVzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV29sZmdhbmcgQW1hZGV1cyBNb3phcnQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJDaGFyYWN0ZXIgZGV2ZWxvcHMgaXRzZWxmIGluIHRoZSBzdHJlYW0gb2YgbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaGFubiBXb2xmZ2FuZyB2b24gR29ldGhlIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGpvdXJuZXkgb2YgYSB0aG91c2FuZCBtaWxlcyBiZWdpbnMgd2l0aCBvbmUgc3RlcC4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UncmUgd2Fsa2luZyBkb3duIHRoZSByaWdodCBwYXRoIGFuZCB5b3UncmUgd2lsbGluZyB0byBrZWVwIHdhbGtpbmcsIGV2ZW50dWFsbHkgeW91J2xsIG1ha2UgcHJvZ3Jlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCYXJhY2sgT2JhbWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaXRob3V0IHNvbWUgZ29hbHMgYW5kIHNvbWUgZWZmb3J0cyB0byByZWFjaCBpdCwgbm8gbWFuIGNhbiBsaXZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSm9obiBEZXdleSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBDcmVhdG9yIGhhcyBub3QgZ2l2ZW4geW91IGEgbG9uZ2luZyB0byBkbyB0aGF0IHdoaWNoIHlvdSBoYXZlIG5vIGFiaWxpdHkgdG8gZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPcmlzb24gTWFyZGVuIgogIH0sCiAgewogICAgInRleHQiOiAiU29tZSBwZW9wbGUgYXJlIGFsd2F5cyBncnVtYmxpbmcgYmVjYXVzZSByb3NlcyBoYXZlIHRob3JuczsgSSBhbSB0aGFua2Z1bCB0aGF0IHRob3JucyBoYXZlIHJvc2VzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxwaG9uc2UgS2FyciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkRvbid0IHRhbGsgYWJvdXQgd2hhdCB5b3UgaGF2ZSBkb25lIG9yIHdoYXQgeW91IGFyZSBnb2luZyB0byBkby4iLAogICAgImF0dHJpYnV0aW9uIjogIlRob21hcyBKZWZmZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3Ugb25seSBsb3NlIHdoYXQgeW91IGNsaW5nIHRvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHdvcmxkIGlzIGJ1dCBhIGNhbnZhcyB0byB0aGUgaW1hZ2luYXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yeSBUaG9yZWF1IgogIH0sCiAgewogICAgInRleHQiOiAiT25lIGZhY2VzIHRoZSBmdXR1cmUgd2l0aCBvbmVzIHBhc3QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQZWFybCBCdWNrIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCB3ZSBhY2hpZXZlIGlud2FyZGx5IHdpbGwgY2hhbmdlIG91dGVyIHJlYWxpdHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJQbHV0YXJjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIG5vdCBhZnJhaWQgb2YgZ3JlYXRuZXNzOiBzb21lIGFyZSBib3JuIGdyZWF0LCBzb21lIGFjaGlldmUgZ3JlYXRuZXNzLCBhbmQgc29tZSBoYXZlIGdyZWF0bmVzcyB0aHJ1c3QgdXBvbiB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBTaGFrZXNwZWFyZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB0aG91Z2h0cyB3ZSBjaG9vc2UgdG8gdGhpbmsgYXJlIHRoZSB0b29scyB3ZSB1c2UgdG8gcGFpbnQgdGhlIGNhbnZhcyBvZiBvdXIgbGl2ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMb3Vpc2UgSGF5IgogIH0sCiAgewogICAgInRleHQiOiAiSSBmaW5kIGhvcGUgaW4gdGhlIGRhcmtlc3Qgb2YgZGF5cywgYW5kIGZvY3VzIGluIHRoZSBicmlnaHRlc3QuIEkgZG8gbm90IGp1ZGdlIHRoZSB1bml2ZXJzZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRhbGFpIExhbWEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciBpZGVhbGl6ZSBvdGhlcnMuIFRoZXkgd2lsbCBuZXZlciBsaXZlIHVwIHRvIHlvdXIgZXhwZWN0YXRpb25zLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGVvIEJ1c2NhZ2xpYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgc21pbGUgaXMgYSBsaWdodCBpbiB0aGUgd2luZG93IG9mIHlvdXIgZmFjZSB0byBzaG93IHlvdXIgaGVhcnQgaXMgYXQgaG9tZS4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1hbiBpcyBub3Qgc3VtIG9mIHdoYXQgaGUgaGFzIGFscmVhZHksIGJ1dCByYXRoZXIgdGhlIHN1bSBvZiB3aGF0IGhlIGRvZXMgbm90IHlldCBoYXZlLCBvZiB3aGF0IGhlIGNvdWxkIGhhdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKZWFuLVBhdWwgU2FydHJlIgogIH0sCiAgewogICAgInRleHQiOiAiVGhvc2Ugd2hvIGNhbm5vdCBsZWFybiBmcm9tIGhpc3RvcnkgYXJlIGRvb21lZCB0byByZXBlYXQgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHZW9yZ2UgU2FudGF5YW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJLaW5kIHdvcmRzIGNhbiBiZSBzaG9ydCBhbmQgZWFzeSB0byBzcGVhaywgYnV0IHRoZWlyIGVjaG9lcyBhcmUgdHJ1bHkgZW5kbGVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1vdGhlciBUZXJlc2EiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGluayBhcyBhIHdpc2UgbWFuIGJ1dCBjb21tdW5pY2F0ZSBpbiB0aGUgbGFuZ3VhZ2Ugb2YgdGhlIHBlb3BsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbGxpYW0gWWVhdHMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTYXlpbmcgdGhhbmsgeW91IGlzIG1vcmUgdGhhbiBnb29kIG1hbm5lcnMuIEl0IGlzIGdvb2Qgc3Bpcml0dWFsaXR5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWxmcmVkIFBhaW50ZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFbmpveSB0aGUgbGl0dGxlIHRoaW5ncywgZm9yIG9uZSBkYXkgeW91IG1heSBsb29rIGJhY2sgYW5kIHJlYWxpemUgdGhleSB3ZXJlIHRoZSBiaWcgdGhpbmdzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IEJyYXVsdCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhvd2V2ZXIgbWFueSBob2x5IHdvcmRzIHlvdSByZWFkLCBob3dldmVyIG1hbnkgeW91IHNwZWFrLCB3aGF0IGdvb2Qgd2lsbCB0aGV5IGRvIHlvdSBpZiB5b3UgZG8gbm90IGFjdCBvbiB1cG9uIHRoZW0/IiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3UgY2FuIGRvIGl0IGlmIHlvdSBiZWxpZXZlIHlvdSBjYW4hIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG9ubHkgcmVhbCB2YWx1YWJsZSB0aGluZyBpcyBpbnR1aXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgRWluc3RlaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJCZSB3aGF0IHlvdSBhcmUuIFRoaXMgaXMgdGhlIGZpcnN0IHN0ZXAgdG93YXJkIGJlY29taW5nIGJldHRlciB0aGFuIHlvdSBhcmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKdWxpdXMgQ2hhcmxlcyBIYXJlIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGZpcnN0IHN0ZXAgdG8gZ2V0dGluZyB0aGUgdGhpbmdzIHlvdSB3YW50IG91dCBvZiBsaWZlIGlzIHRoaXM6IGRlY2lkZSB3aGF0IHlvdSB3YW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVuIFN0ZWluIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIG9ubHkgdGhpbmcgdG8gZG8gd2l0aCBnb29kIGFkdmljZSBpcyB0byBwYXNzIGl0IG9uLiBJdCBpcyBuZXZlciBvZiBhbnkgdXNlIHRvIG9uZXNlbGYuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPc2NhciBXaWxkZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFsbCBsaXZlIHdpdGggdGhlIG9iamVjdGl2ZSBvZiBiZWluZyBoYXBweTsgb3VyIGxpdmVzIGFyZSBhbGwgZGlmZmVyZW50IGFuZCB5ZXQgdGhlIHNhbWUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbm5lIEZyYW5rIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGFyZSBzcGVjaWFsLCB5b3UgYXJlIHVuaXF1ZSwgeW91IGFyZSB0aGUgYmVzdCEiLAogICAgImF0dHJpYnV0aW9uIjogIkNhdGh5IFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiQ29tcGxhaW5pbmcgZG9lc24ndCBjaGFuZ2UgYSB0aGluZyBvbmx5IHRha2luZyBhY3Rpb24gZG9lcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiSSBoYXZlIGJlZW4gaW1wcmVzc2VkIHdpdGggdGhlIHVyZ2VuY3kgb2YgZG9pbmcuIEtub3dpbmcgaXMgbm90IGVub3VnaDsgd2UgbXVzdCBhcHBseS4gQmVpbmcgd2lsbGluZyBpcyBub3QgZW5vdWdoOyB3ZSBtdXN0IGRvLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGVvbmFyZG8gZGEgVmluY2kiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEaXZpZGUgZWFjaCBkaWZmaWN1bHR5IGludG8gYXMgbWFueSBwYXJ0cyBhcyBpcyBmZWFzaWJsZSBhbmQgbmVjZXNzYXJ5IHRvIHJlc29sdmUgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSZW5lIERlc2NhcnRlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBnZXQgcGVhY2Ugb2YgbWluZCBub3QgYnkgdGhpbmtpbmcgYWJvdXQgaXQgb3IgaW1hZ2luaW5nIGl0LCBidXQgYnkgcXVpZXRlbmluZyBhbmQgcmVsYXhpbmcgdGhlIHJlc3RsZXNzIG1pbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSZW1leiBTYXNzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJIYXBwaW5lc3MgY2Fubm90IGJlIHRyYXZlbGxlZCB0bywgb3duZWQsIGVhcm5lZCwgd29ybiBvciBjb25zdW1lZC4gSGFwcGluZXNzIGlzIHRoZSBzcGlyaXR1YWwgZXhwZXJpZW5jZSBvZiBsaXZpbmcgZXZlcnkgbWludXRlIHdpdGggbG92ZSwgZ3JhY2UgYW5kIGdyYXRpdHVkZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkRlbmlzIFdhaXRsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbiB0aGUgZGVwdGggb2Ygd2ludGVyLCBJIGZpbmFsbHkgbGVhcm5lZCB0aGF0IHRoZXJlIHdhcyB3aXRoaW4gbWUgYW4gaW52aW5jaWJsZSBzdW1tZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbGJlcnQgQ2FtdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGluZ3MgZG8gbm90IGNoYW5nZSwgd2UgY2hhbmdlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgVGhvcmVhdSIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1lZGl0YXRpb24gYnJpbmdzIHdpc2RvbTsgbGFjayBvZiBtZWRpYXRpb24gbGVhdmVzIGlnbm9yYW5jZS4gS25vdyB3ZWxsIHdoYXQgbGVhZHMgeW91IGZvcndhcmQgYW5kIHdoYXQgaG9sZCB5b3UgYmFjaywgYW5kIGNob29zZSB0aGUgcGF0aCB0aGF0IGxlYWRzIHRvIHdpc2RvbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdyYXRpdHVkZSBtYWtlcyBzZW5zZSBvZiBvdXIgcGFzdCwgYnJpbmdzIHBlYWNlIGZvciB0b2RheSwgYW5kIGNyZWF0ZXMgYSB2aXNpb24gZm9yIHRvbW9ycm93LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWVsb2R5IEJlYXR0aWUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUcnVzdGluZyBvdXIgaW50dWl0aW9uIG9mdGVuIHNhdmVzIHVzIGZyb20gZGlzYXN0ZXIuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbm5lIFdpbHNvbiBTY2hhZWYiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGRvbid0IGJlbGlldmUgaW4gZmFpbHVyZS4gSXQgaXMgbm90IGZhaWx1cmUgaWYgeW91IGVuam95ZWQgdGhlIHByb2Nlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJPcHJhaCBXaW5mcmV5IgogIH0sCiAgewogICAgInRleHQiOiAiTGV0IHVzIHJldmVyZSwgbGV0IHVzIHdvcnNoaXAsIGJ1dCBlcmVjdCBhbmQgb3Blbi1leWVkLCB0aGUgaGlnaGVzdCwgbm90IHRoZSBsb3dlc3Q7IHRoZSBmdXR1cmUsIG5vdCB0aGUgcGFzdCEiLAogICAgImF0dHJpYnV0aW9uIjogIkNoYXJsb3R0ZSBHaWxtYW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJNZW4gaW4gZ2VuZXJhbCBqdWRnZSBtb3JlIGZyb20gYXBwZWFyYW5jZXMgdGhhbiBmcm9tIHJlYWxpdHkuIEFsbCBtZW4gaGF2ZSBleWVzLCBidXQgZmV3IGhhdmUgdGhlIGdpZnQgb2YgcGVuZXRyYXRpb24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOaWNjb2xvIE1hY2hpYXZlbGxpIgogIH0sCiAgewogICAgInRleHQiOiAiQSBtYW4gaXMgZ3JlYXQgYnkgZGVlZHMsIG5vdCBieSBiaXJ0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoYW5ha3lhIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIGhhcyBoZWFsdGggaGFzIGhvcGUsIGFuZCBoZSB3aG8gaGFzIGhvcGUgaGFzIGV2ZXJ5dGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNeSBhZHZpY2UgdG8geW91IGlzIG5vdCB0byBpbnF1aXJlIHdoeSBvciB3aGl0aGVyLCBidXQganVzdCBlbmpveSB5b3VyIGljZSBjcmVhbSB3aGlsZSBpdHMgb24geW91ciBwbGF0ZSAtIHRoYXQncyBteSBwaGlsb3NvcGh5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvcm50b24gV2lsZGVyIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGltcG9ydGFudCB0aGluZyBpcyB0aGlzOiB0byBiZSBhYmxlIGF0IGFueSBtb21lbnQgdG8gc2FjcmlmaWNlIHdoYXQgd2UgYXJlIGZvciB3aGF0IHdlIGNvdWxkIGJlY29tZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNoYXJsZXMgRHVib2lzIgogIH0sCiAgewogICAgInRleHQiOiAiSWYgeW91IGFyZSBnb2luZyB0byBhY2hpZXZlIGV4Y2VsbGVuY2UgaW4gYmlnIHRoaW5ncywgeW91IGRldmVsb3AgdGhlIGhhYml0IGluIGxpdHRsZSBtYXR0ZXJzLiBFeGNlbGxlbmNlIGlzIG5vdCBhbiBleGNlcHRpb24sIGl0IGlzIGEgcHJldmFpbGluZyBhdHRpdHVkZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbGluIFBvd2VsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5ldmVyIHByb21pc2UgbW9yZSB0aGFuIHlvdSBjYW4gcGVyZm9ybS4iLAogICAgImF0dHJpYnV0aW9uIjogIlB1YmxpbGl1cyBTeXJ1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBhcmUgbmV2ZXIgZ2l2ZW4gYSB3aXNoIHdpdGhvdXQgYWxzbyBiZWluZyBnaXZlbiB0aGUgcG93ZXIgdG8gbWFrZSBpdCBjb21lIHRydWUuIFlvdSBtYXkgaGF2ZSB0byB3b3JrIGZvciBpdCwgaG93ZXZlci4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpY2hhcmQgQmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBlYWNlIG9mIG1pbmQgaXMgbm90IHRoZSBhYnNlbmNlIG9mIGNvbmZsaWN0IGZyb20gbGlmZSwgYnV0IHRoZSBhYmlsaXR5IHRvIGNvcGUgd2l0aCBpdC4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdXIgYmlnIG9wcG9ydHVuaXR5IG1heSBiZSByaWdodCB3aGVyZSB5b3UgYXJlIG5vdy4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEhpbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJXaHkgd29ycnkgYWJvdXQgdG9tb3Jyb3csIHdoZW4gdG9kYXkgaXMgYWxsIHdlIGhhdmU/IiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbnN0ZWFkIG9mIHNheWluZyB0aGF0IG1hbiBpcyB0aGUgY3JlYXR1cmUgb2YgY2lyY3Vtc3RhbmNlLCBpdCB3b3VsZCBiZSBuZWFyZXIgdGhlIG1hcmsgdG8gc2F5IHRoYXQgbWFuIGlzIHRoZSBhcmNoaXRlY3Qgb2YgY2lyY3Vtc3RhbmNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIENhcmx5bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBIGhvdXNlIGRpdmlkZWQgYWdhaW5zdCBpdHNlbGYgY2Fubm90IHN0YW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWJyYWhhbSBMaW5jb2xuIgogIH0sCiAgewogICAgInRleHQiOiAiVG8gc3R1ZHkgYW5kIG5vdCB0aGluayBpcyBhIHdhc3RlLiBUbyB0aGluayBhbmQgbm90IHN0dWR5IGlzIGRhbmdlcm91cy4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJ5IG5hdHVyZSBtYW4gaGF0ZXMgY2hhbmdlOyBzZWxkb20gd2lsbCBoZSBxdWl0IGhpcyBvbGQgaG9tZSB0aWxsIGl0IGhhcyBhY3R1YWxseSBmYWxsZW4gYXJvdW5kIGhpcyBlYXJzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIENhcmx5bGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJZiB5b3UgbG92ZSBzb21lb25lLCBzZXQgdGhlbSBmcmVlLiBJZiB0aGV5IGNvbWUgYmFjayB0aGV5J3JlIHlvdXJzOyBpZiB0aGV5IGRvbid0IHRoZXkgbmV2ZXIgd2VyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlJpY2hhcmQgQmFjaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIG5vdCB0aGUgbWlzdGFrZSB0aGF0IGhhcyB0aGUgbW9zdCBwb3dlciwgaW5zdGVhZCwgaXQgaXMgbGVhcm5pbmcgZnJvbSB0aGUgbWlzdGFrZSB0byBhZHZhbmNlIHlvdXIgb3duIGF0dHJpYnV0ZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCeXJvbiBSb2JlcnRzIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgaGF2ZSB0d28gZWFycyBhbmQgb25lIG1vdXRoIHNvIHRoYXQgd2UgY2FuIGxpc3RlbiB0d2ljZSBhcyBtdWNoIGFzIHdlIHNwZWFrLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXBpY3RldHVzIgogIH0sCiAgewogICAgInRleHQiOiAiQSBtYW4gc2hvdWxkIGxvb2sgZm9yIHdoYXQgaXMsIGFuZCBub3QgZm9yIHdoYXQgaGUgdGhpbmtzIHNob3VsZCBiZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIk1vc3Qgb2YgdGhlIGltcG9ydGFudCB0aGluZ3MgaW4gdGhlIHdvcmxkIGhhdmUgYmVlbiBhY2NvbXBsaXNoZWQgYnkgcGVvcGxlIHdobyBoYXZlIGtlcHQgb24gdHJ5aW5nIHdoZW4gdGhlcmUgc2VlbWVkIHRvIGJlIG5vIGhvcGUgYXQgYWxsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGFsZSBDYXJuZWdpZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIG5ldmVyIHRvbyBsYXRlLiBFdmVuIGlmIHlvdSBhcmUgZ29pbmcgdG8gZGllIHRvbW9ycm93LCBrZWVwIHlvdXJzZWxmIHN0cmFpZ2h0IGFuZCBjbGVhciBhbmQgYmUgYSBoYXBweSBodW1hbiBiZWluZyB0b2RheS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbWEgWWVzaGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTcGlyaXR1YWxpdHkgY2FuIGJlIHNldmVyZWQgZnJvbSBib3RoIHZpY2lvdXMgc2VjdGFyaWFuaXNtIGFuZCB0aG91Z2h0bGVzcyBiYW5hbGl0aWVzLiBTcGlyaXR1YWxpdHksIEkgaGF2ZSBjb21lIHRvIHNlZSwgaXMgbm90aGluZyBsZXNzIHRoYW4gdGhlIHRob3VnaHRmdWwgbG92ZSBvZiBsaWZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IEMuIFNvbG9tb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBydWluIHRoZSBwcmVzZW50IHdpdGggdGhlIHJ1aW5lZCBwYXN0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxsZW4gR2lsY2hyaXN0IgogIH0sCiAgewogICAgInRleHQiOiAiQSBtYW4gb2YgYWJpbGl0eSBhbmQgdGhlIGRlc2lyZSB0byBhY2NvbXBsaXNoIHNvbWV0aGluZyBjYW4gZG8gYW55dGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEb25hbGQgS2lyY2hlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRpbWUgY2hhbmdlcyBldmVyeXRoaW5nIGV4Y2VwdCBzb21ldGhpbmcgd2l0aGluIHVzIHdoaWNoIGlzIGFsd2F5cyBzdXJwcmlzZWQgYnkgY2hhbmdlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIEhhcmR5IgogIH0sCiAgewogICAgInRleHQiOiAiRmFjZSB5b3VyIGRlZmljaWVuY2llcyBhbmQgYWNrbm93bGVkZ2UgdGhlbTsgYnV0IGRvIG5vdCBsZXQgdGhlbSBtYXN0ZXIgeW91LiBMZXQgdGhlbSB0ZWFjaCB5b3UgcGF0aWVuY2UsIHN3ZWV0bmVzcywgaW5zaWdodC4iLAogICAgImF0dHJpYnV0aW9uIjogIkhlbGVuIEtlbGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIk5vYm9keSBtYWRlIGEgZ3JlYXRlciBtaXN0YWtlIHRoYW4gaGUgd2hvIGRpZCBub3RoaW5nIGJlY2F1c2UgaGUgY291bGQgZG8gb25seSBhIGxpdHRsZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVkbXVuZCBCdXJrZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldlIGFyZSBub3QgYW5pbWFscy4gV2UgYXJlIG5vdCBhIHByb2R1Y3Qgb2Ygd2hhdCBoYXMgaGFwcGVuZWQgdG8gdXMgaW4gb3VyIHBhc3QuIFdlIGhhdmUgdGhlIHBvd2VyIG9mIGNob2ljZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlN0ZXBoZW4gQ292ZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBsZXQgd2hhdCB5b3UgY2FuJ3QgZG8gc3RvcCB5b3UgZnJvbSBkb2luZyB3aGF0IHlvdSBjYW4gZG8uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbiBhbnQgb24gdGhlIG1vdmUgZG9lcyBtb3JlIHRoYW4gYSBkb3ppbmcgb3giLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJOZXZlciBhcG9sb2dpemUgZm9yIHNob3dpbmcgZmVlbGluZ3MuIFdoZW4geW91IGRvIHNvLCB5b3UgYXBvbG9naXplIGZvciB0aGUgdHJ1dGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCZW5qYW1pbiBEaXNyYWVsaSIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZXJlIGFsbCB0aGluayBhbGlrZSwgbm8gb25lIHRoaW5rcyB2ZXJ5IG11Y2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYWx0ZXIgTGlwcG1hbm4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJTcHJpbmcgaXMgYSB0aW1lIGZvciByZWJpcnRoIGFuZCB0aGUgZnVsZmlsbWVudCBvZiBuZXcgbGlmZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiVHJhbnNsYXRpb24gaXMgdGhlIHBhcmFkaWdtLCB0aGUgZXhlbXBsYXIgb2YgYWxsIHdyaXRpbmcuIEl0IGlzIHRyYW5zbGF0aW9uIHRoYXQgZGVtb25zdHJhdGVzIG1vc3Qgdml2aWRseSB0aGUgeWVhcm5pbmcgZm9yIHRyYW5zZm9ybWF0aW9uIHRoYXQgdW5kZXJsaWVzIGV2ZXJ5IGFjdCBpbnZvbHZpbmcgc3BlZWNoLCB0aGF0IHN1cHJlbWVseSBodW1hbiBnaWZ0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGFycnkgQnVyY2hlbGwgTWF0aGV3cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBzaG9lIHRoYXQgZml0cyBvbmUgcGVyc29uIHBpbmNoZXMgYW5vdGhlcjsgdGhlcmUgaXMgbm8gcmVjaXBlIGZvciBsaXZpbmcgdGhhdCBzdWl0cyBhbGwgY2FzZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYXJsIEp1bmciCiAgfSwKICB7CiAgICAidGV4dCI6ICJTaWxlbmNlIGlzIGEgc291cmNlIG9mIGdyZWF0IHN0cmVuZ3RoLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTGFvIFR6dSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIGxlc3MgY3VyaW91cyBhYm91dCBwZW9wbGUgYW5kIG1vcmUgY3VyaW91cyBhYm91dCBpZGVhcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmllIEN1cmllIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIGxhZGRlciBvZiBzdWNjZXNzIGlzIG5ldmVyIGNyb3dkZWQgYXQgdGhlIHRvcC4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEhpbGwiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHb29kIGluc3RpbmN0cyB1c3VhbGx5IHRlbGwgeW91IHdoYXQgdG8gZG8gbG9uZyBiZWZvcmUgeW91ciBoZWFkIGhhcyBmaWd1cmVkIGl0IG91dC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1pY2hhZWwgQnVya2UiCiAgfSwKICB7CiAgICAidGV4dCI6ICJTdHJvbmcgYmVsaWVmcyB3aW4gc3Ryb25nIG1lbiwgYW5kIHRoZW4gbWFrZSB0aGVtIHN0cm9uZ2VyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBCYWNoIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHBhdGggdG8gc3VjY2VzcyBpcyB0byB0YWtlIG1hc3NpdmUsIGRldGVybWluZWQgYWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVG9ueSBSb2JiaW5zIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHN1cGVyaW9yIG1hbiBpcyBtb2Rlc3QgaW4gaGlzIHNwZWVjaCwgYnV0IGV4Y2VlZHMgaW4gaGlzIGFjdGlvbnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgZXllIHNlZXMgb25seSB3aGF0IHRoZSBtaW5kIGlzIHByZXBhcmVkIHRvIGNvbXByZWhlbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIZW5yaSBCZXJnc29uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlcmUgYXJlIG5vIGZhaWx1cmVzLiBKdXN0IGV4cGVyaWVuY2VzIGFuZCB5b3VyIHJlYWN0aW9ucyB0byB0aGVtLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVG9tIEtyYXVzZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5IG1hbiBpcyBhIHZvbHVtZSBpZiB5b3Uga25vdyBob3cgdG8gcmVhZCBoaW0uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFubmluZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHlvdSBwcm9wb3NlIHRvIHNwZWFrLCBhbHdheXMgYXNrIHlvdXJzZWxmLCBpcyBpdCB0cnVlLCBpcyBpdCBuZWNlc3NhcnksIGlzIGl0IGtpbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJCdWRkaGEiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMb3ZlIGlzIHRoZSBmbG93ZXIgeW91J3ZlIGdvdCB0byBsZXQgZ3Jvdy4iLAogICAgImF0dHJpYnV0aW9uIjogIkpvaG4gTGVubm9uIgogIH0sCiAgewogICAgInRleHQiOiAiRG9uJ3QgbG9vayBiYWNrLiBTb21ldGhpbmcgbWlnaHQgYmUgZ2FpbmluZyBvbiB5b3UuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJTYXRjaGVsIFBhaWdlIgogIH0sCiAgewogICAgInRleHQiOiAiQSBmYWlsdXJlIGlzIGEgbWFuIHdobyBoYXMgYmx1bmRlcmVkIGJ1dCBpcyBub3QgY2FwYWJsZSBvZiBjYXNoaW5nIGluIG9uIHRoZSBleHBlcmllbmNlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxiZXJ0IEh1YmJhcmQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgb25lIHdobyBhbHdheXMgbG9zZXMsIGlzIHRoZSBvbmx5IHBlcnNvbiB3aG8gZ2V0cyB0aGUgcmV3YXJkLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2xhaXJlIENoYXJtb250IgogIH0sCiAgewogICAgInRleHQiOiAiTmV2ZXIgaWdub3JlIGEgZ3V0IGZlZWxpbmcsIGJ1dCBuZXZlciBiZWxpZXZlIHRoYXQgaXQncyBlbm91Z2guIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSb2JlcnQgSGVsbGVyIgogIH0sCiAgewogICAgInRleHQiOiAiSW50dWl0aW9uIGlzIHRoZSBzdXByYS1sb2dpYyB0aGF0IGN1dHMgb3V0IGFsbCB0aGUgcm91dGluZSBwcm9jZXNzZXMgb2YgdGhvdWdodCBhbmQgbGVhcHMgc3RyYWlnaHQgZnJvbSB0aGUgcHJvYmxlbSB0byB0aGUgYW5zd2VyLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUm9iZXJ0IEdyYXZlcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkFycm9nYW5jZSBhbmQgcnVkZW5lc3MgYXJlIHRyYWluaW5nIHdoZWVscyBvbiB0aGUgYmljeWNsZSBvZiBsaWZlIC0gZm9yIHdlYWsgcGVvcGxlIHdobyBjYW5ub3Qga2VlcCB0aGVpciBiYWxhbmNlIHdpdGhvdXQgdGhlbS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhdXJhIFRlcmVzYSBNYXJxdWV6IgogIH0sCiAgewogICAgInRleHQiOiAiV2F0Y2ggdGhlIGxpdHRsZSB0aGluZ3M7IGEgc21hbGwgbGVhayB3aWxsIHNpbmsgYSBncmVhdCBzaGlwLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQmVuamFtaW4gRnJhbmtsaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJLZWVwIHNpbGVuY2UgZm9yIHRoZSBtb3N0IHBhcnQsIGFuZCBzcGVhayBvbmx5IHdoZW4geW91IG11c3QsIGFuZCB0aGVuIGJyaWVmbHkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJFcGljdGV0dXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJIGRvIG5vdCBiZWxpZXZlIGluIGEgZmF0ZSB0aGF0IGZhbGxzIG9uIG1lbiBob3dldmVyIHRoZXkgYWN0OyBidXQgSSBkbyBiZWxpZXZlIGluIGEgZmF0ZSB0aGF0IGZhbGxzIG9uIG1hbiB1bmxlc3MgdGhleSBhY3QuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJHLiBLLiBDaGVzdGVydG9uIgogIH0sCiAgewogICAgInRleHQiOiAiV2UgY2FuIG9ubHkgbGVhcm4gdG8gbG92ZSBieSBsb3ZpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJJcmlzIE11cmRvY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBkbyBhbGwgdGhhdCBvbmUgaXMgYWJsZSB0byBkbywgaXMgdG8gYmUgYSBtYW47IHRvIGRvIGFsbCB0aGF0IG9uZSB3b3VsZCBsaWtlIHRvIGRvLCBpcyB0byBiZSBhIGdvZC4iLAogICAgImF0dHJpYnV0aW9uIjogIk5hcG9sZW9uIEJvbmFwYXJ0ZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB3aXNlc3QgbWVuIGZvbGxvdyB0aGVpciBvd24gZGlyZWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRXVyaXBpZGVzIgogIH0sCiAgewogICAgInRleHQiOiAiRXhjZWxsZW5jZSBpcyBub3QgYSBza2lsbC4gSXQgaXMgYW4gYXR0aXR1ZGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBNYXJzdG9uIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHN1cGVyaW9yIG1hbiBhY3RzIGJlZm9yZSBoZSBzcGVha3MsIGFuZCBhZnRlcndhcmRzIHNwZWFrcyBhY2NvcmRpbmcgdG8gaGlzIGFjdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkNvbmZ1Y2l1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB0cmljayBpcyBpbiB3aGF0IG9uZSBlbXBoYXNpemVzLiBXZSBlaXRoZXIgbWFrZSBvdXJzZWx2ZXMgbWlzZXJhYmxlLCBvciB3ZSBtYWtlIG91cnNlbHZlcyBoYXBweS4gVGhlIGFtb3VudCBvZiB3b3JrIGlzIHRoZSBzYW1lLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQ2FybG9zIENhc3RhbmVkYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlJpc2sgbW9yZSB0aGFuIG90aGVycyB0aGluayBpcyBzYWZlLiBDYXJlIG1vcmUgdGhhbiBvdGhlcnMgdGhpbmsgaXMgd2lzZS4gRHJlYW0gbW9yZSB0aGFuIG90aGVycyB0aGluayBpcyBwcmFjdGljYWwuRXhwZWN0IG1vcmUgdGhhbiBvdGhlcnMgdGhpbmsgaXMgcG9zc2libGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDYWRldCBNYXhpbSIKICB9LAogIHsKICAgICJ0ZXh0IjogIkEgbGVhZGVyIG9yIGEgbWFuIG9mIGFjdGlvbiBpbiBhIGNyaXNpcyBhbG1vc3QgYWx3YXlzIGFjdHMgc3ViY29uc2Npb3VzbHkgYW5kIHRoZW4gdGhpbmtzIG9mIHRoZSByZWFzb25zIGZvciBoaXMgYWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmF3YWhhcmxhbCBOZWhydSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlBlYWNlIGNvbWVzIGZyb20gd2l0aGluLiBEbyBub3Qgc2VlayBpdCB3aXRob3V0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnVkZGhhIgogIH0sCiAgewogICAgInRleHQiOiAiV2hhdCB5b3Ugc2VlIGRlcGVuZHMgb24gd2hhdCB5b3UncmUgbG9va2luZyBmb3IuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBubyBkdXR5IHdlIHNvIHVuZGVycmF0ZSBhcyB0aGUgZHV0eSBvZiBiZWluZyBoYXBweS4gQnkgYmVpbmcgaGFwcHkgd2Ugc293IGFub255bW91cyBiZW5lZml0cyB1cG9uIHRoZSB3b3JsZC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJvYmVydCBMb3VpcyBTdGV2ZW5zb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUcnkgbm90IHRvIGJlY29tZSBhIG1hbiBvZiBzdWNjZXNzIGJ1dCByYXRoZXIgdHJ5IHRvIGJlY29tZSBhIG1hbiBvZiB2YWx1ZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIldoZW4geW91IGFyaXNlIGluIHRoZSBtb3JuaW5nLCB0aGluayBvZiB3aGF0IGEgcHJlY2lvdXMgcHJpdmlsZWdlIGl0IGlzIHRvIGJlIGFsaXZlIC0gdG8gYnJlYXRoZSwgdG8gdGhpbmssIHRvIGVuam95LCB0byBsb3ZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyY3VzIEF1cmVsaXVzIgogIH0sCiAgewogICAgInRleHQiOiAiUmVhbCBzdWNjZXNzIGlzIGZpbmRpbmcgeW91ciBsaWZld29yayBpbiB0aGUgd29yayB0aGF0IHlvdSBsb3ZlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiRGF2aWQgTWNDdWxsb3VnaCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkga25vdyBidXQgb25lIGZyZWVkb20gYW5kIHRoYXQgaXMgdGhlIGZyZWVkb20gb2YgdGhlIG1pbmQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJBbnRvaW5lIGRlIFNhaW50LUV4dXBlcnkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbXBvc3NpYmlsaXRpZXMgYXJlIG1lcmVseSB0aGluZ3Mgd2hpY2ggd2UgaGF2ZSBub3QgeWV0IGxlYXJuZWQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFybGVzIENoZXNudXR0IgogIH0sCiAgewogICAgInRleHQiOiAiQ291cmFnZSBpcyB3aGF0IGl0IHRha2VzIHRvIHN0YW5kIHVwIGFuZCBzcGVhazsgY291cmFnZSBpcyBhbHNvIHdoYXQgaXQgdGFrZXMgdG8gc2l0IGRvd24gYW5kIGxpc3Rlbi4iLAogICAgImF0dHJpYnV0aW9uIjogIldpbnN0b24gQ2h1cmNoaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiWW91IGNhbid0IGNyZWF0ZSBpbiBhIHZhY3V1bS4gTGlmZSBnaXZlcyB5b3UgdGhlIG1hdGVyaWFsIGFuZCBkcmVhbXMgY2FuIHByb3BlbCBuZXcgYmVnaW5uaW5ncy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiQWxsIGFjaGlldmVtZW50cywgYWxsIGVhcm5lZCByaWNoZXMsIGhhdmUgdGhlaXIgYmVnaW5uaW5nIGluIGFuIGlkZWEuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJOYXBvbGVvbiBIaWxsIgogIH0sCiAgewogICAgInRleHQiOiAiV2hvZXZlciBpcyBoYXBweSB3aWxsIG1ha2Ugb3RoZXJzIGhhcHB5LCB0b28uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNYXJrIFR3YWluIgogIH0sCiAgewogICAgInRleHQiOiAiVXNpbmcgdGhlIHBvd2VyIG9mIGRlY2lzaW9uIGdpdmVzIHlvdSB0aGUgY2FwYWNpdHkgdG8gZ2V0IHBhc3QgYW55IGV4Y3VzZSB0byBjaGFuZ2UgYW55IGFuZCBldmVyeSBwYXJ0IG9mIHlvdXIgbGlmZSBpbiBhbiBpbnN0YW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVG9ueSBSb2JiaW5zIgogIH0sCiAgewogICAgInRleHQiOiAiV2lzZG9tIGlzIHRoZSBzdXByZW1lIHBhcnQgb2YgaGFwcGluZXNzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiU29waG9jbGVzIgogIH0sCiAgewogICAgInRleHQiOiAiTm8gb25lIGNhbiBtYWtlIHlvdSBmZWVsIGluZmVyaW9yIHdpdGhvdXQgeW91ciBjb25zZW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiRWxlYW5vciBSb29zZXZlbHQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMaWZlIGlzIGEgcHJvY2Vzcy4gV2UgYXJlIGEgcHJvY2Vzcy4gVGhlIHVuaXZlcnNlIGlzIGEgcHJvY2Vzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFubmUgU2NoYWVmIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgbm90IHVuY29tbW9uIGZvciBwZW9wbGUgdG8gc3BlbmQgdGhlaXIgd2hvbGUgbGlmZSB3YWl0aW5nIHRvIHN0YXJ0IGxpdmluZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkVja2hhcnQgVG9sbGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJBbGwgdGhhdCBpcyBuZWNlc3NhcnkgaXMgdG8gYWNjZXB0IHRoZSBpbXBvc3NpYmxlLCBkbyB3aXRob3V0IHRoZSBpbmRpc3BlbnNhYmxlLCBhbmQgYmVhciB0aGUgaW50b2xlcmFibGUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJLYXRobGVlbiBOb3JyaXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJEbyBub3QgdHVybiBiYWNrIHdoZW4geW91IGFyZSBqdXN0IGF0IHRoZSBnb2FsLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUHVibGlsaXVzIFN5cnVzIgogIH0sCiAgewogICAgInRleHQiOiAiTm90aGluZyBkaW1pbmlzaGVzIGFueGlldHkgZmFzdGVyIHRoYW4gYWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2FsdGVyIEFuZGVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiRG9uJ3QganVkZ2UgZWFjaCBkYXkgYnkgdGhlIGhhcnZlc3QgeW91IHJlYXAgYnV0IGJ5IHRoZSBzZWVkcyB0aGF0IHlvdSBwbGFudC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJvYmVydCBTdGV2ZW5zb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBub3RoaW5nIGhhcHBlbnMgdG8gYW55IHBlcnNvbiBidXQgd2hhdCB3YXMgaW4gaGlzIHBvd2VyIHRvIGdvIHRocm91Z2ggd2l0aC4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmN1cyBBdXJlbGl1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRocmVlIHRoaW5ncyBpbiBodW1hbiBsaWZlIGFyZSBpbXBvcnRhbnQuIFRoZSBmaXJzdCBpcyB0byBiZSBraW5kLiBUaGUgc2Vjb25kIGlzIHRvIGJlIGtpbmQuIFRoZSB0aGlyZCBpcyB0byBiZSBraW5kLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSGVucnkgSmFtZXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJNeSBtYW1hIGFsd2F5cyBzYWlkOiBsaWZlJ3MgbGlrZSBhIGJveCBvZiBjaG9jb2xhdGVzLiBZb3UgbmV2ZXIga25vdyB3aGF0IHlvdSBnb25uYSBnZXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJGb3JyZXN0IEd1bXAiCiAgfSwKICB7CiAgICAidGV4dCI6ICJJbiBvcmRlciB0byB3aW4sIHlvdSBtdXN0IGV4cGVjdCB0byB3aW4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSaWNoYXJkIEJhY2giCiAgfSwKICB7CiAgICAidGV4dCI6ICJEb24ndCBmb2N1cyBvbiBtYWtpbmcgdGhlIHJpZ2h0IGRlY2lzaW9uLCBmb2N1cyBvbiBtYWtpbmcgdGhlIGRlY2lzaW9uIHRoZSByaWdodCBvbmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgd29ybGQgaXMgYSBib29rLCBhbmQgdGhvc2Ugd2hvIGRvIG5vdCB0cmF2ZWwgcmVhZCBvbmx5IGEgcGFnZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkF1Z3VzdGludXMgU2FuY3R1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkl0IGlzIG9uIG91ciBmYWlsdXJlcyB0aGF0IHdlIGJhc2UgYSBuZXcgYW5kIGRpZmZlcmVudCBhbmQgYmV0dGVyIHN1Y2Nlc3MuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIYXZlbG9jayBFbGxpcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkJlIGZhaXRoZnVsIGluIHNtYWxsIHRoaW5ncyBiZWNhdXNlIGl0IGlzIGluIHRoZW0gdGhhdCB5b3VyIHN0cmVuZ3RoIGxpZXMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNb3RoZXIgVGVyZXNhIgogIH0sCiAgewogICAgInRleHQiOiAiSXQgaXMgc3VycHJpc2luZyB3aGF0IGEgbWFuIGNhbiBkbyB3aGVuIGhlIGhhcyB0bywgYW5kIGhvdyBsaXR0bGUgbW9zdCBtZW4gd2lsbCBkbyB3aGVuIHRoZXkgZG9uJ3QgaGF2ZSB0by4iLAogICAgImF0dHJpYnV0aW9uIjogIldhbHRlciBMaW5uIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpcyBhIHByb2dyZXNzLCBhbmQgbm90IGEgc3RhdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJZb3VyIGRlc3RpbnkgaXNuJ3QganVzdCBmYXRlOyBpdCBpcyBob3cgeW91IHVzZSB5b3VyIG93biBkZXZlbG9wZWQgYWJpbGl0aWVzIHRvIGdldCB3aGF0IHlvdSB3YW50LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQnlyb24gUHVsc2lmZXIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUbyBtYWtlIG5vIG1pc3Rha2VzIGlzIG5vdCBpbiB0aGUgcG93ZXIgb2YgbWFuOyBidXQgZnJvbSB0aGVpciBlcnJvcnMgYW5kIG1pc3Rha2VzIHRoZSB3aXNlIGFuZCBnb29kIGxlYXJuIHdpc2RvbSBmb3IgdGhlIGZ1dHVyZS4iLAogICAgImF0dHJpYnV0aW9uIjogIlBsdXRhcmNoIgogIH0sCiAgewogICAgInRleHQiOiAiSGUgd2hvIHdpc2hlcyB0byBzZWN1cmUgdGhlIGdvb2Qgb2Ygb3RoZXJzLCBoYXMgYWxyZWFkeSBzZWN1cmVkIGhpcyBvd24uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDb25mdWNpdXMiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUb2RheSBpcyB0aGUgdG9tb3Jyb3cgd2Ugd29ycmllZCBhYm91dCB5ZXN0ZXJkYXkuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMb3ZlIGlzIG5vdCBibGluZDsgaXQgc2ltcGx5IGVuYWJsZXMgb25lIHRvIHNlZSB0aGluZ3Mgb3RoZXJzIGZhaWwgdG8gc2VlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiIgogIH0sCiAgewogICAgInRleHQiOiAiTmV2ZXIgZG91YnQgdGhhdCBhIHNtYWxsIGdyb3VwIG9mIHRob3VnaHRmdWwsIGNvbW1pdHRlZCBwZW9wbGUgY2FuIGNoYW5nZSB0aGUgd29ybGQuIEluZGVlZC4gSXQgaXMgdGhlIG9ubHkgdGhpbmcgdGhhdCBldmVyIGhhcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmdhcmV0IE1lYWQiCiAgfSwKICB7CiAgICAidGV4dCI6ICJGb2xsb3cgZWZmZWN0aXZlIGFjdGlvbiB3aXRoIHF1aWV0IHJlZmxlY3Rpb24uIEZyb20gdGhlIHF1aWV0IHJlZmxlY3Rpb24gd2lsbCBjb21lIGV2ZW4gbW9yZSBlZmZlY3RpdmUgYWN0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiUGV0ZXIgRHJ1Y2tlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV2ZXJ5IGh1bWFuIGJlaW5nIGlzIHRoZSBhdXRob3Igb2YgaGlzIG93biBoZWFsdGggb3IgZGlzZWFzZS4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ1ZGRoYSIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBiZXN0IHRoaW5nIGluIGV2ZXJ5IG5vYmxlIGRyZWFtIGlzIHRoZSBkcmVhbWVyLi4uIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJNb25jdXJlIENvbndheSIKICB9LAogIHsKICAgICJ0ZXh0IjogIktpbmRuZXNzIGluIHdvcmRzIGNyZWF0ZXMgY29uZmlkZW5jZS4gS2luZG5lc3MgaW4gdGhpbmtpbmcgY3JlYXRlcyBwcm9mb3VuZG5lc3MuIEtpbmRuZXNzIGluIGdpdmluZyBjcmVhdGVzIGxvdmUuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJMYW8gVHp1IgogIH0sCiAgewogICAgInRleHQiOiAiR2l2ZSB0aGFua3MgZm9yIHRoZSByYWluIG9mIGxpZmUgdGhhdCBwcm9wZWxzIHVzIHRvIHJlYWNoIG5ldyBob3Jpem9ucy4iLAogICAgImF0dHJpYnV0aW9uIjogIkJ5cm9uIFB1bHNpZmVyIgogIH0sCiAgewogICAgInRleHQiOiAiT3VyIGRvdWJ0cyBhcmUgdHJhaXRvcnMgYW5kIG1ha2UgdXMgbG9zZSB0aGUgZ29vZCB3ZSBvZnRlbiBtaWdodCB3aW4sIGJ5IGZlYXJpbmcgdG8gYXR0ZW1wdC4iLAogICAgImF0dHJpYnV0aW9uIjogIkphbmUgQWRkYW1zIgogIH0sCiAgewogICAgInRleHQiOiAiVGhlIHJvYWQgbGVhZGluZyB0byBhIGdvYWwgZG9lcyBub3Qgc2VwYXJhdGUgeW91IGZyb20gdGhlIGRlc3RpbmF0aW9uOyBpdCBpcyBlc3NlbnRpYWxseSBhIHBhcnQgb2YgaXQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJDaGFybGVzIERlTGludCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkNvdXJhZ2UgaXMgdGhlIGRpc2NvdmVyeSB0aGF0IHlvdSBtYXkgbm90IHdpbiwgYW5kIHRyeWluZyB3aGVuIHlvdSBrbm93IHlvdSBjYW4gbG9zZS4iLAogICAgImF0dHJpYnV0aW9uIjogIiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIHNlZWQgdGltZSBsZWFybiwgaW4gaGFydmVzdCB0ZWFjaCwgaW4gd2ludGVyIGVuam95LiIsCiAgICAiYXR0cmlidXRpb24iOiAiV2lsbGlhbSBCbGFrZSIKICB9LAogIHsKICAgICJ0ZXh0IjogIllvdSBkbyBub3QgYmVjb21lIGdvb2QgYnkgdHJ5aW5nIHRvIGJlIGdvb2QsIGJ1dCBieSBmaW5kaW5nIHRoZSBnb29kbmVzcyB0aGF0IGlzIGFscmVhZHkgd2l0aGluIHlvdS4iLAogICAgImF0dHJpYnV0aW9uIjogIkVja2hhcnQgVG9sbGUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGUgb25seSByZWFsIG1pc3Rha2UgaXMgdGhlIG9uZSBmcm9tIHdoaWNoIHdlIGxlYXJuIG5vdGhpbmcuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIFBvd2VsbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIkluIHNrYXRpbmcgb3ZlciB0aGluIGljZSBvdXIgc2FmZXR5IGlzIGluIG91ciBzcGVlZC4iLAogICAgImF0dHJpYnV0aW9uIjogIlJhbHBoIEVtZXJzb24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJLbm93bGVkZ2UgaGFzIHRocmVlIGRlZ3JlZXMgLSBvcGluaW9uLCBzY2llbmNlLCBpbGx1bWluYXRpb24uIFRoZSBtZWFucyBvciBpbnN0cnVtZW50IG9mIHRoZSBmaXJzdCBpcyBzZW5zZTsgb2YgdGhlIHNlY29uZCwgZGlhbGVjdGljOyBvZiB0aGUgdGhpcmQsIGludHVpdGlvbi4iLAogICAgImF0dHJpYnV0aW9uIjogIlBsb3RpbnVzIgogIH0sCiAgewogICAgInRleHQiOiAiTGlmZSBpcyBtb3ZlbWVudC13ZSBicmVhdGhlLCB3ZSBlYXQsIHdlIHdhbGssIHdlIG1vdmUhIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJKb2huIFBpZXJyYWtvcyIKICB9LAogIHsKICAgICJ0ZXh0IjogIkdyZWF0IGluZGVlZCBpcyB0aGUgc3VibGltaXR5IG9mIHRoZSBDcmVhdGl2ZSwgdG8gd2hpY2ggYWxsIGJlaW5ncyBvd2UgdGhlaXIgYmVnaW5uaW5nIGFuZCB3aGljaCBwZXJtZWF0ZXMgYWxsIGhlYXZlbi4iLAogICAgImF0dHJpYnV0aW9uIjogIkxhbyBUenUiCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeW9uZSBzaG91bGQgY2FyZWZ1bGx5IG9ic2VydmUgd2hpY2ggd2F5IGhpcyBoZWFydCBkcmF3cyBoaW0sIGFuZCB0aGVuIGNob29zZSB0aGF0IHdheSB3aXRoIGFsbCBoaXMgc3RyZW5ndGguIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJIYXNpZGljIHNheWluZyIKICB9LAogIHsKICAgICJ0ZXh0IjogIklmIHdlIGxlYXJuIHRvIG9wZW4gb3VyIGhlYXJ0cywgYW55b25lLCBpbmNsdWRpbmcgdGhlIHBlb3BsZSB3aG8gZHJpdmUgdXMgY3JhenksIGNhbiBiZSBvdXIgdGVhY2hlci4iLAogICAgImF0dHJpYnV0aW9uIjogIlBlbWEgQ2hvZHJvbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSB3b3JsZCB0dXJucyBhc2lkZSB0byBsZXQgYW55IG1hbiBwYXNzIHdobyBrbm93cyB3aGVyZSBoZSBpcyBnb2luZy4iLAogICAgImF0dHJpYnV0aW9uIjogIkVwaWN0ZXR1cyIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZXJlIGlzIGEgd2F5IHRoYXQgbmF0dXJlIHNwZWFrcywgdGhhdCBsYW5kIHNwZWFrcy4gTW9zdCBvZiB0aGUgdGltZSB3ZSBhcmUgc2ltcGx5IG5vdCBwYXRpZW50IGVub3VnaCwgcXVpZXQgZW5vdWdoLCB0byBwYXkgYXR0ZW50aW9uIHRvIHRoZSBzdG9yeS4iLAogICAgImF0dHJpYnV0aW9uIjogIkxpbmRhIEhvZ2FuIgogIH0sCiAgewogICAgInRleHQiOiAiU2VsZi1jb21wbGFjZW5jeSBpcyBmYXRhbCB0byBwcm9ncmVzcy4iLAogICAgImF0dHJpYnV0aW9uIjogIk1hcmdhcmV0IFNhbmdzdGVyIgogIH0sCiAgewogICAgInRleHQiOiAiU29tZW9uZSByZW1lbWJlcnMsIHNvbWVvbmUgY2FyZXM7IHlvdXIgbmFtZSBpcyB3aGlzcGVyZWQgaW4gc29tZW9uZSdzIHByYXllcnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICIiCiAgfSwKICB7CiAgICAidGV4dCI6ICJMb3NlIGFuIGhvdXIgaW4gdGhlIG1vcm5pbmcsIGFuZCB5b3Ugd2lsbCBzcGVuZCBhbGwgZGF5IGxvb2tpbmcgZm9yIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiUmljaGFyZCBXaGF0ZWx5IgogIH0sCiAgewogICAgInRleHQiOiAiTm8gZ2FyZGVuIGlzIHdpdGhvdXQgaXRzIHdlZWRzLiIsCiAgICAiYXR0cmlidXRpb24iOiAiVGhvbWFzIEZ1bGxlciIKICB9LAogIHsKICAgICJ0ZXh0IjogIkkgY2FuJ3QgY2hhbmdlIHRoZSBkaXJlY3Rpb24gb2YgdGhlIHdpbmQsIGJ1dCBJIGNhbiBhZGp1c3QgbXkgc2FpbHMgdG8gYWx3YXlzIHJlYWNoIG15IGRlc3RpbmF0aW9uLiIsCiAgICAiYXR0cmlidXRpb24iOiAiSmltbXkgRGVhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRvIGJlIGhhcHB5IGlzIHRvIGJlIGFibGUgdG8gYmVjb21lIGF3YXJlIG9mIG9uZXNlbGYgd2l0aG91dCBmcmlnaHQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJXYWx0ZXIgQmVuamFtaW4iCiAgfSwKICB7CiAgICAidGV4dCI6ICJUaGVyZSBpcyBvbmx5IG9uZSBjb3JuZXIgb2YgdGhlIHVuaXZlcnNlIHlvdSBjYW4gYmUgY2VydGFpbiBvZiBpbXByb3ZpbmcsIGFuZCB0aGF0J3MgeW91ciBvd24gc2VsZi4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsZG91cyBIdXhsZXkiCiAgfSwKICB7CiAgICAidGV4dCI6ICJHaXZlIG1lIHNpeCBob3VycyB0byBjaG9wIGRvd24gYSB0cmVlIGFuZCBJIHdpbGwgc3BlbmQgdGhlIGZpcnN0IGZvdXIgc2hhcnBlbmluZyB0aGUgYXhlLiIsCiAgICAiYXR0cmlidXRpb24iOiAiQWJyYWhhbSBMaW5jb2xuIgogIH0sCiAgewogICAgInRleHQiOiAiQmUgbGlrZSB0aGUgZmxvd2VyLCB0dXJuIHlvdXIgZmFjZSB0byB0aGUgc3VuLiIsCiAgICAiYXR0cmlidXRpb24iOiAiS2FobGlsIEdpYnJhbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIlNvIGlzIGNoZWVyZnVsbmVzcywgb3IgYSBnb29kIHRlbXBlciwgdGhlIG1vcmUgaXQgaXMgc3BlbnQsIHRoZSBtb3JlIHJlbWFpbnMuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJSYWxwaCBFbWVyc29uIgogIH0sCiAgewogICAgInRleHQiOiAiTGVhcm4gZnJvbSB5ZXN0ZXJkYXksIGxpdmUgZm9yIHRvZGF5LCBob3BlIGZvciB0b21vcnJvdy4iLAogICAgImF0dHJpYnV0aW9uIjogIkFsYmVydCBFaW5zdGVpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkhhcHBpbmVzcyBpcyBhIFN3ZWRpc2ggc3Vuc2V0IC0gaXQgaXMgdGhlcmUgZm9yIGFsbCwgYnV0IG1vc3Qgb2YgdXMgbG9vayB0aGUgb3RoZXIgd2F5IGFuZCBsb3NlIGl0LiIsCiAgICAiYXR0cmlidXRpb24iOiAiTWFyayBUd2FpbiIKICB9LAogIHsKICAgICJ0ZXh0IjogIkV4Y2VsbGVuY2UgaXMgdG8gZG8gYSBjb21tb24gdGhpbmcgaW4gYW4gdW5jb21tb24gd2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiQm9va2VyIFdhc2hpbmd0b24iCiAgfSwKICB7CiAgICAidGV4dCI6ICJFdmVyeXRoaW5nIGNhbiBiZSB0YWtlbiBmcm9tIGEgbWFuIGJ1dCAuLi4gdGhlIGxhc3Qgb2YgdGhlIGh1bWFuIGZyZWVkb21zIC0gdG8gY2hvb3NlIG9uZXMgYXR0aXR1ZGUgaW4gYW55IGdpdmVuIHNldCBvZiBjaXJjdW1zdGFuY2VzLCB0byBjaG9vc2Ugb25lcyBvd24gd2F5LiIsCiAgICAiYXR0cmlidXRpb24iOiAiVmljdG9yIEZyYW5rbCIKICB9LAogIHsKICAgICJ0ZXh0IjogIlRoZSBtb3N0IGltcG9ydGFudCB0aGluZyBpcyB0cmFuc2Zvcm1pbmcgb3VyIG1pbmRzLCBmb3IgYSBuZXcgd2F5IG9mIHRoaW5raW5nLCBhIG5ldyBvdXRsb29rOiB3ZSBzaG91bGQgc3RyaXZlIHRvIGRldmVsb3AgYSBuZXcgaW5uZXIgd29ybGQuIiwKICAgICJhdHRyaWJ1dGlvbiI6ICJEYWxhaSBMYW1hIgogIH0KXQ==",
-}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/gdamore/tcell"
+)
+
+// trueColour is the number of colours reported by tcell for terminals supporting 24-bit colour.
+const trueColour = 1 << 24
+
+// parseColourDepth parses the value of -colors into a number of colours, 0 meaning the
+// terminal's capability should be detected.
+func parseColourDepth(s string) (int, bool) {
+	switch s {
+	case "auto":
+		return 0, true
+	case "truecolor", "24bit":
+		return trueColour, true
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || (n != 256 && n != 16 && n != 8) {
+		return 0, false
+	}
+
+	return n, true
+}
+
+// paletteCandidates returns the palette entries usable with the given number of colours. On 256
+// colour terminals only the colour cube and grey ramp are used since, unlike the first 16 entries,
+// they aren't usually changed by the terminal's own theme.
+func paletteCandidates(numColours int) []tcell.Color {
+	first, last := 0, 8
+	switch {
+	case numColours >= 256:
+		first, last = 16, 256
+	case numColours >= 16:
+		last = 16
+	}
+
+	var candidates []tcell.Color
+	for i := first; i < last; i++ {
+		candidates = append(candidates, tcell.Color(i))
+	}
+
+	return candidates
+}
+
+// colourDistance approximates how different two colours look using the 'redmean' weighting.
+func colourDistance(a, b tcell.Color) float64 {
+	r1, g1, b1 := a.RGB()
+	r2, g2, b2 := b.RGB()
+
+	rmean := float64(r1+r2) / 2
+	dr, dg, db := float64(r1-r2), float64(g1-g2), float64(b1-b2)
+
+	return (2+rmean/256)*dr*dr + 4*dg*dg + (2+(255-rmean)/256)*db*db
+}
+
+// luminance returns the perceived brightness of a colour in the range 0-255.
+func luminance(c tcell.Color) float64 {
+	r, g, b := c.RGB()
+	return 0.2126*float64(r) + 0.7152*float64(g) + 0.0722*float64(b)
+}
+
+// degradeTheme maps the colours of a theme onto the nearest entries of the palette of a terminal
+// with the given number of colours, leaving them untouched on terminals supporting 24-bit colour.
+// Since the nearest entry is often shared, the mapping avoids giving text the background's
+// colour, giving two different required colours (e.g hicol and errcol) the same one, or making
+// text which was lighter than the background darker than it (and vice versa), picking the next
+// nearest entry instead whenever the palette allows.
+func degradeTheme(theme map[string]themeColour, numColours int) map[string]themeColour {
+	if numColours >= trueColour || numColours < 8 {
+		return theme
+	}
+
+	candidates := paletteCandidates(numColours)
+	degraded := map[string]themeColour{}
+	mapped := map[tcell.Color]tcell.Color{}
+	taken := map[tcell.Color]bool{}
+
+	isRequired := map[string]bool{}
+	for _, k := range themeColours {
+		isRequired[k] = true
+	}
+
+	bg, bgMapped := theme["bgcol"].colour, tcell.ColorDefault
+
+	// The background comes first since the others are chosen relative to it.
+	keys := append([]string{"bgcol"}, themeColours...)
+	keys = append(keys, optionalThemeColours...)
+
+	for _, key := range keys {
+		c, ok := theme[key]
+		if _, done := degraded[key]; !ok || done {
+			continue
+		}
+
+		// The cursor colour is set with an escape sequence of its own which always takes RGB.
+		if key == "cursorcol" || c.colour&tcell.ColorIsRGB == 0 {
+			degraded[key] = c
+			continue
+		}
+
+		if m, ok := mapped[c.colour]; ok {
+			c.colour = m
+			degraded[key] = c
+			continue
+		}
+
+		isLighter := luminance(c.colour) > luminance(bg)
+		hasContrast := luminance(c.colour)-luminance(bg) > 32 || luminance(bg)-luminance(c.colour) > 32
+
+		notBackground := func(p tcell.Color) bool {
+			return key == "bgcol" || bgMapped == tcell.ColorDefault || p != bgMapped
+		}
+		sameSide := func(p tcell.Color) bool {
+			return key == "bgcol" || !hasContrast || (luminance(p) > luminance(bgMapped)) == isLighter
+		}
+		untaken := func(p tcell.Color) bool {
+			return !isRequired[key] || !taken[p]
+		}
+
+		m := nearestPaletteColour(c.colour, candidates,
+			func(p tcell.Color) bool { return notBackground(p) && sameSide(p) && untaken(p) },
+			func(p tcell.Color) bool { return notBackground(p) && sameSide(p) },
+			notBackground)
+
+		if key == "bgcol" {
+			bgMapped = m
+		}
+		if isRequired[key] {
+			taken[m] = true
+		}
+
+		mapped[c.colour] = m
+		c.colour = m
+		degraded[key] = c
+	}
+
+	return degraded
+}
+
+// nearestPaletteColour returns the candidate nearest to the given colour satisfying the first
+// acceptance test which any candidate passes, or simply the nearest one if none do.
+func nearestPaletteColour(c tcell.Color, candidates []tcell.Color, accept ...func(tcell.Color) bool) tcell.Color {
+	sorted := append([]tcell.Color(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return colourDistance(c, sorted[i]) < colourDistance(c, sorted[j])
+	})
+
+	for _, ok := range accept {
+		for _, p := range sorted {
+			if ok(p) {
+				return p
+			}
+		}
+	}
+
+	return sorted[0]
+}
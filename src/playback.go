@@ -0,0 +1,266 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+var replayUsage = `usage: tt replay [-speed SPEED] [-theme THEME] FILE
+
+    Play back a recorded test exactly as it was typed, mistakes, backspaces and
+    all. FILE is a replay file or the name of one in the replay directory.
+
+    -speed SPEED        The initial playback speed, 1, 2 or 4 (default: 1).
+    -theme THEME        The theme to use.
+
+Keys
+    space               Pause or resume playback.
+    1, 2, 4             Play at 1x, 2x or 4x speed.
+    left, right         Skip back or forward 5 seconds.
+    p, n                Go to the previous or next segment.
+    escape, q           Quit.
+`
+
+// scrubStep is how far the arrow keys move playback.
+const scrubStep = 5 * time.Second
+
+// player plays a replay back through the typer, one segment at a time.
+type player struct {
+	t *TyperScreen
+	r *replay
+
+	segment int
+	st      *typingState
+	applied int // The number of keystrokes of the segment applied to st.
+
+	// Playback is at elapsed (since the start of the segment) as of resumedAt.
+	elapsed   time.Duration
+	resumedAt time.Time
+	speed     int
+	paused    bool
+}
+
+// replayCommand implements 'tt replay' and returns the exit code.
+func replayCommand(args []string) int {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	fs.Usage = func() { os.Stderr.Write([]byte(replayUsage)) }
+	speed := fs.Int("speed", 1, "")
+	themeName := fs.String("theme", "default", "")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 1
+	}
+
+	if *speed != 1 && *speed != 2 && *speed != 4 {
+		die("-speed must be 1, 2 or 4.")
+	}
+
+	r, err := loadReplay(fs.Arg(0))
+	if err != nil {
+		die("%v", err)
+	}
+
+	if scr, err = tcell.NewScreen(); err != nil {
+		panic(err)
+	}
+	if err := scr.Init(); err != nil {
+		panic(err)
+	}
+	defer scr.Fini()
+
+	p := &player{t: createTyper(scr, false, *themeName, 0), r: r, speed: *speed}
+	p.play()
+
+	return 0
+}
+
+// end returns the time of the last keystroke of the current segment.
+func (p *player) end() time.Duration {
+	ks := p.r.Segments[p.segment].Keystrokes
+	if len(ks) == 0 {
+		return 0
+	}
+
+	return time.Duration(ks[len(ks)-1].Time) * time.Millisecond
+}
+
+// position returns how far playback has got through the current segment.
+func (p *player) position() time.Duration {
+	if p.paused {
+		return p.elapsed
+	}
+
+	return p.elapsed + time.Since(p.resumedAt)*time.Duration(p.speed)
+}
+
+// setPosition moves playback to the given time in the current segment.
+func (p *player) setPosition(elapsed time.Duration) {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if end := p.end(); elapsed > end {
+		elapsed = end
+	}
+
+	p.elapsed = elapsed
+	p.resumedAt = time.Now()
+}
+
+// setSegment starts playback of the given segment from the beginning.
+func (p *player) setSegment(i int) {
+	p.segment = i
+	p.t.segment = i
+	p.st = nil
+	p.setPosition(0)
+	p.t.Screen.Clear()
+}
+
+// seek brings the state of the text up to date with the given time, replaying the keystrokes made
+// before it. Moving backwards replays the segment from the start.
+func (p *player) seek(elapsed time.Duration) {
+	s := p.r.Segments[p.segment]
+
+	if p.st != nil && p.applied > 0 && time.Duration(s.Keystrokes[p.applied-1].Time)*time.Millisecond > elapsed {
+		p.st = nil
+	}
+
+	if p.st == nil {
+		p.st = newTypingState(s.Text, s.Attribution, -1)
+		p.applied = 0
+		p.t.layout(p.st)
+	}
+
+	for ; p.applied < len(s.Keystrokes); p.applied++ {
+		k := s.Keystrokes[p.applied]
+		if time.Duration(k.Time)*time.Millisecond > elapsed {
+			break
+		}
+
+		before := p.st.cursorPositionInText
+		p.t.handleKey(p.st, tcell.NewEventKey(k.Key, k.Rune, k.Mod))
+
+		// The recorded position is authoritative since options like -nobackspace aren't recorded.
+		if k.Pos <= len(p.st.referenceText) && k.Pos != p.st.cursorPositionInText {
+			p.st.cursorPositionInText = k.Pos
+			if k.Pos < before {
+				before = k.Pos
+			}
+			p.st.tally(before)
+		}
+	}
+
+	p.st.startTime = time.Now().Add(-elapsed)
+}
+
+// drawStatus draws the playback controls on the bottom line of the screen.
+func (p *player) drawStatus(elapsed time.Duration) {
+	w, h := p.t.Screen.Size()
+
+	state := "▶"
+	if p.paused {
+		state = "⏸"
+	}
+
+	status := fmt.Sprintf("%s %dx  %s / %s  segment %d/%d  (space: pause, 1/2/4: speed, ←/→: skip, n/p: segment, q: quit)",
+		state, p.speed, formatPlaybackTime(elapsed), formatPlaybackTime(p.end()), p.segment+1, len(p.r.Segments))
+
+	for x := 0; x < w; x++ {
+		p.t.Screen.SetContent(x, h-1, ' ', nil, p.t.defaultStyle)
+	}
+	drawString(p.t.Screen, (w-len([]rune(status)))/2, h-1, status, -1, p.t.timerStyle)
+}
+
+// formatPlaybackTime formats a time as minutes, seconds and tenths of a second.
+func formatPlaybackTime(d time.Duration) string {
+	return fmt.Sprintf("%d:%04.1f", int(d.Minutes()), d.Seconds()-float64(int(d.Minutes())*60))
+}
+
+// play runs the player until the user quits.
+func (p *player) play() {
+	p.t.Screen.SetStyle(p.t.defaultStyle)
+	p.setSegment(0)
+
+	done := make(chan bool)
+	defer close(done)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			time.Sleep(50 * time.Millisecond)
+			p.t.Screen.PostEventWait(nil)
+		}
+	}()
+
+	for {
+		elapsed := p.position()
+
+		// Move on to the next segment shortly after the last keystroke of this one.
+		if !p.paused && elapsed > p.end()+time.Second && p.segment < len(p.r.Segments)-1 {
+			p.setSegment(p.segment + 1)
+			elapsed = 0
+		} else if elapsed >= p.end() && !p.paused {
+			if p.segment == len(p.r.Segments)-1 {
+				p.setPosition(p.end())
+				p.paused = true
+			}
+		}
+
+		p.seek(elapsed)
+		if p.st.scroll() {
+			p.t.Screen.Clear()
+		}
+		p.t.redraw(p.st)
+		p.drawStatus(elapsed)
+		p.t.Screen.Show()
+
+		switch ev := p.t.Screen.PollEvent().(type) {
+		case *tcell.EventResize:
+			p.st = nil
+			p.t.Screen.Sync()
+			p.t.Screen.Clear()
+		case *tcell.EventKey:
+			switch ev.Key() {
+			case tcell.KeyEscape, tcell.KeyCtrlC:
+				return
+			case tcell.KeyLeft:
+				p.setPosition(p.position() - scrubStep)
+			case tcell.KeyRight:
+				p.setPosition(p.position() + scrubStep)
+			case tcell.KeyRune:
+				switch ev.Rune() {
+				case 'q':
+					return
+				case ' ':
+					if p.paused && p.position() >= p.end() {
+						p.setPosition(0) // Start again from the top.
+					} else {
+						p.setPosition(p.position())
+					}
+					p.paused = !p.paused
+				case '1', '2', '4':
+					p.setPosition(p.position())
+					p.speed = int(ev.Rune() - '0')
+				case 'n':
+					if p.segment < len(p.r.Segments)-1 {
+						p.setSegment(p.segment + 1)
+					}
+				case 'p':
+					if p.segment > 0 {
+						p.setSegment(p.segment - 1)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,496 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+const defaultRacePort = "7878"
+
+var raceUsage = `usage: tt race host [-port PORT] [-name NAME] [-n WORDS] [-words WORDFILE] [-seed SEED] [-theme THEME]
+       tt race join [-name NAME] [-theme THEME] HOST[:PORT]
+
+    host        Start a race which others on the network can join, press enter
+                to start it once everyone has joined.
+    join        Join the race started on HOST.
+`
+
+// racer is a participant in a race.
+type racer struct {
+	ID       int     `json:"id"`
+	Name     string  `json:"name"`
+	Pos      int     `json:"pos"` // The number of characters typed, not counting line breaks.
+	Finished bool    `json:"finished"`
+	Left     bool    `json:"left"`
+	Wpm      int     `json:"wpm"`
+	Accuracy float64 `json:"accuracy"`
+	Time     int64   `json:"time"` // The time taken to finish in milliseconds.
+}
+
+// raceMessage is sent between the racers and the host, one JSON object per line.
+type raceMessage struct {
+	Type      string  `json:"type"` // join, welcome, lobby, start, progress, finish, results or error.
+	Name      string  `json:"name,omitempty"`
+	Text      string  `json:"text,omitempty"`
+	Seed      int64   `json:"seed,omitempty"`
+	Countdown int     `json:"countdown,omitempty"`
+	Racer     racer   `json:"racer"`
+	Racers    []racer `json:"racers,omitempty"`
+}
+
+// raceServer distributes a test to the racers connected to it and relays their progress.
+type raceServer struct {
+	sync.Mutex
+
+	text    string
+	seed    int64
+	started bool
+	racers  []*racer
+	conns   map[int]*json.Encoder
+	nextID  int
+}
+
+// broadcast sends the message to every racer, the server must be locked.
+func (s *raceServer) broadcast(m raceMessage) {
+	for _, enc := range s.conns {
+		enc.Encode(m)
+	}
+}
+
+// racerList returns a copy of the racers, the server must be locked.
+func (s *raceServer) racerList() []racer {
+	var l []racer
+	for _, r := range s.racers {
+		l = append(l, *r)
+	}
+
+	return l
+}
+
+// rankedRacers orders the racers by the time they took to finish, followed by those who didn't
+// ordered by how far they got.
+func rankedRacers(racers []racer) []racer {
+	sort.SliceStable(racers, func(i, j int) bool {
+		a, b := racers[i], racers[j]
+		if a.Finished != b.Finished {
+			return a.Finished
+		}
+		if a.Finished {
+			return a.Time < b.Time
+		}
+		return a.Pos > b.Pos
+	})
+
+	return racers
+}
+
+// finishIfDone sends the results once every racer has finished or left, the server must be locked.
+func (s *raceServer) finishIfDone() {
+	for _, r := range s.racers {
+		if !r.Finished && !r.Left {
+			return
+		}
+	}
+
+	s.broadcast(raceMessage{Type: "results", Racers: rankedRacers(s.racerList())})
+}
+
+// serve handles a racer's connection until it is closed.
+func (s *raceServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	dec := json.NewDecoder(conn)
+	enc := json.NewEncoder(conn)
+
+	var m raceMessage
+	if err := dec.Decode(&m); err != nil || m.Type != "join" {
+		return
+	}
+
+	s.Lock()
+	if s.started {
+		enc.Encode(raceMessage{Type: "error", Text: "The race has already started."})
+		s.Unlock()
+		return
+	}
+
+	s.nextID++
+	r := &racer{ID: s.nextID, Name: m.Name}
+	s.racers = append(s.racers, r)
+	s.conns[r.ID] = enc
+	enc.Encode(raceMessage{Type: "welcome", Racer: *r})
+	s.broadcast(raceMessage{Type: "lobby", Racers: s.racerList()})
+	s.Unlock()
+
+	for {
+		var m raceMessage
+		err := dec.Decode(&m)
+
+		s.Lock()
+		if err != nil {
+			delete(s.conns, r.ID)
+			if s.started {
+				r.Left = true
+				s.finishIfDone()
+			} else {
+				for i := range s.racers {
+					if s.racers[i] == r {
+						s.racers = append(s.racers[:i], s.racers[i+1:]...)
+						break
+					}
+				}
+				s.broadcast(raceMessage{Type: "lobby", Racers: s.racerList()})
+			}
+			s.Unlock()
+			return
+		}
+
+		switch m.Type {
+		case "progress":
+			r.Pos = m.Racer.Pos
+			s.broadcast(raceMessage{Type: "progress", Racer: *r})
+		case "finish":
+			r.Pos = m.Racer.Pos
+			r.Finished = true
+			r.Wpm = m.Racer.Wpm
+			r.Accuracy = m.Racer.Accuracy
+			r.Time = m.Racer.Time
+			s.broadcast(raceMessage{Type: "progress", Racer: *r})
+			s.finishIfDone()
+		}
+		s.Unlock()
+	}
+}
+
+// start sends the test to every racer, beginning the countdown.
+func (s *raceServer) start() {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.started {
+		return
+	}
+
+	s.started = true
+	s.broadcast(raceMessage{Type: "start", Text: s.text, Seed: s.seed, Countdown: 3, Racers: s.racerList()})
+}
+
+// listen accepts racers on the given address in the background.
+func (s *raceServer) listen(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	return nil
+}
+
+// plainPosition converts a position in wrapped text to the number of characters before it, not
+// counting line breaks, which is the same whatever the width the text is wrapped to.
+func plainPosition(text []rune, pos int) int {
+	n := 0
+	for _, c := range text[:pos] {
+		if c != '\n' {
+			n++
+		}
+	}
+
+	return n
+}
+
+// wrappedPosition is the inverse of plainPosition.
+func wrappedPosition(text []rune, plain int) int {
+	for i, c := range text {
+		if c == '\n' {
+			continue
+		}
+		if plain == 0 {
+			return i
+		}
+		plain--
+	}
+
+	return len(text)
+}
+
+// raceCommand implements 'tt race' and returns the exit code.
+func raceCommand(args []string) int {
+	if len(args) == 0 {
+		os.Stderr.Write([]byte(raceUsage))
+		return 1
+	}
+
+	name := os.Getenv("USER")
+	if name == "" {
+		name = "anonymous"
+	}
+
+	fs := flag.NewFlagSet("race", flag.ExitOnError)
+	fs.Usage = func() { os.Stderr.Write([]byte(raceUsage)) }
+	fs.StringVar(&name, "name", name, "")
+	themeName := fs.String("theme", "default", "")
+
+	switch args[0] {
+	case "host":
+		port := fs.String("port", defaultRacePort, "")
+		numWords := fs.Int("n", 50, "")
+		wordFile := fs.String("words", "1000en", "")
+		seed := fs.Int64("seed", time.Now().UnixNano(), "")
+		fs.Parse(args[1:])
+
+		b := readResource("words", *wordFile)
+		if b == nil {
+			die("%s does not appear to be a valid word list. See '-list words' for a list of builtin word lists.", *wordFile)
+		}
+
+		rand.Seed(*seed)
+		server := &raceServer{
+			text:  randomText(*numWords, regexp.MustCompile("\\s+").Split(strings.TrimSpace(string(b)), -1)),
+			seed:  *seed,
+			conns: map[int]*json.Encoder{},
+		}
+
+		if err := server.listen(":" + *port); err != nil {
+			die("%v", err)
+		}
+
+		return raceClient("localhost:"+*port, name, *themeName, server)
+	case "join":
+		fs.Parse(args[1:])
+		if fs.NArg() != 1 {
+			fs.Usage()
+			return 1
+		}
+
+		addr := fs.Arg(0)
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addr = net.JoinHostPort(addr, defaultRacePort)
+		}
+
+		return raceClient(addr, name, *themeName, nil)
+	default:
+		os.Stderr.Write([]byte(raceUsage))
+		return 1
+	}
+}
+
+// raceClient takes part in the race at the given address, server being set if this is the host.
+func raceClient(addr, name, themeName string, server *raceServer) int {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		die("%v", err)
+	}
+	defer conn.Close()
+
+	enc := json.NewEncoder(conn)
+	dec := json.NewDecoder(conn)
+	enc.Encode(raceMessage{Type: "join", Name: name})
+
+	if scr, err = tcell.NewScreen(); err != nil {
+		panic(err)
+	}
+	if err := scr.Init(); err != nil {
+		panic(err)
+	}
+	defer scr.Fini()
+
+	typer := createTyper(scr, false, themeName, 0)
+
+	// Progress is tracked here as it arrives, everything else is handled by the event loop below.
+	var mu sync.Mutex
+	positions := map[int]int{}
+
+	go func() {
+		for {
+			var m raceMessage
+			if err := dec.Decode(&m); err != nil {
+				scr.PostEventWait(tcell.NewEventInterrupt(raceMessage{Type: "error", Text: "Lost connection to the host."}))
+				return
+			}
+
+			if m.Type == "progress" {
+				mu.Lock()
+				positions[m.Racer.ID] = m.Racer.Pos
+				mu.Unlock()
+				continue
+			}
+
+			scr.PostEventWait(tcell.NewEventInterrupt(m))
+		}
+	}()
+
+	host, _ := os.Hostname()
+	status := "Waiting for the host to start the race..."
+	if server != nil {
+		status = fmt.Sprintf("Others can join with 'tt race join %s'.\n\nPress enter to start the race.", host)
+	}
+
+	drawRaceScreen(typer, "Racers:\n\n\n"+status)
+
+	var self racer
+	var start raceMessage
+	for {
+		switch ev := scr.PollEvent().(type) {
+		case *tcell.EventResize:
+			scr.Sync()
+		case *tcell.EventKey:
+			switch ev.Key() {
+			case tcell.KeyEscape, tcell.KeyCtrlC:
+				return 1
+			case tcell.KeyEnter:
+				if server != nil && start.Type == "" {
+					server.start()
+				}
+			}
+		case *tcell.EventInterrupt:
+			m := ev.Data().(raceMessage)
+
+			switch m.Type {
+			case "error":
+				scr.Fini()
+				fmt.Fprintln(os.Stderr, m.Text)
+				return 1
+			case "welcome":
+				self = m.Racer
+			case "lobby":
+				var names []string
+				for _, r := range m.Racers {
+					names = append(names, r.Name)
+				}
+				drawRaceScreen(typer, "Racers:\n\n"+strings.Join(names, ", ")+"\n\n"+status)
+			case "start":
+				// The countdown is driven by the event loop so keys pressed during it are discarded.
+				start = m
+				go func() {
+					for i := m.Countdown; i >= 0; i-- {
+						scr.PostEventWait(tcell.NewEventInterrupt(raceMessage{Type: "countdown", Countdown: i}))
+						time.Sleep(time.Second)
+					}
+				}()
+			case "countdown":
+				if m.Countdown > 0 {
+					drawRaceScreen(typer, fmt.Sprintf("%d", m.Countdown))
+					break
+				}
+
+				if !race(typer, enc, start, self, &mu, positions) {
+					return 1
+				}
+
+				drawRaceScreen(typer, "Waiting for the other racers to finish...")
+			case "results":
+				drawRaceScreen(typer, formatRaceResults(m.Racers)+"\n\nPress space to exit.")
+				for {
+					if ev, ok := scr.PollEvent().(*tcell.EventKey); ok &&
+						(ev.Key() == tcell.KeyEscape || ev.Key() == tcell.KeyCtrlC || ev.Rune() == ' ') {
+						return 0
+					}
+				}
+			}
+		}
+	}
+}
+
+// race runs the test, drawing the other racers' carets and reporting progress to the host. It
+// returns false if the typist abandoned the race. A message which arrives during the race (the
+// connection being lost) ends it and is posted again for the caller to handle.
+func race(typer *TyperScreen, enc *json.Encoder, start raceMessage, self racer, mu *sync.Mutex, positions map[int]int) bool {
+	// Everyone starts the clock at the end of the countdown rather than on their first keystroke.
+	began := time.Now()
+
+	for {
+		wrapped := []rune(reflowText(scr, start.Text, 80))
+
+		typer.Rivals = nil
+		for _, r := range start.Racers {
+			if r.ID == self.ID {
+				continue
+			}
+
+			id := r.ID
+			typer.Rivals = append(typer.Rivals, rival{r.Name, func(int, time.Duration) int {
+				mu.Lock()
+				defer mu.Unlock()
+				return positions[id]
+			}})
+		}
+
+		typer.Progress = func(pos int) {
+			enc.Encode(raceMessage{Type: "progress", Racer: racer{Pos: plainPosition(wrapped, pos)}})
+		}
+
+		numErrors, numCorrect, returnCode, _, _, _, _, _, _ := typer.start(string(wrapped), -1, -1, -1, true, "", nil)
+		switch returnCode {
+		case TyperAppResize:
+			// As in a normal test the text is started again, rewrapped to the new size, but the
+			// clock keeps running.
+			scr.Sync()
+			continue
+		case TyperInterrupted:
+			scr.PostEventWait(tcell.NewEventInterrupt(typer.Interrupt))
+			return true
+		case UserCompleted:
+		default:
+			return false
+		}
+
+		duration := time.Since(began)
+		enc.Encode(raceMessage{Type: "finish", Racer: racer{
+			Pos:      plainPosition(wrapped, len(wrapped)),
+			Wpm:      int(float64(numCorrect) / 5 / (float64(duration) / 60e9)),
+			Accuracy: float64(numCorrect) / float64(numErrors+numCorrect) * 100,
+			Time:     duration.Milliseconds(),
+		}})
+
+		return true
+	}
+}
+
+// drawRaceScreen clears the screen and draws the message at its centre.
+func drawRaceScreen(typer *TyperScreen, msg string) {
+	scr.SetStyle(typer.defaultStyle)
+	scr.Clear()
+	drawStringAtCenter(scr, msg, typer.defaultStyle)
+	scr.HideCursor()
+	scr.Show()
+}
+
+// formatRaceResults formats the racers as a table in the order they finished.
+func formatRaceResults(racers []racer) string {
+	var b strings.Builder
+
+	w := tabwriter.NewWriter(&b, 0, 8, 3, ' ', 0)
+	fmt.Fprintf(w, "#\tName\tWPM\tAccuracy\tTime\n")
+	for i, r := range racers {
+		if r.Finished {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%.2f%%\t%.2fs\n", i+1, r.Name, r.Wpm, r.Accuracy, float64(r.Time)/1000)
+		} else {
+			fmt.Fprintf(w, "-\t%s\t-\t-\tDNF\n", r.Name)
+		}
+	}
+	w.Flush()
+
+	return strings.TrimRight(b.String(), "\n")
+}
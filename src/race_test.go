@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+)
+
+// testRacer is a scripted client connected to a race server.
+type testRacer struct {
+	t    *testing.T
+	conn net.Conn
+	enc  *json.Encoder
+	dec  *json.Decoder
+	self racer
+}
+
+func joinTestRace(t *testing.T, addr, name string) *testRacer {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &testRacer{t: t, conn: conn, enc: json.NewEncoder(conn), dec: json.NewDecoder(conn)}
+	r.enc.Encode(raceMessage{Type: "join", Name: name})
+	r.self = r.expect("welcome").Racer
+
+	return r
+}
+
+// expect skips messages until one of the given type arrives, and returns it.
+func (r *testRacer) expect(typ string) raceMessage {
+	r.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	for {
+		var m raceMessage
+		if err := r.dec.Decode(&m); err != nil {
+			r.t.Fatalf("waiting for %s: %v", typ, err)
+		}
+		if m.Type == typ {
+			return m
+		}
+	}
+}
+
+func startTestServer(t *testing.T, text string) (*raceServer, string) {
+	// Find a free port.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	s := &raceServer{text: text, conns: map[int]*json.Encoder{}}
+	if err := s.listen(addr); err != nil {
+		t.Fatal(err)
+	}
+
+	return s, addr
+}
+
+func TestRace(t *testing.T) {
+	s, addr := startTestServer(t, "the quick brown fox")
+
+	slow := joinTestRace(t, addr, "slow")
+	defer slow.conn.Close()
+	fast := joinTestRace(t, addr, "fast")
+	defer fast.conn.Close()
+	quitter := joinTestRace(t, addr, "quitter")
+
+	// Everyone is told about the last racer to join.
+	for _, r := range []*testRacer{slow, fast, quitter} {
+		for len(r.expect("lobby").Racers) != 3 {
+		}
+	}
+
+	s.start()
+	for _, r := range []*testRacer{slow, fast, quitter} {
+		if m := r.expect("start"); m.Text != "the quick brown fox" || len(m.Racers) != 3 {
+			t.Fatalf("unexpected start message %+v", m)
+		}
+	}
+
+	// Racers who join once the race has started are turned away.
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	json.NewEncoder(conn).Encode(raceMessage{Type: "join", Name: "late"})
+	var m raceMessage
+	if err := json.NewDecoder(conn).Decode(&m); err != nil || m.Type != "error" {
+		t.Fatalf("late racer got %+v, %v, expected an error", m, err)
+	}
+	conn.Close()
+
+	quitter.enc.Encode(raceMessage{Type: "progress", Racer: racer{Pos: 4}})
+	if m := slow.expect("progress"); m.Racer.ID != quitter.self.ID || m.Racer.Pos != 4 {
+		t.Fatalf("unexpected progress message %+v", m)
+	}
+	quitter.conn.Close()
+
+	slow.enc.Encode(raceMessage{Type: "finish", Racer: racer{Pos: 19, Wpm: 40, Accuracy: 100, Time: 6000}})
+	fast.enc.Encode(raceMessage{Type: "finish", Racer: racer{Pos: 19, Wpm: 80, Accuracy: 95, Time: 3000}})
+
+	for _, r := range []*testRacer{slow, fast} {
+		results := r.expect("results").Racers
+
+		var names []string
+		for _, racer := range results {
+			names = append(names, racer.Name)
+		}
+		if len(names) != 3 || names[0] != "fast" || names[1] != "slow" || names[2] != "quitter" {
+			t.Fatalf("expected the racers to be ranked fast, slow, quitter, got %v", names)
+		}
+		if !results[2].Left || results[2].Finished || results[2].Pos != 4 {
+			t.Errorf("expected quitter to have left at 4, got %+v", results[2])
+		}
+		if results[0].Wpm != 80 || results[0].Time != 3000 {
+			t.Errorf("expected fast's result to be relayed, got %+v", results[0])
+		}
+	}
+}
+
+func TestPlainPosition(t *testing.T) {
+	wrapped := []rune("the quick \nbrown \nfox")
+
+	for _, c := range []struct {
+		wrapped, plain int
+	}{
+		{0, 0},
+		{4, 4},
+		{11, 10},
+		{18, 16},
+		{len(wrapped), 19},
+	} {
+		if got := plainPosition(wrapped, c.wrapped); got != c.plain {
+			t.Errorf("plainPosition(%d) = %d, expected %d", c.wrapped, got, c.plain)
+		}
+		if got := wrappedPosition(wrapped, c.plain); got != c.wrapped {
+			t.Errorf("wrappedPosition(%d) = %d, expected %d", c.plain, got, c.wrapped)
+		}
+	}
+}
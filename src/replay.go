@@ -0,0 +1,262 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+// keystroke is a key pressed during a test.
+type keystroke struct {
+	Time int64         `json:"t"` // Milliseconds since the segment started.
+	Key  tcell.Key     `json:"key"`
+	Rune rune          `json:"rune,omitempty"`
+	Mod  tcell.ModMask `json:"mod,omitempty"`
+	Pos  int           `json:"pos"` // The position of the cursor once the keystroke was handled.
+}
+
+// replaySegment holds the text of a segment of a test and every keystroke made typing it.
+type replaySegment struct {
+	Text        string      `json:"text"`
+	Attribution string      `json:"attribution,omitempty"`
+	Keystrokes  []keystroke `json:"keystrokes"`
+}
+
+// replay is the record of a completed test stored in REPLAY_DIR.
+type replay struct {
+	Timestamp int64           `json:"timestamp"`
+	Wpm       int             `json:"wpm"`
+	Accuracy  float64         `json:"accuracy"`
+	Segments  []replaySegment `json:"segments"`
+}
+
+// bestReplay is the entry of the replay index for a text, the fastest replay of it.
+type bestReplay struct {
+	File string `json:"file"`
+	Wpm  int    `json:"wpm"`
+}
+
+// replayIndexPath returns the path of the index of the fastest replay of each text, which saves
+// reading every replay to find one.
+func replayIndexPath() string {
+	return filepath.Join(REPLAY_DIR, ".index")
+}
+
+// textKey identifies the text of the given segments in the replay index, ignoring differences in
+// whitespace.
+func textKey(segments []segment) string {
+	h := sha256.New()
+	for _, s := range segments {
+		// The same text is wrapped differently depending on the width of the screen.
+		h.Write([]byte(strings.Join(strings.Fields(s.Text), " ")))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// saveReplay writes the replay of a test of the given segments to a new file in REPLAY_DIR,
+// recording it in the index if it is the fastest run of them, and returns its path.
+func saveReplay(r replay, segments []segment) string {
+	os.MkdirAll(REPLAY_DIR, 0700)
+
+	path := filepath.Join(REPLAY_DIR, time.Unix(r.Timestamp, 0).Format("2006-01-02-150405")+".json")
+	for i := 1; ; i++ {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			break
+		}
+		path = filepath.Join(REPLAY_DIR, fmt.Sprintf("%s-%d.json", time.Unix(r.Timestamp, 0).Format("2006-01-02-150405"), i))
+	}
+
+	writeValue(path, r)
+
+	index := map[string]bestReplay{}
+	readValue(replayIndexPath(), &index)
+
+	key := textKey(segments)
+	if best, ok := index[key]; !ok || r.Wpm > best.Wpm {
+		index[key] = bestReplay{filepath.Base(path), r.Wpm}
+		writeValue(replayIndexPath(), index)
+	}
+
+	return path
+}
+
+// loadReplay reads the given replay file, or the replay of that name in REPLAY_DIR.
+func loadReplay(path string) (*replay, error) {
+	var r replay
+
+	err := readValue(path, &r)
+	if os.IsNotExist(err) && !strings.ContainsRune(path, os.PathSeparator) {
+		err = readValue(filepath.Join(REPLAY_DIR, path), &r)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.Segments) == 0 {
+		return nil, fmt.Errorf("%s contains no keystrokes", path)
+	}
+
+	return &r, nil
+}
+
+// generateTestFromReplay returns a test of the text of the given replay, so it can be raced.
+func generateTestFromReplay(path string) func() []segment {
+	r, err := loadReplay(path)
+	if err != nil {
+		die("%v", err)
+	}
+
+	return func() []segment {
+		var segments []segment
+		for _, s := range r.Segments {
+			segments = append(segments, segment{Text: s.Text, Attribution: s.Attribution})
+		}
+
+		return segments
+	}
+}
+
+// findGhost returns the replay to race given the value of -ghost. 'best' selects the fastest stored
+// replay of the same text, anything else is loaded with loadReplay (the test then being of its text,
+// see generateTestFromReplay). nil is returned if there is no replay of the text to race yet.
+func findGhost(name string, segments []segment) *replay {
+	if name != "best" {
+		r, err := loadReplay(name)
+		if err != nil {
+			die("%v", err)
+		}
+
+		return r
+	}
+
+	index := map[string]bestReplay{}
+	readValue(replayIndexPath(), &index)
+
+	best, ok := index[textKey(segments)]
+	if !ok {
+		return nil
+	}
+
+	r, err := loadReplay(filepath.Join(REPLAY_DIR, best.File))
+	if err != nil {
+		return nil
+	}
+
+	return r
+}
+
+// unwrapped returns a copy of the replay with the line breaks taken out of its text, and the
+// positions of its keystrokes adjusted to match, so it can be compared with a run of the same text
+// wrapped to a different width.
+func (r *replay) unwrapped() *replay {
+	u := *r
+	u.Segments = make([]replaySegment, len(r.Segments))
+
+	for i, s := range r.Segments {
+		text := []rune(s.Text)
+		u.Segments[i] = replaySegment{Text: strings.Replace(s.Text, "\n", "", -1), Attribution: s.Attribution}
+
+		for _, k := range s.Keystrokes {
+			if k.Pos <= len(text) {
+				k.Pos = plainPosition(text, k.Pos)
+			}
+			u.Segments[i].Keystrokes = append(u.Segments[i].Keystrokes, k)
+		}
+	}
+
+	return &u
+}
+
+// position returns the position in the given segment reached by the replay at the given time since
+// the segment started, -1 if the replay has no such segment.
+func (r *replay) position(segment int, elapsed time.Duration) int {
+	if segment >= len(r.Segments) {
+		return -1
+	}
+
+	pos := 0
+	for _, k := range r.Segments[segment].Keystrokes {
+		if k.Time > elapsed.Milliseconds() {
+			break
+		}
+		pos = k.Pos
+	}
+
+	return pos
+}
+
+// timeToReach returns how long after the start of the given segment the replay first reached the
+// given position, if it ever did.
+func timeToReach(s replaySegment, pos int) (time.Duration, bool) {
+	for _, k := range s.Keystrokes {
+		if k.Pos >= pos {
+			return time.Duration(k.Time) * time.Millisecond, true
+		}
+	}
+
+	return 0, false
+}
+
+// ghostMargin returns how far ahead of the ghost the typist was (negative if behind) when they
+// finished each segment of the run, in total. It reports false if the ghost never got as far.
+func ghostMargin(ghost *replay, run []replaySegment) (time.Duration, bool) {
+	var margin time.Duration
+
+	ghost = ghost.unwrapped()
+	run = (&replay{Segments: run}).unwrapped().Segments
+
+	for i, s := range run {
+		if i >= len(ghost.Segments) || len(s.Keystrokes) == 0 {
+			return 0, false
+		}
+
+		end := s.Keystrokes[len(s.Keystrokes)-1].Pos
+		ghostTime, ok := timeToReach(ghost.Segments[i], end)
+		if !ok {
+			return 0, false
+		}
+
+		runTime, _ := timeToReach(s, end)
+		margin += ghostTime - runTime
+	}
+
+	return margin, true
+}
+
+// pacePosition returns the position in the text reached by a typist with a constant speed of wpm
+// at the given time.
+func pacePosition(wpm int, elapsed time.Duration) int {
+	return int(elapsed.Minutes() * float64(wpm) * 5)
+}
+
+// timeAgainstPace returns how long the run spent ahead of and behind a typist with a constant
+// speed of wpm, sampling each segment of the run up to its last keystroke.
+func timeAgainstPace(wpm int, run []replaySegment) (ahead, behind time.Duration) {
+	const step = 10 * time.Millisecond
+	r := (&replay{Segments: run}).unwrapped()
+
+	for i, s := range run {
+		if len(s.Keystrokes) == 0 {
+			continue
+		}
+
+		end := time.Duration(s.Keystrokes[len(s.Keystrokes)-1].Time) * time.Millisecond
+		for t := time.Duration(0); t < end; t += step {
+			if pos, pace := r.position(i, t), pacePosition(wpm, t); pos > pace {
+				ahead += step
+			} else if pos < pace {
+				behind += step
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The statistics which can be shown above the text while typing, in their default order.
+var liveStatNames = []string{"wpm", "accuracy", "errors", "time", "progress"}
+
+// parseLiveStats parses the comma separated list of statistics given to -stats, 'all' selecting
+// every one of them.
+func parseLiveStats(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if s == "all" {
+		return liveStatNames, nil
+	}
+
+	var stats []string
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+
+		valid := false
+		for _, n := range liveStatNames {
+			if n == name {
+				valid = true
+			}
+		}
+
+		if !valid {
+			return nil, fmt.Errorf("%s is not a valid statistic, expected one of %s or all", name, strings.Join(liveStatNames, ", "))
+		}
+
+		stats = append(stats, name)
+	}
+
+	return stats, nil
+}
+
+// tally brings the running totals of correct and incorrect characters, words and line breaks up to
+// date with the cursor.
+// from is the first position which may have changed since the last call, only the characters
+// between it and the cursor (or where the cursor was last time) are looked at.
+func (st *typingState) tally(from int) {
+	if from > st.tallied {
+		from = st.tallied
+	}
+	if from < 0 {
+		from = 0
+	}
+
+	for i := from; i < st.tallied; i++ {
+		if st.referenceText[i] == '\n' {
+			st.numLineBreaks--
+			continue
+		}
+		if st.endsWord(i) {
+			st.numWords--
+		}
+
+		if st.talliedCorrect[i] {
+			st.numCorrect--
+		} else {
+			st.numErrors--
+		}
+	}
+
+	for i := from; i < st.cursorPositionInText; i++ {
+		if st.referenceText[i] == '\n' {
+			st.numLineBreaks++
+			continue
+		}
+		if st.endsWord(i) {
+			st.numWords++
+		}
+
+		st.talliedCorrect[i] = st.userTypedText[i] == st.referenceText[i]
+		if st.talliedCorrect[i] {
+			st.numCorrect++
+		} else {
+			st.numErrors++
+		}
+	}
+
+	st.tallied = st.cursorPositionInText
+}
+
+// formatClock formats a duration as minutes and seconds.
+func formatClock(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+
+	return fmt.Sprintf("%d:%02d", int(d.Minutes()), int(d.Seconds())%60)
+}
+
+// progress returns how far through the test the typist is, as a fraction of the time limit, the
+// word or character limit or otherwise the text.
+func (st *typingState) progress(elapsed time.Duration) float64 {
+	var p float64
+
+	switch {
+	case st.timeLimit != -1:
+		p = float64(elapsed) / float64(st.timeLimit)
+	case st.wordLimit != -1:
+		numWords, _ := st.countTyped()
+		p = float64(numWords) / float64(st.wordLimit)
+	case st.charLimit != -1:
+		p = float64(st.numCorrect+st.numErrors) / float64(st.charLimit)
+	case len(st.referenceText) > 0:
+		p = float64(st.cursorPositionInText) / float64(len(st.referenceText))
+	}
+
+	if p > 1 {
+		p = 1
+	}
+
+	return p
+}
+
+// drawStats draws the statistics selected with -stats on the line above the text. Those which
+// would give away mistakes are left out in blind mode.
+func (t *TyperScreen) drawStats(st *typingState) {
+	if len(t.Stats) == 0 || st.startTime.IsZero() {
+		return
+	}
+
+	elapsed := time.Since(st.startTime)
+	numErrors := st.numErrors + st.rejected
+
+	var fields []string
+	for _, name := range t.Stats {
+		if t.Blind && (name == "accuracy" || name == "errors") {
+			continue
+		}
+
+		switch name {
+		case "wpm":
+			wpm := 0
+			if elapsed > 1e7 { // Avoid flashing large numbers on test start.
+				wpm = int((float64(st.numCorrect) / 5) / (float64(elapsed) / 60e9))
+			}
+			fields = append(fields, fmt.Sprintf("WPM: %d", wpm))
+		case "accuracy":
+			accuracy := 100.0
+			if st.numCorrect+numErrors > 0 {
+				accuracy = float64(st.numCorrect) / float64(st.numCorrect+numErrors) * 100
+			}
+			fields = append(fields, fmt.Sprintf("Accuracy: %.1f%%", accuracy))
+		case "errors":
+			fields = append(fields, fmt.Sprintf("Errors: %d", numErrors))
+		case "time":
+			if st.timeLimit != -1 {
+				fields = append(fields, fmt.Sprintf("Time: %s (%s left)", formatClock(elapsed), formatClock(st.timeLimit-elapsed)))
+			} else {
+				fields = append(fields, fmt.Sprintf("Time: %s", formatClock(elapsed)))
+			}
+		case "progress":
+			fields = append(fields, fmt.Sprintf("Progress: %d%%", int(st.progress(elapsed)*100)))
+		}
+	}
+
+	panel := strings.Join(fields, "   ")
+	screenWidth, _ := t.Screen.Size()
+	y := st.yStartTopSideOfSideOfScreen - 2
+
+	for x := 0; x < screenWidth; x++ {
+		t.Screen.SetContent(x, y, ' ', nil, t.defaultStyle)
+	}
+	drawString(t.Screen, (screenWidth-len([]rune(panel)))/2, y, panel, -1, t.wpmStyle)
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestTally(t *testing.T) {
+	st := newTypingState("ab cd \nef", "", -1)
+
+	// typeText puts s in the text at the cursor, as handleKey would, and brings the totals up to date.
+	typeText := func(s string) {
+		from := st.cursorPositionInText
+		for _, c := range s {
+			st.userTypedText[st.cursorPositionInText] = c
+			st.cursorPositionInText++
+		}
+		st.tally(from)
+	}
+	erase := func(n int) {
+		st.cursorPositionInText -= n
+		st.tally(st.cursorPositionInText)
+	}
+	check := func(when string, correct, errors, words, row int) {
+		t.Helper()
+		if st.numCorrect != correct || st.numErrors != errors || st.numWords != words || st.cursorRow() != row {
+			t.Errorf("%s: got %d correct, %d errors, %d words on row %d, expected %d, %d, %d, %d",
+				when, st.numCorrect, st.numErrors, st.numWords, st.cursorRow(), correct, errors, words, row)
+		}
+	}
+
+	typeText("ax cd \ne")
+	check("after typing", 6, 1, 2, 1)
+
+	erase(6)
+	check("after erasing", 1, 1, 1, 0)
+
+	erase(1)
+	typeText("b cd \nef")
+	check("after correcting", 8, 0, 3, 1)
+
+	if numWords, numChars := st.countTyped(); numWords != 3 || numChars != 8 {
+		t.Errorf("countTyped() = %d, %d, expected 3, 8", numWords, numChars)
+	}
+
+	// Tallying again without the cursor moving changes nothing.
+	st.tally(0)
+	check("after tallying again", 8, 0, 3, 1)
+}
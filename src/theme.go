@@ -0,0 +1,430 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+// themeColours are the keys every theme must define.
+var themeColours = []string{"bgcol", "fgcol", "hicol", "hicol2", "hicol3", "errcol"}
+
+// optionalThemeColours are the keys a theme may define to style individual parts of the screen,
+// each one falls back to one of themeColours (see TyperScreen.setTheme).
+var optionalThemeColours = []string{"typedcol", "fixedcol", "attrcol", "timercol", "wpmcol", "cursorcol"}
+
+// themeColour is a colour defined by a theme together with the attributes of text drawn in it,
+// written in the theme as the colour followed by any of bold, underline and italic.
+type themeColour struct {
+	colour    tcell.Color
+	bold      bool
+	underline bool
+	italic    bool
+}
+
+// style returns the given style with its foreground set to the colour and its attributes added.
+func (c themeColour) style(s tcell.Style) tcell.Style {
+	s = s.Foreground(c.colour)
+	if c.bold {
+		s = s.Bold(true)
+	}
+	if c.underline {
+		s = s.Underline(true)
+	}
+	if c.italic {
+		s = s.Italic(true)
+	}
+
+	return s
+}
+
+// parseThemeColour parses a theme value of the form 'COLOUR [bold] [underline] [italic]', where COLOUR
+// is anything accepted by makeTcellColorFromHex.
+func parseThemeColour(value string) (themeColour, error) {
+	var c themeColour
+	var err error
+
+	colour, attrs := splitThemeValue(value)
+	if colour == "" {
+		return c, fmt.Errorf("no colour given")
+	}
+
+	if c.colour, err = makeTcellColorFromHex(colour); err != nil {
+		return c, fmt.Errorf("'%s' is not a valid colour (expected #rrggbb, #rgb, rgb(r, g, b) or a colour name)", colour)
+	}
+
+	for _, attr := range attrs {
+		switch attr {
+		case "bold":
+			c.bold = true
+		case "underline":
+			c.underline = true
+		case "italic":
+			c.italic = true
+		default:
+			return c, fmt.Errorf("'%s' is not an attribute (expected bold, underline or italic)", attr)
+		}
+	}
+
+	return c, nil
+}
+
+// splitThemeValue splits a theme value into the colour and the attributes following it.
+func splitThemeValue(value string) (colour string, attrs []string) {
+	value = strings.TrimSpace(value)
+
+	// rgb(r, g, b) may contain spaces.
+	if strings.HasPrefix(value, "rgb(") {
+		if i := strings.Index(value, ")"); i != -1 {
+			return value[:i+1], strings.Fields(value[i+1:])
+		}
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	return fields[0], fields[1:]
+}
+
+// themeEntry is a 'key: value' line of a theme file.
+type themeEntry struct {
+	value  string
+	line   int
+	source string // The name of the theme it was inherited from, empty for the theme itself.
+}
+
+// location describes where the entry was defined for use in error messages.
+func (e themeEntry) location() string {
+	if e.source == "" {
+		return fmt.Sprintf("line %d", e.line)
+	}
+
+	return fmt.Sprintf("line %d of %s", e.line, e.source)
+}
+
+// readThemeEntries adds the entries of the given theme file to entries, first adding those of the
+// theme named by its 'inherit' key (if any) so that the keys it defines itself take precedence.
+// Keys starting with '$' define palette colours which other keys can refer to.
+func readThemeEntries(b []byte, source string, inherited map[string]bool, entries map[string]themeEntry) []string {
+	var problems []string
+	own := map[string]themeEntry{}
+
+	isThemeKey := map[string]bool{"inherit": true}
+	for _, k := range append(themeColours, optionalThemeColours...) {
+		isThemeKey[k] = true
+	}
+
+	location := func(line int) string {
+		return themeEntry{line: line, source: source}.location()
+	}
+
+	for i, ln := range strings.Split(string(b), "\n") {
+		ln = strings.TrimSpace(ln)
+		if ln == "" || strings.HasPrefix(ln, "#") {
+			continue
+		}
+
+		a := strings.SplitN(ln, ":", 2)
+		if len(a) != 2 {
+			problems = append(problems, fmt.Sprintf("%s: '%s' is not of the form 'key: value'.", location(i+1), ln))
+			continue
+		}
+
+		key := strings.TrimSpace(a[0])
+		value := strings.TrimSpace(a[1])
+		if !isThemeKey[key] && !strings.HasPrefix(key, "$") {
+			problems = append(problems, fmt.Sprintf("%s: %s is not a theme key.", location(i+1), key))
+		} else if e, ok := own[key]; ok {
+			problems = append(problems, fmt.Sprintf("%s: %s is already defined on line %d.", location(i+1), key, e.line))
+		} else {
+			own[key] = themeEntry{value, i + 1, source}
+		}
+	}
+
+	if e, ok := own["inherit"]; ok {
+		if inherited[e.value] {
+			problems = append(problems, fmt.Sprintf("%s: inherit: %s inherits from itself.", e.location(), e.value))
+		} else if parent := readResource("themes", e.value); parent == nil {
+			problems = append(problems, fmt.Sprintf("%s: inherit: %s does not appear to be a valid theme.", e.location(), e.value))
+		} else {
+			inherited[e.value] = true
+			problems = append(problems, readThemeEntries(parent, e.value, inherited, entries)...)
+		}
+	}
+
+	for k, e := range own {
+		entries[k] = e
+	}
+
+	return problems
+}
+
+// resolvePalette replaces a palette reference ($name) at the start of a theme value with the
+// colour it refers to, keeping any attributes which follow it.
+func resolvePalette(value string, entries map[string]themeEntry) (string, error) {
+	for i := 0; ; i++ {
+		colour, attrs := splitThemeValue(value)
+		if !strings.HasPrefix(colour, "$") {
+			return value, nil
+		}
+
+		if i == len(entries) {
+			return "", fmt.Errorf("%s refers back to itself", colour)
+		}
+
+		e, ok := entries[colour]
+		if !ok {
+			return "", fmt.Errorf("%s is not defined", colour)
+		}
+
+		value = strings.Join(append([]string{e.value}, attrs...), " ")
+	}
+}
+
+// parseTheme parses the contents of a theme file, along with any themes it inherits from, into its
+// colours keyed by name. Every problem found is returned, prefixed with where it occurs.
+func parseTheme(b []byte) (map[string]themeColour, []string) {
+	colours := map[string]themeColour{}
+	entries := map[string]themeEntry{}
+	problems := readThemeEntries(b, "", map[string]bool{}, entries)
+
+	var keys []string
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := entries[keys[i]], entries[keys[j]]
+		if a.source != b.source {
+			return a.source < b.source
+		}
+		return a.line < b.line
+	})
+
+	for _, key := range keys {
+		e := entries[key]
+		if key == "inherit" {
+			continue
+		}
+
+		value, err := resolvePalette(e.value, entries)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s: %v.", e.location(), key, err))
+		} else if c, err := parseThemeColour(value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s: %v.", e.location(), key, err))
+		} else if (key == "bgcol" || key == "cursorcol") && (c.bold || c.underline || c.italic) {
+			problems = append(problems, fmt.Sprintf("%s: %s does not take attributes.", e.location(), key))
+		} else if !strings.HasPrefix(key, "$") {
+			colours[key] = c
+		}
+	}
+
+	for _, k := range themeColours {
+		if _, ok := entries[k]; !ok {
+			problems = append(problems, fmt.Sprintf("%s is not defined.", k))
+		}
+	}
+
+	return colours, problems
+}
+
+// loadTheme reads and parses the given theme, dying if it does not exist or is invalid.
+func loadTheme(themeName string) map[string]themeColour {
+	b := readResource("themes", themeName)
+	if b == nil {
+		die("%s does not appear to be a valid theme, try '-list themes' for a list of built in thems.", themeName)
+	}
+
+	colours, problems := parseTheme(b)
+	if len(problems) > 0 {
+		die("%s: %s", themeName, problems[0])
+	}
+
+	return colours
+}
+
+// newThemedTyper creates a typer which uses the given theme colours.
+func newThemedTyper(scr tcell.Screen, bold bool, colours map[string]themeColour) *TyperScreen {
+	t := createDefaultTyper(scr)
+	t.setTheme(bold, colours)
+	return t
+}
+
+var themesUsage = `usage: tt themes preview [THEME]
+       tt themes check FILE
+       tt themes import FILE [NAME]
+
+    preview     Render a sample test in each theme starting with THEME, use the
+                arrow keys to cycle through themes and escape to quit.
+    check       Validate the given theme file, reporting every problem found.
+    import      Create a theme called NAME (by default the name of the file)
+                in the user theme directory from a terminal colour scheme. FILE
+                may be an iTerm2 .itermcolors file, an Alacritty configuration
+                (YAML or TOML), a Windows Terminal scheme (JSON) or Xresources.
+`
+
+// themesCommand implements 'tt themes' and returns the exit code.
+func themesCommand(args []string) int {
+	if len(args) == 0 {
+		os.Stderr.Write([]byte(themesUsage))
+		return 1
+	}
+
+	switch args[0] {
+	case "preview":
+		name := ""
+		if len(args) > 1 {
+			name = args[1]
+		}
+		previewThemes(name)
+		return 0
+	case "check":
+		if len(args) != 2 {
+			os.Stderr.Write([]byte(themesUsage))
+			return 1
+		}
+		return checkTheme(args[1])
+	case "import":
+		if len(args) != 2 && len(args) != 3 {
+			os.Stderr.Write([]byte(themesUsage))
+			return 1
+		}
+
+		name := ""
+		if len(args) == 3 {
+			name = args[2]
+		}
+		return importTheme(args[1], name)
+	default:
+		os.Stderr.Write([]byte(themesUsage))
+		return 1
+	}
+}
+
+// checkTheme validates the given theme file (or theme name), printing every problem found.
+func checkTheme(path string) int {
+	b := readResource("themes", path)
+	if b == nil {
+		fmt.Fprintf(os.Stderr, "%s: no such theme or file.\n", path)
+		return 1
+	}
+
+	_, problems := parseTheme(b)
+	for _, p := range problems {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", path, p)
+	}
+
+	if len(problems) > 0 {
+		return 1
+	}
+
+	fmt.Printf("%s: ok\n", path)
+	return 0
+}
+
+// previewThemes renders a sample test in each available theme, starting with the given one.
+func previewThemes(first string) {
+	var names []string
+	idx := 0
+
+	for _, e := range listResources("themes") {
+		if e.shadowedBy == "" {
+			if e.name == first {
+				idx = len(names)
+			}
+			names = append(names, e.name)
+		}
+	}
+
+	// Allow previewing a theme file which isn't installed.
+	if first != "" && (len(names) == 0 || names[idx] != first) {
+		names = append([]string{first}, names...)
+		idx = 0
+	}
+
+	if len(names) == 0 {
+		die("No themes found.")
+	}
+
+	var err error
+	if scr, err = tcell.NewScreen(); err != nil {
+		panic(err)
+	}
+	if err := scr.Init(); err != nil {
+		panic(err)
+	}
+	defer scr.Fini()
+
+	t := createDefaultTyper(scr)
+	for {
+		drawThemePreview(t, names[idx], idx, len(names))
+
+		switch ev := scr.PollEvent().(type) {
+		case *tcell.EventResize:
+			scr.Sync()
+		case *tcell.EventKey:
+			switch ev.Key() {
+			case tcell.KeyRight, tcell.KeyDown:
+				idx = (idx + 1) % len(names)
+			case tcell.KeyLeft, tcell.KeyUp:
+				idx = (idx + len(names) - 1) % len(names)
+			case tcell.KeyEscape, tcell.KeyCtrlC:
+				return
+			case tcell.KeyRune:
+				if ev.Rune() == 'q' {
+					return
+				}
+			}
+		}
+	}
+}
+
+// drawThemePreview draws a partially typed sample test (including a mistake) in the given theme.
+func drawThemePreview(t *TyperScreen, name string, idx, numThemes int) {
+	scr := t.Screen
+	header := fmt.Sprintf("%s (%d/%d)", name, idx+1, numThemes)
+	footer := "left/right: change theme    esc: quit"
+
+	scr.SetStyle(tcell.StyleDefault)
+	scr.Clear()
+
+	b := readResource("themes", name)
+	colours, problems := parseTheme(b)
+	if b == nil || len(problems) > 0 {
+		if b == nil {
+			problems = []string{"no such theme or file."}
+		}
+		drawString(scr, 2, 1, header+"\n\n"+strings.Join(problems, "\n")+"\n\n"+footer, -1, tcell.StyleDefault)
+		scr.HideCursor()
+		scr.Show()
+		return
+	}
+
+	t.setTheme(false, degradeTheme(colours, scr.Colors()))
+	t.Stats = liveStatNames
+
+	st := newTypingState("the quick brown fox jumps over \nthe lazy dog and keeps running",
+		"A sample attribution", 30*time.Second)
+	st.startTime = time.Now().Add(-6 * time.Second)
+
+	typed := "the quick bworn f"
+	copy(st.userTypedText, []rune(typed))
+	st.cursorPositionInText = len(typed)
+	st.everMistyped[5] = true // Show a corrected character
+	st.tally(0)
+
+	scr.SetStyle(t.defaultStyle)
+	scr.Clear()
+	t.layout(st)
+
+	w, h := scr.Size()
+	drawString(scr, (w-len(header))/2, 1, header, -1, t.defaultStyle)
+	drawString(scr, (w-len(footer))/2, h-2, footer, -1, t.defaultStyle)
+
+	t.redraw(st)
+}
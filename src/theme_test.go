@@ -0,0 +1,97 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell"
+)
+
+const testTheme = `
+# A comment
+$red: #cc0000
+$accent: $red
+
+bgcol: #000
+fgcol: rgb(200, 200, 200)
+hicol: steelblue3 bold
+hicol2: gray50
+hicol3: $accent underline
+errcol: $red bold italic
+`
+
+func TestParseTheme(t *testing.T) {
+	colours, problems := parseTheme([]byte(testTheme))
+	if len(problems) != 0 {
+		t.Fatalf("unexpected problems: %v", problems)
+	}
+
+	for key, expected := range map[string]themeColour{
+		"bgcol":  {colour: tcell.NewHexColor(0x000000)},
+		"fgcol":  {colour: tcell.NewRGBColor(200, 200, 200)},
+		"hicol":  {colour: tcell.NewHexColor(0x4f94cd), bold: true},
+		"hicol2": {colour: tcell.NewHexColor(0x7f7f7f)},
+		"hicol3": {colour: tcell.NewHexColor(0xcc0000), underline: true},
+		"errcol": {colour: tcell.NewHexColor(0xcc0000), bold: true, italic: true},
+	} {
+		if colours[key] != expected {
+			t.Errorf("%s = %+v, expected %+v", key, colours[key], expected)
+		}
+	}
+
+	if _, ok := colours["$red"]; ok {
+		t.Errorf("palette colours shouldn't be returned as theme colours")
+	}
+}
+
+func TestParseThemeProblems(t *testing.T) {
+	_, problems := parseTheme([]byte(`
+bgcol: #000 bold
+fgcol: notacolour
+fgcol: #fff
+hicol: $missing
+hicol2: #fff sparkly
+hicol3
+wibble: #fff
+`))
+
+	for _, expected := range []string{
+		"line 2: bgcol does not take attributes.",
+		"line 3: fgcol: 'notacolour' is not a valid colour",
+		"line 4: fgcol is already defined on line 3.",
+		"line 5: hicol: $missing is not defined.",
+		"line 6: hicol2: 'sparkly' is not an attribute",
+		"line 7: 'hicol3' is not of the form 'key: value'.",
+		"line 8: wibble is not a theme key.",
+		"errcol is not defined.",
+	} {
+		found := false
+		for _, p := range problems {
+			if strings.HasPrefix(p, expected) {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf("expected a problem starting %q, got %q", expected, problems)
+		}
+	}
+}
+
+func TestResolvePalette(t *testing.T) {
+	entries := map[string]themeEntry{
+		"$a":    {value: "$b"},
+		"$b":    {value: "#123456"},
+		"$loop": {value: "$loop"},
+	}
+
+	if v, err := resolvePalette("$a bold", entries); err != nil || v != "#123456 bold" {
+		t.Errorf("resolvePalette($a bold) = %q, %v, expected #123456 bold", v, err)
+	}
+	if v, err := resolvePalette("#fff", entries); err != nil || v != "#fff" {
+		t.Errorf("resolvePalette(#fff) = %q, %v, expected it to be left alone", v, err)
+	}
+	if _, err := resolvePalette("$loop", entries); err == nil {
+		t.Errorf("expected a palette colour referring to itself to be an error")
+	}
+}
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// A terminal scheme holds the colours of a terminal colour scheme keyed the same way as
+// scripts/terminal_themes.json: background, foreground and color0 to color15 (all #rrggbb).
+type terminalScheme map[string]string
+
+// ansiColourNames are the names terminals use for color0 to color7 (color8 to color15 are the
+// bright versions).
+var ansiColourNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// blend mixes two colours, giving src the given opacity over dest (as scripts/themegen.py does).
+func blend(src, dest tcell.Color, opacity float64) tcell.Color {
+	sr, sg, sb := src.RGB()
+	dr, dg, db := dest.RGB()
+
+	mix := func(s, d int32) int32 {
+		return int32(float64(s)*opacity + float64(d)*(1-opacity))
+	}
+
+	return tcell.NewRGBColor(mix(sr, dr), mix(sg, dg), mix(sb, db))
+}
+
+// themeFromScheme derives a tt theme from a terminal colour scheme using the same mapping as
+// the bundled themes (see scripts/themegen.py).
+func themeFromScheme(s terminalScheme) (string, error) {
+	colours := map[string]tcell.Color{}
+	for _, k := range []string{"background", "foreground", "color1", "color7", "color9"} {
+		v, ok := s[k]
+		if !ok {
+			return "", fmt.Errorf("%s is not defined", k)
+		}
+
+		c, err := makeTcellColorFromHex(v)
+		if err != nil {
+			return "", fmt.Errorf("%s: %v", k, err)
+		}
+		colours[k] = c
+	}
+
+	hex := func(c tcell.Color) string {
+		return fmt.Sprintf("#%.6x", c.Hex())
+	}
+
+	return fmt.Sprintf("bgcol: %s\nfgcol: %s\nhicol: %s\nhicol2: %s\nhicol3: %s\nerrcol: %s\n",
+		hex(colours["background"]),
+		hex(colours["foreground"]),
+		hex(colours["color7"]),
+		hex(blend(colours["background"], colours["color9"], .3)),
+		hex(colours["color9"]),
+		hex(colours["color1"])), nil
+}
+
+// normalizeSchemeColour converts the colour formats used by terminal configuration files
+// (#rrggbb, 0xrrggbb, rgb:rr/gg/bb) to #rrggbb.
+func normalizeSchemeColour(v string) string {
+	if f := strings.Fields(v); len(f) > 0 {
+		v = strings.Trim(f[0], `"'`)
+	}
+
+	switch {
+	case strings.HasPrefix(v, "0x"):
+		return "#" + v[2:]
+	case strings.HasPrefix(v, "rgb:"):
+		return "#" + strings.Replace(v[4:], "/", "", -1)
+	}
+
+	return v
+}
+
+var iTermAnsiColour = regexp.MustCompile(`^Ansi (\d+) Color$`)
+
+// readITermScheme reads an iTerm2 .itermcolors file, a plist mapping names like 'Ansi 1 Color'
+// to dictionaries of colour components in the range 0-1.
+func readITermScheme(b []byte) (terminalScheme, error) {
+	s := terminalScheme{}
+	d := xml.NewDecoder(strings.NewReader(string(b)))
+
+	var path []string // The keys of the enclosing dictionaries.
+	var key, text string
+	components := map[string]float64{}
+
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			text = ""
+			if tok.Name.Local == "dict" {
+				path = append(path, key)
+				components = map[string]float64{}
+			}
+		case xml.CharData:
+			text += string(tok)
+		case xml.EndElement:
+			switch tok.Name.Local {
+			case "key":
+				key = strings.TrimSpace(text)
+			case "real", "integer":
+				components[key], _ = strconv.ParseFloat(strings.TrimSpace(text), 64)
+			case "dict":
+				name := path[len(path)-1]
+				path = path[:len(path)-1]
+
+				component := func(c string) int32 {
+					return int32(math.Round(components[c+" Component"] * 255))
+				}
+				hex := fmt.Sprintf("#%.6x", tcell.NewRGBColor(component("Red"), component("Green"), component("Blue")).Hex())
+
+				switch m := iTermAnsiColour.FindStringSubmatch(name); {
+				case name == "Background Color":
+					s["background"] = hex
+				case name == "Foreground Color":
+					s["foreground"] = hex
+				case m != nil:
+					s["color"+m[1]] = hex
+				}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// readAlacrittyScheme reads the colors section of an Alacritty configuration file in either
+// YAML (alacritty.yml) or TOML (alacritty.toml) form.
+func readAlacrittyScheme(b []byte) (terminalScheme, error) {
+	s := terminalScheme{}
+
+	// The section (e.g colors.normal) of each value is tracked using the indentation of YAML
+	// keys or the headings of TOML tables.
+	var sections []string
+	var indents []int
+
+	set := func(section []string, key, value string) {
+		if len(section) != 2 || section[0] != "colors" {
+			return
+		}
+
+		value = normalizeSchemeColour(value)
+		switch section[1] {
+		case "primary":
+			if key == "background" || key == "foreground" {
+				s[key] = value
+			}
+		case "normal", "bright":
+			for i, name := range ansiColourNames {
+				if key == name {
+					if section[1] == "bright" {
+						i += 8
+					}
+					s[fmt.Sprintf("color%d", i)] = value
+				}
+			}
+		}
+	}
+
+	for _, ln := range strings.Split(string(b), "\n") {
+		if i := strings.Index(ln, "#"); i != -1 && !strings.Contains(ln[:i], "'") && !strings.Contains(ln[:i], `"`) {
+			ln = ln[:i]
+		}
+		if strings.TrimSpace(ln) == "" {
+			continue
+		}
+
+		trimmed := strings.TrimSpace(ln)
+		indent := len(ln) - len(strings.TrimLeft(ln, " "))
+
+		if strings.HasPrefix(trimmed, "[") {
+			sections = strings.Split(strings.Trim(trimmed, "[] "), ".")
+			indents = nil
+			continue
+		}
+
+		sep := ":"
+		if strings.Contains(trimmed, "=") {
+			sep = "="
+		}
+
+		a := strings.SplitN(trimmed, sep, 2)
+		if len(a) != 2 {
+			continue
+		}
+		key, value := strings.TrimSpace(a[0]), strings.TrimSpace(a[1])
+
+		if sep == ":" {
+			for len(indents) > 0 && indents[len(indents)-1] >= indent {
+				indents = indents[:len(indents)-1]
+				sections = sections[:len(sections)-1]
+			}
+
+			if value == "" {
+				sections = append(sections, key)
+				indents = append(indents, indent)
+				continue
+			}
+		}
+
+		set(sections, key, value)
+	}
+
+	return s, nil
+}
+
+// readWindowsTerminalScheme reads a Windows Terminal colour scheme, either on its own or from
+// the schemes of a settings file in which case the one with the given name (or else the first)
+// is used. The name of the scheme is also returned.
+func readWindowsTerminalScheme(b []byte, name string) (terminalScheme, string, error) {
+	var settings struct {
+		Schemes []map[string]string `json:"schemes"`
+	}
+	var scheme map[string]string
+
+	if err := json.Unmarshal(b, &settings); err == nil && len(settings.Schemes) > 0 {
+		scheme = settings.Schemes[0]
+		for _, sch := range settings.Schemes {
+			if sch["name"] == name {
+				scheme = sch
+			}
+		}
+	} else if err := json.Unmarshal(b, &scheme); err != nil {
+		return nil, "", err
+	}
+
+	// Schemes in the format of scripts/terminal_themes.json need no conversion.
+	if _, ok := scheme["color1"]; ok {
+		return terminalScheme(scheme), scheme["name"], nil
+	}
+
+	s := terminalScheme{
+		"background": scheme["background"],
+		"foreground": scheme["foreground"],
+	}
+
+	for i, n := range ansiColourNames {
+		if n == "magenta" {
+			n = "purple"
+		}
+
+		s[fmt.Sprintf("color%d", i)] = scheme[n]
+		s[fmt.Sprintf("color%d", i+8)] = scheme["bright"+strings.ToUpper(n[:1])+n[1:]]
+	}
+
+	for k, v := range s {
+		if v == "" {
+			delete(s, k)
+		}
+	}
+
+	return s, scheme["name"], nil
+}
+
+// readXresourcesScheme reads the colours from an Xresources file (e.g '*.color1: #cc241d' or
+// 'URxvt.background: #282828'), expanding any #define'd names.
+func readXresourcesScheme(b []byte) (terminalScheme, error) {
+	s := terminalScheme{}
+	defines := map[string]string{}
+	resource := regexp.MustCompile(`^(?:.*[.*])?(background|foreground|color\d+)$`)
+
+	for _, ln := range strings.Split(string(b), "\n") {
+		ln = strings.TrimSpace(ln)
+
+		if strings.HasPrefix(ln, "#define") {
+			if f := strings.Fields(ln); len(f) == 3 {
+				defines[f[1]] = f[2]
+			}
+			continue
+		}
+
+		a := strings.SplitN(ln, ":", 2)
+		if strings.HasPrefix(ln, "!") || len(a) != 2 {
+			continue
+		}
+
+		m := resource.FindStringSubmatch(strings.TrimSpace(a[0]))
+		if m == nil {
+			continue
+		}
+
+		value := strings.TrimSpace(a[1])
+		if v, ok := defines[value]; ok {
+			value = v
+		}
+		s[m[1]] = normalizeSchemeColour(value)
+	}
+
+	return s, nil
+}
+
+var alacrittyColours = regexp.MustCompile(`(?m)^(colors:|\[colors)`)
+
+// readTerminalScheme reads a terminal colour scheme in any of the supported formats, which is
+// determined from the file's extension or failing that its contents. The name of the scheme is
+// returned if the file defines one.
+func readTerminalScheme(path string, b []byte, name string) (terminalScheme, string, error) {
+	content := strings.TrimSpace(string(b))
+
+	switch ext := strings.ToLower(filepath.Ext(path)); {
+	case ext == ".itermcolors" || strings.HasPrefix(content, "<?xml"):
+		s, err := readITermScheme(b)
+		return s, "", err
+	case ext == ".json" || strings.HasPrefix(content, "{"):
+		return readWindowsTerminalScheme(b, name)
+	case ext == ".yml" || ext == ".yaml" || ext == ".toml" || alacrittyColours.MatchString(content):
+		s, err := readAlacrittyScheme(b)
+		return s, "", err
+	default:
+		s, err := readXresourcesScheme(b)
+		return s, "", err
+	}
+}
+
+// importTheme converts the given terminal colour scheme to a tt theme called name (by default the
+// name of the scheme or file) in the user theme directory.
+func importTheme(path, name string) int {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 1
+	}
+
+	scheme, schemeName, err := readTerminalScheme(path, b, name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		return 1
+	}
+
+	theme, err := themeFromScheme(scheme)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v (is it a supported colour scheme?)\n", path, err)
+		return 1
+	}
+
+	if name == "" {
+		name = schemeName
+	}
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	name = strings.ToLower(strings.Join(strings.Fields(name), "-"))
+
+	// The name may come from the scheme itself, so it mustn't be able to point outside the directory.
+	if name == "" || strings.Contains(name, "..") || strings.ContainsAny(name, `/\`) {
+		fmt.Fprintf(os.Stderr, "%q is not a valid theme name, give another after the file.\n", name)
+		return 1
+	}
+
+	dir := filepath.Join(CONFIG_DIRS[0], "themes")
+	dest := filepath.Join(dir, name)
+
+	if _, err := os.Stat(dest); err == nil {
+		fmt.Fprintf(os.Stderr, "%s already exists, remove it or choose another name.\n", dest)
+		return 1
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	if err := os.WriteFile(dest, []byte(theme), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Wrote %s, use it with -theme %s\n", dest, name)
+	return 0
+}
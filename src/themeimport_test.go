@@ -0,0 +1,86 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadAlacrittyScheme(t *testing.T) {
+	expected := terminalScheme{
+		"background": "#282828",
+		"foreground": "#ebdbb2",
+		"color1":     "#cc241d",
+		"color2":     "#98971a",
+		"color9":     "#fb4934",
+	}
+
+	for name, config := range map[string]string{
+		"yaml": `
+window:
+  opacity: 0.9
+colors:
+  primary:
+    background: '0x282828' # dark
+    foreground: '0xebdbb2'
+  normal:
+    red:   '0xcc241d'
+    green: '0x98971a'
+  bright:
+    red:   '0xfb4934'
+  cursor:
+    text: '0x000000'
+`,
+		"toml": `
+[window]
+opacity = 0.9
+
+[colors.primary]
+background = "#282828"
+foreground = "#ebdbb2"
+
+[colors.normal]
+red = "#cc241d"
+green = "#98971a"
+
+[colors.bright]
+red = "#fb4934"
+
+[colors.cursor]
+text = "#000000"
+`,
+	} {
+		s, err := readAlacrittyScheme([]byte(config))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(s, expected) {
+			t.Errorf("%s: got %v, expected %v", name, s, expected)
+		}
+	}
+}
+
+func TestReadXresourcesScheme(t *testing.T) {
+	s, err := readXresourcesScheme([]byte(`
+! A comment
+#define red #cc241d
+*.background: #282828
+URxvt.foreground: rgb:eb/db/b2
+*color1: red
+*.color9:     #fb4934
+URxvt.font: xft:mono
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := terminalScheme{
+		"background": "#282828",
+		"foreground": "#ebdbb2",
+		"color1":     "#cc241d",
+		"color9":     "#fb4934",
+	}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("got %v, expected %v", s, expected)
+	}
+}
@@ -7,9 +7,9 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"regexp"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/gdamore/tcell"
@@ -21,11 +21,19 @@ var csvMode bool
 var jsonMode bool
 
 type result struct {
-	Wpm       int       `json:"wpm"`
-	Cpm       int       `json:"cpm"`
-	Accuracy  float64   `json:"accuracy"`
-	Timestamp int64     `json:"timestamp"`
-	Mistakes  []mistake `json:"mistakes"`
+	Wpm          int       `json:"wpm"`
+	Cpm          int       `json:"cpm"`
+	Accuracy     float64   `json:"accuracy"`
+	Timestamp    int64     `json:"timestamp"`
+	Mistakes     []mistake `json:"mistakes"`
+	TerminatedBy string    `json:"terminated_by"`
+	Consistency  float64   `json:"consistency"`
+	Failed       bool      `json:"failed"` // Whether the test ended by -suddendeath or -minacc.
+
+	// The speed and accuracy of each finger, hand and row, with -keyboard or -layout.
+	Fingers []keyGroupStats `json:"fingers,omitempty"`
+	Hands   []keyGroupStats `json:"hands,omitempty"`
+	Rows    []keyGroupStats `json:"rows,omitempty"`
 }
 
 func die(format string, args ...interface{}) {
@@ -48,9 +56,13 @@ func parseConfig(b []byte) map[string]string {
 
 	cfg := map[string]string{}
 	for _, ln := range bytes.Split(b, []byte("\n")) {
+		if bytes.HasPrefix(bytes.TrimSpace(ln), []byte("#")) {
+			continue
+		}
+
 		a := strings.SplitN(string(ln), ":", 2)
 		if len(a) == 2 {
-			cfg[a[0]] = strings.Trim(a[1], " ")
+			cfg[strings.TrimSpace(a[0])] = strings.Trim(a[1], " ")
 		}
 	}
 
@@ -77,10 +89,18 @@ func exit(rc int) {
 
 	if csvMode {
 		for _, r := range globalResults {
-			fmt.Printf("test,%d,%d,%.2f,%d\n", r.Wpm, r.Cpm, r.Accuracy, r.Timestamp)
+			fmt.Printf("test,%d,%d,%.2f,%d,%s\n", r.Wpm, r.Cpm, r.Accuracy, r.Timestamp, r.TerminatedBy)
 			for _, m := range r.Mistakes {
 				fmt.Printf("mistake,%s,%s\n", m.Word, m.Typed)
 			}
+			for _, groups := range []struct {
+				typ    string
+				groups []keyGroupStats
+			}{{"finger", r.Fingers}, {"hand", r.Hands}, {"row", r.Rows}} {
+				for _, g := range groups.groups {
+					fmt.Printf("%s,%s,%d,%.2f\n", groups.typ, g.Name, g.Wpm, g.Accuracy)
+				}
+			}
 		}
 	}
 
@@ -94,12 +114,25 @@ func showReport(
 	incorrectChars int,
 	attribution string,
 	mistakes []mistake,
+	terminatedBy string,
+	breakdown keyBreakdown,
+	timeline []secondStats,
+	chars []typedChar,
+	inlineDiff bool,
+	notes []string,
+	styles mistakeStyles,
 ) {
 	cpm := int(float64(correctChars) / (float64(duration) / 60e9))
 	wpm := cpm / 5
-	accuracy := float64(correctChars) / float64(incorrectChars+correctChars) * 100
+	accuracy := 0.0
+	if correctChars+incorrectChars > 0 {
+		accuracy = float64(correctChars) / float64(incorrectChars+correctChars) * 100
+	}
+	samples := wpmSamples(timeline, duration)
 
-	globalResults = append(globalResults, result{wpm, cpm, accuracy, time.Now().Unix(), mistakes})
+	globalResults = append(globalResults, result{wpm, cpm, accuracy, time.Now().Unix(), mistakes, terminatedBy,
+		consistency(samples), terminatedBy == FailedBySuddenDeath || terminatedBy == FailedByMinAccuracy,
+		breakdown.Fingers, breakdown.Hands, breakdown.Rows})
 
 	mistakeStr := ""
 	if attribution != "" {
@@ -129,30 +162,101 @@ func showReport(
 	}
 
 	// Integrate the formatted duration into the report string
+	notesStr := ""
+	for _, n := range notes {
+		notesStr += "\n" + n
+	}
+
 	report := fmt.Sprintf("WPM: %9d\n"+
 		"CPM: %9d\n"+
 		"Duration: %s\n"+
-		"Accuracy: %9.2f%%%s%s%s",
-		wpm, cpm, durationStr, accuracy,
+		"Accuracy: %9.2f%%\n"+
+		"Consistency: %6.2f%%%s%s%s%s",
+		wpm, cpm, durationStr, accuracy, consistency(samples), notesStr,
 		mistakeStr, attribution, globalInfoAboutTheCurrentTest)
 
 	report = fmt.Sprintf("%s\n", report)
 	report = fmt.Sprintf("%s\nTests completed : %d", report, len(globalResults))
 	report = fmt.Sprintf("%s\nCharacters      : %d", report, correctChars+incorrectChars)
-	report = fmt.Sprintf("%s\n\nPress SPACE to continue.", report)
+	if s := breakdown.String(); s != "" {
+		report = fmt.Sprintf("%s\n\n%s", report, s)
+	}
+
+	footer := "Press SPACE to continue."
+	if len(chars) > 0 {
+		footer = "Press SPACE to continue or d to view the whole text."
+	}
+
+	draw := func() {
+		scr.Clear()
+
+		// The diff of the text (as much of it as fits) and the chart go between the report and the
+		// footer, the chart only if there is room for it.
+		sw, sh := scr.Size()
+		nc, nr := calcStringDimensions(report)
+		width := 60
+		if width < nc {
+			width = nc
+		}
+		if width > sw-4 {
+			width = sw - 4
+		}
+
+		height := nr + 2
+		var lines [][]typedChar
+		if inlineDiff {
+			lines = diffLines(chars, width)
+			if max := (sh - height - 1) / 2; len(lines) > max && max >= 0 {
+				lines = lines[:max]
+			}
+			if len(lines) > 0 {
+				height += len(lines)*2 + 1
+			}
+		}
+
+		showChart := len(samples) > 1 && height+chartHeight+1 <= sh && sw >= 40
+		if showChart {
+			height += chartHeight + 1
+		}
+
+		y := (sh - height) / 2
+		if y < 0 {
+			y = 0
+		}
+
+		drawString(scr, (sw-nc)/2, y, report, -1, tcell.StyleDefault)
+		y += nr + 1
+		if len(lines) > 0 {
+			drawDiff(scr, (sw-width)/2, y, width, lines, styles)
+			y += len(lines)*2 + 1
+		}
+		if showChart {
+			drawChart(scr, (sw-width)/2, y, width, samples, timeline, duration,
+				tcell.StyleDefault, styles.incorrect)
+			y += chartHeight + 1
+		}
+		drawString(scr, (sw-nc)/2, y, footer, -1, tcell.StyleDefault)
+
+		scr.HideCursor()
+		scr.Show()
+	}
 
-	scr.Clear()
-	drawStringAtCenter(scr, report, tcell.StyleDefault)
-	scr.HideCursor()
-	scr.Show()
+	draw()
 
 	for {
 		event := scr.PollEvent()
 		switch ev := event.(type) {
+		case *tcell.EventResize:
+			draw()
 		case *tcell.EventKey:
 			key := ev.Key()
 			if key == tcell.KeyRune && ev.Rune() == ' ' {
 				return
+			} else if key == tcell.KeyRune && ev.Rune() == 'd' && len(chars) > 0 {
+				if viewDiff(scr, chars, styles) {
+					return
+				}
+				draw()
 			} else if key == tcell.KeyCtrlC {
 				exit(1)
 			}
@@ -160,6 +264,23 @@ func showReport(
 	}
 }
 
+// reflowText wraps the text to lines of at most maxLineLength characters, or the width of the
+// screen if it is narrower, collapsing runs of whitespace.
+func reflowText(scr tcell.Screen, inputText string, maxLineLength int) string {
+	screenWidth, _ := scr.Size()
+	// Adjust window size based on screen size
+	windowSize := maxLineLength
+	if windowSize > screenWidth {
+		windowSize = screenWidth - 8
+	}
+
+	// Replace multiple spaces with single space
+	inputText = regexp.MustCompile("\\s+").ReplaceAllString(inputText, " ")
+	return strings.Replace(
+		wordWrap(strings.Trim(inputText, " "), windowSize),
+		"\n", " \n", -1)
+}
+
 func createDefaultTyper(scr tcell.Screen) *TyperScreen {
 	return NewTyper(scr, true, tcell.ColorDefault,
 		tcell.ColorDefault,
@@ -169,41 +290,23 @@ func createDefaultTyper(scr tcell.Screen) *TyperScreen {
 		tcell.ColorMaroon)
 }
 
-func createTyper(scr tcell.Screen, bold bool, themeName string) *TyperScreen {
-	var theme map[string]string
-
-	if b := readResource("themes", themeName); b == nil {
-		die("%s does not appear to be a valid theme, try '-list themes' for a list of built in thems.", themeName)
-	} else {
-		theme = parseConfig(b)
-	}
-
-	var bgcol, fgcol, hicol, hicol2, hicol3, errcol tcell.Color
-	var err error
-
-	if bgcol, err = makeTcellColorFromHex(theme["bgcol"]); err != nil {
-		die("bgcol is not defined and/or a valid hex colour.")
-	}
-	if fgcol, err = makeTcellColorFromHex(theme["fgcol"]); err != nil {
-		die("fgcol is not defined and/or a valid hex colour.")
-	}
-	if hicol, err = makeTcellColorFromHex(theme["hicol"]); err != nil {
-		die("hicol is not defined and/or a valid hex colour.")
-	}
-	if hicol2, err = makeTcellColorFromHex(theme["hicol2"]); err != nil {
-		die("hicol2 is not defined and/or a valid hex colour.")
-	}
-	if hicol3, err = makeTcellColorFromHex(theme["hicol3"]); err != nil {
-		die("hicol3 is not defined and/or a valid hex colour.")
-	}
-	if errcol, err = makeTcellColorFromHex(theme["errcol"]); err != nil {
-		die("errcol is not defined and/or a valid hex colour.")
+// createTyper creates a typer using the given theme, with its colours mapped onto the terminal's
+// palette if it has fewer than numColours (0 meaning detect what the terminal supports).
+func createTyper(scr tcell.Screen, bold bool, themeName string, numColours int) *TyperScreen {
+	if numColours == 0 {
+		numColours = scr.Colors()
 	}
 
-	return NewTyper(scr, bold, fgcol, bgcol, hicol, hicol2, hicol3, errcol)
+	return newThemedTyper(scr, bold, degradeTheme(loadTheme(themeName), numColours))
 }
 
 var usage = `usage: tt [options] [file]
+       tt themes preview [THEME]
+       tt themes check FILE
+       tt themes import FILE [NAME]
+       tt race host [-port PORT] [-name NAME] [-n WORDS] [-words WORDFILE]
+       tt race join [-name NAME] HOST[:PORT]
+       tt replay [-speed SPEED] FILE
 
 Modes
     -words  WORDFILE    Specifies the file from which words are randomly
@@ -222,41 +325,119 @@ File Mode
     -start PARAGRAPH    The offset of the starting paragraph, set this to 0 to
                         reset progress on a given file.
 Aesthetics
-    -showwpm            Display WPM whilst typing.
+    -showwpm            Display WPM whilst typing (same as -stats wpm).
+    -stats LIST         Display the given statistics above the text whilst
+                        typing, LIST is a comma separated list of wpm,
+                        accuracy, errors, time and progress or 'all'.
     -reader-mode        In reader mode, allow to have skip through text using space
     -theme THEMEFILE    The theme to use. 
     -w                  The maximum line length in characters. This option is 
     -notheme            Attempt to use the default terminal theme. 
                         This may produce odd results depending 
                         on the theme colours.
+    -colors DEPTH       The number of colours the terminal supports, theme
+                        colours are mapped to the nearest available ones.
+                        DEPTH=[auto|truecolor|256|16|8] (default: auto).
     -blockcursor        Use the default cursor style.
     -bold               Embolden typed text.
                         ignored if -raw is present.
+    -lines N            The number of lines of text shown at once, the text
+                        scrolls as you type (default: as many as fit).
+    -keyboard LAYOUT    Show a keyboard below the text with the next key to
+                        press highlighted and keys coloured by finger.
+                        LAYOUT=[qwerty|qwertz|azerty|dvorak|colemak]
 Test Parameters
     -t SECONDS          Terminate the test after the given number of seconds.
+                        More text is drawn from the source as needed, so only
+                        the clock ends a timed test.
+    -wordcount WORDS    Terminate the test once the given number of words have
+                        been typed.
+    -charcount CHARS    Terminate the test once the given number of characters
+                        have been typed.
     -noskip             Disable word skipping when space is pressed.
     -nobackspace        Disable the backspace key.
+    -strict             Don't advance past a mistyped character, the error is
+                        counted but the right character must be typed (words
+                        can't be skipped either).
+    -stoponword         Don't allow leaving a word which contains errors, they
+                        must be corrected before typing the following space.
+    -blind              Don't show whether characters were typed correctly
+                        whilst typing (nor the accuracy and errors of -stats),
+                        the report shows the text with the mistakes
+                        highlighted instead.
+    -suddendeath        Fail the test on the first error.
+    -minacc N           Fail the test as soon as the accuracy drops below N
+                        percent, once the first 20 characters have been typed
+                        (or the test is completed). Failed tests are retried,
+                        and with -oneshot tt exits with status 2.
     -nohighlight        Disable current and next word highlighting.
     -highlight1         Only highlight the current word.
     -highlight2         Only highlight the next word.
+    -ghost REPLAY       Race a previous run, drawn as a second caret moving
+                        through the text as it did. REPLAY is a replay file,
+                        whose text is typed in place of any other, or 'best'
+                        for the fastest run of the same text, if it has been
+                        typed before. Every completed test is saved as a
+                        replay.
+    -pace WPM           Draw a caret moving through the text at the given
+                        speed and report how long you spent ahead of and
+                        behind it.
+    -layout LAYOUT      Emulate the given keyboard layout on a QWERTY keyboard,
+                        so keys type what they would in LAYOUT regardless of
+                        the layout set in the OS (e.g -layout colemak).
 
 Scripting
     -oneshot            Automatically exit after a single run.
     -noreport           Don't show a report at the end of a test.
     -csv                Print the test results to stdout in the form:
-                        [type],[wpm],[cpm],[accuracy],[timestamp].
+                        [type],[wpm],[cpm],[accuracy],[timestamp],[end]
+                        where [end] is what ended the test (text, time,
+                        words, chars, or suddendeath and minacc for failed
+                        tests), followed (with -keyboard or -layout) by a
+                        [finger|hand|row],[name],[wpm],[accuracy] line for
+                        each finger, hand and row used.
     -json               Print the test output in JSON.
     -raw                Don't reflow STDIN text or show one paragraph at a time.
                         Note that line breaks are determined exclusively by the
                         input.
     -multi              Treat each input paragraph as a self contained test.
 
+Themes
+    tt themes preview   Render a sample test in each theme, cycling through
+                        them with the arrow keys.
+    tt themes check     Validate a theme file and report every problem found.
+    tt themes import    Create a theme from an iTerm2, Alacritty, Windows
+                        Terminal or Xresources colour scheme.
+
+Races
+    tt race host        Host a race on the local network (port 7878 by
+                        default), press enter to start it once everyone
+                        has joined.
+    tt race join HOST   Join the race hosted on HOST. Every racer types the
+                        same text and sees the others' carets as they go.
+
+Replays
+    tt replay FILE      Play back a test saved in the replay directory (see
+                        -ghost) as it was typed, at 1x, 2x or 4x speed with
+                        space to pause and the arrow keys to skip.
+
 Misc
-    -list TYPE          Lists internal resources of the given type.
-                        TYPE=[themes|quotes|words]
+    -profile NAME       Use the options of the named profile in the
+                        configuration file (see Configuration).
+    -list TYPE          Lists the builtin and user resources of the given type
+                        along with where each one comes from.
+                        TYPE=[themes|quotes|words|layouts]
 
 Version
     -v                  Print the current version.
+
+Configuration
+    Default values for any of the options above can be given in a file called
+    $XDG_CONFIG_HOME/tt/config (~/.config/tt/config if XDG_CONFIG_HOME is
+    unset) or ~/.tt/config, one 'option: value' per line.
+    Options prefixed with a profile name (e.g 'sprint.t: 30') only apply when
+    that profile is selected with -profile or a 'profile: NAME' line. Options
+    given on the command line always take precedence.
 `
 
 func saveMistakes(mistakes []mistake) {
@@ -273,6 +454,18 @@ func saveMistakes(mistakes []mistake) {
 // main execution point
 func main() {
 
+	// Subcommands
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "themes":
+			os.Exit(themesCommand(os.Args[2:]))
+		case "race":
+			os.Exit(raceCommand(os.Args[2:]))
+		case "replay":
+			os.Exit(replayCommand(os.Args[2:]))
+		}
+	}
+
 	// Word configuration variables
 	var wordCount int
 	var groupCount int
@@ -286,11 +479,19 @@ func main() {
 	var noSkip bool
 	var readerMode bool
 	var disableBackspace bool
+	var strictMode bool
+	var stopOnWord bool
+	var suddenDeath bool
+	var blindMode bool
+	var minAccuracy float64
 	var disableReport bool
 	var disableTheme bool
 	var useNormalCursor bool
 	var maxLineLength int
+	var visibleLines int
 	var timeoutDuration int
+	var wordLimit int
+	var charLimit int
 	var startParagraphIndex int
 
 	// File and mode configuration variables
@@ -300,29 +501,47 @@ func main() {
 	var wordFilePath string
 	var quoteFilePath string
 	var themeName string
+	var colorsFlag string
+	var keyboardName string
+	var layoutName string
+	var ghostName string
+	var paceWpm int
+	var profileName string
 	var showWordsPerMinute bool
+	var statsFlag string
 	var multiMode bool
 	var versionFlag bool
 	var boldFlag bool
 
 	// Extract type test function variable
 	var customFunctionToExtractNextListOfSegments func() []segment
+	// Persists the position of the first untyped paragraph (file mode only)
+	var saveFileProgress func(int)
 
 	// Set the command line flags
 	flag.IntVar(&wordCount, "n", 50, "")
 	flag.IntVar(&groupCount, "g", 1, "")
 	flag.IntVar(&startParagraphIndex, "start", -1, "")
 	flag.IntVar(&maxLineLength, "w", 108*5, "") // The default screen size is 540 which is very wide.
+	flag.IntVar(&visibleLines, "lines", 0, "")
 	flag.IntVar(&timeoutDuration, "t", -1, "")
+	flag.IntVar(&wordLimit, "wordcount", -1, "")
+	flag.IntVar(&charLimit, "charcount", -1, "")
 	flag.BoolVar(&versionFlag, "v", false, "")
 	flag.StringVar(&wordFilePath, "words", "", "")
 	flag.StringVar(&quoteFilePath, "quotes", "", "")
 	flag.BoolVar(&showWordsPerMinute, "showwpm", false, "")
+	flag.StringVar(&statsFlag, "stats", "", "")
 	flag.BoolVar(&noSkip, "noskip", false, "")
 	flag.BoolVar(&readerMode, "reader-mode", true,
 		"In reader mode, allow to have skip through text using space")
 	flag.BoolVar(&useNormalCursor, "blockcursor", false, "")
 	flag.BoolVar(&disableBackspace, "nobackspace", false, "")
+	flag.BoolVar(&strictMode, "strict", false, "")
+	flag.BoolVar(&stopOnWord, "stoponword", false, "")
+	flag.BoolVar(&suddenDeath, "suddendeath", false, "")
+	flag.BoolVar(&blindMode, "blind", false, "")
+	flag.Float64Var(&minAccuracy, "minacc", 0, "")
 	flag.BoolVar(&disableTheme, "notheme", false, "")
 	flag.BoolVar(&oneShotMode, "oneshot", false, "")
 	flag.BoolVar(&disableHighlight, "nohighlight", false, "")
@@ -335,21 +554,32 @@ func main() {
 	flag.BoolVar(&rawMode, "raw", false, "")
 	flag.BoolVar(&multiMode, "multi", false, "")
 	flag.StringVar(&themeName, "theme", "default", "")
+	flag.StringVar(&colorsFlag, "colors", "auto", "")
+	flag.StringVar(&keyboardName, "keyboard", "", "")
+	flag.StringVar(&layoutName, "layout", "", "")
+	flag.StringVar(&ghostName, "ghost", "", "")
+	flag.IntVar(&paceWpm, "pace", 0, "")
 	flag.StringVar(&listFlag, "list", "", "")
+	flag.StringVar(&profileName, "profile", "", "")
 
 	// Assign a custom function to handle usage
 	flag.Usage = func() { os.Stdout.Write([]byte(usage)) }
 	flag.Parse()
 
-	// List the files in the specified directory
+	// Fill in anything not given on the command line from the configuration file
+	applyConfig(profileName)
+
+	// List the user and builtin resources of the specified type
 	if listFlag != "" {
-		prefix := listFlag + "/"
-		for filePath, _ := range packedFiles {
-			if strings.Index(filePath, prefix) == 0 {
-				_, fileName := filepath.Split(filePath)
-				fmt.Println(fileName)
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		for _, e := range listResources(listFlag) {
+			if e.shadowedBy != "" {
+				fmt.Fprintf(w, "%s\t%s (shadowed by %s)\n", e.name, e.source, e.shadowedBy)
+			} else {
+				fmt.Fprintf(w, "%s\t%s\n", e.name, e.source)
 			}
 		}
+		w.Flush()
 
 		os.Exit(0)
 	}
@@ -367,22 +597,14 @@ func main() {
 
 	// Function to reflow the text to fit the screen
 	reflowTextForScreen := func(inputText string) string {
-		screenWidth, _ := scr.Size()
-		// Adjust window size based on screen size
-		windowSize := maxLineLength
-		if windowSize > screenWidth {
-			windowSize = screenWidth - 8
-		}
-
-		// Replace multiple spaces with single space
-		inputText = regexp.MustCompile("\\s+").ReplaceAllString(inputText, " ")
-		return strings.Replace(
-			wordWrap(strings.Trim(inputText, " "), windowSize),
-			"\n", " \n", -1)
+		return reflowText(scr, inputText, maxLineLength)
 	}
 
 	// Assign the test generation function based on input configuration
 	switch {
+	case ghostName != "" && ghostName != "best":
+		// A ghost can only be raced on the text it typed.
+		customFunctionToExtractNextListOfSegments = generateTestFromReplay(ghostName)
 	case wordFilePath != "":
 		customFunctionToExtractNextListOfSegments = generateWordTest(wordFilePath, wordCount, groupCount)
 	case quoteFilePath != "":
@@ -395,12 +617,47 @@ func main() {
 		customFunctionToExtractNextListOfSegments = generateTestFromData(buffer, rawMode, multiMode)
 	case len(flag.Args()) > 0:
 		typingTextPath := flag.Args()[0]
-		customFunctionToExtractNextListOfSegments = generateTestFromFile(typingTextPath, startParagraphIndex)
+		customFunctionToExtractNextListOfSegments, saveFileProgress =
+			generateTestFromFile(typingTextPath, startParagraphIndex)
 	default:
 		customFunctionToExtractNextListOfSegments = generateWordTest("1000en", wordCount, groupCount)
 	}
 
-	var err error
+	var keyboard *keyboardLayout
+	if keyboardName != "" {
+		keyboard = loadKeyboardLayout(keyboardName)
+	}
+
+	// Statistics are broken down by the fingers of the layout being typed in, if one was given.
+	statsLayout := keyboard
+
+	// Keys are assumed to be in QWERTY positions.
+	var keyMap map[rune]rune
+	if layoutName != "" {
+		statsLayout = loadKeyboardLayout(layoutName)
+		keyMap = layoutRemapping(loadKeyboardLayout("qwerty"), statsLayout)
+	}
+
+	// -showwpm predates -stats and is short for '-stats wpm'.
+	if showWordsPerMinute && statsFlag == "" {
+		statsFlag = "wpm"
+	}
+
+	stats, err := parseLiveStats(statsFlag)
+	if err != nil {
+		die("%v.", err)
+	}
+
+	numColours, ok := parseColourDepth(colorsFlag)
+	if !ok {
+		die("%s is not a valid colour depth, expected one of auto, truecolor, 256, 16 or 8.", colorsFlag)
+	}
+
+	// Stop tcell sending 24-bit colours to a terminal which claims to support them but doesn't.
+	if numColours != 0 && numColours < trueColour {
+		os.Setenv("TCELL_TRUECOLOR", "disable")
+	}
+
 	scr, err = tcell.NewScreen()
 	if err != nil {
 		panic(err)
@@ -424,7 +681,7 @@ func main() {
 	if disableTheme {
 		typerScreen = createDefaultTyper(scr)
 	} else {
-		typerScreen = createTyper(scr, boldFlag, themeName)
+		typerScreen = createTyper(scr, boldFlag, themeName, numColours)
 	}
 
 	// Update highlighting styles based on flags
@@ -440,23 +697,74 @@ func main() {
 	typerScreen.SkipWord = !noSkip
 	typerScreen.ReaderMode = readerMode
 	typerScreen.DisableBackspace = disableBackspace
+	typerScreen.Strict = strictMode
+	typerScreen.StopOnWord = stopOnWord
+	typerScreen.SuddenDeath = suddenDeath
+	typerScreen.Blind = blindMode
+	typerScreen.MinAccuracy = minAccuracy
 	typerScreen.BlockCursor = useNormalCursor
-	typerScreen.ShowWpm = showWordsPerMinute
+	typerScreen.Stats = stats
+	typerScreen.VisibleLines = visibleLines
+	typerScreen.WordLimit = wordLimit
+	typerScreen.CharLimit = charLimit
+	typerScreen.Keyboard = keyboard
+	typerScreen.KeyMap = keyMap
 
 	// Adjust timeout duration if specified
 	if timeoutDuration != -1 {
 		timeoutDuration *= 1e9
 	}
 
+	// Reflow the text of the given segments to fit the screen if not in raw mode
+	reflowSegments := func(segments []segment) {
+		if !rawMode {
+			for i, _ := range segments {
+				segments[i].Text = reflowTextForScreen(segments[i].Text)
+			}
+		}
+	}
+
+	// Segments drawn from the source which the typist never got to are handed out again, one at a
+	// time, before any new ones so that none of the text is skipped.
+	var untypedSegments []segment
+	nextSegments := func() []segment {
+		if len(untypedSegments) > 0 {
+			segments := []segment{untypedSegments[0]}
+			untypedSegments = untypedSegments[1:]
+			return segments
+		}
+
+		return customFunctionToExtractNextListOfSegments()
+	}
+
+	// Timed and counted tests keep drawing text from the source as the typist approaches the end
+	// of it, so the clock or the count is the only thing which terminates them.
+	var streamedSegments []segment
+	if timeoutDuration != -1 || wordLimit != -1 || charLimit != -1 {
+		typerScreen.TextSource = func() []segment {
+			segments := nextSegments()
+			reflowSegments(segments)
+			streamedSegments = append(streamedSegments, segments...)
+			return segments
+		}
+	}
+
 	// Initialize segment list and index
 	var lstx2OfSegmentsFound [][]segment
 	var idxOfPreparedSegments = 0
 
+	// Save the paragraph following (offset 1) or at (offset 0) the given segments as the resume point
+	saveProgressAt := func(segments []segment, offset int) {
+		if saveFileProgress != nil && len(segments) > 0 {
+			saveFileProgress(segments[len(segments)-1].ParagraphIndex + offset)
+		}
+	}
+
 	// Typing loop
 	for {
 		// Generate segments
 		if idxOfPreparedSegments >= len(lstx2OfSegmentsFound) {
-			lstx2OfSegmentsFound = append(lstx2OfSegmentsFound, customFunctionToExtractNextListOfSegments())
+			lstx2OfSegmentsFound = append(lstx2OfSegmentsFound, nextSegments())
 			// Note: customFunctionToExtractNextListOfSegments should be a different abstraction
 			// it should be called on an object like structure,
 			// where if we want we can manipulate the object before we call this function
@@ -470,20 +778,54 @@ func main() {
 		}
 
 		// Reflow text for screen if not in raw mode
-		if !rawMode {
-			for i, _ := range listOfSegmentsToType {
-				listOfSegmentsToType[i].Text = reflowTextForScreen(listOfSegmentsToType[i].Text)
+		reflowSegments(listOfSegmentsToType)
+		streamedSegments = nil
+
+		// Race the ghost of a previous run
+		var ghost *replay
+		typerScreen.Rivals = nil
+		if ghostName != "" {
+			if ghost = findGhost(ghostName, listOfSegmentsToType); ghost != nil {
+				ghost = ghost.unwrapped()
+				typerScreen.Rivals = append(typerScreen.Rivals, rival{"ghost", ghost.position})
 			}
 		}
 
+		// Hold a constant target speed
+		if paceWpm > 0 {
+			typerScreen.Rivals = append(typerScreen.Rivals, rival{"pace", func(_ int, elapsed time.Duration) int {
+				return pacePosition(paceWpm, elapsed)
+			}})
+		}
+
 		// Start typing
-		errorCount, correctCount, duration, returnCode, mistakes :=
+		errorCount, correctCount, duration, returnCode, mistakes, terminatedBy, typedChars :=
 			typerScreen.Start(listOfSegmentsToType, time.Duration(timeoutDuration))
 		saveMistakes(mistakes)
 
+		// The segments of the test followed by those streamed into it, in the order they were typed
+		typedSegments := append(append([]segment{}, listOfSegmentsToType...), streamedSegments...)
+		lastSegment := typedSegments[typerScreen.SegmentsReached-1]
+
+		// Put back what was drawn from the source but not typed
+		var untyped []segment
+		switch {
+		case returnCode == UserAskedForNext || returnCode == UserCompleted && terminatedBy == EndedByText:
+		case returnCode == UserCompleted:
+			// The clock or count ended the test part way through a paragraph, which is typed again
+			untyped = typedSegments[typerScreen.SegmentsReached-1:]
+		default:
+			// The test is typed again (or an earlier one is), drawing the same text into it
+			untyped = streamedSegments
+		}
+		untypedSegments = append(append([]segment{}, untyped...), untypedSegments...)
+
 		// Handle typing return code
 		switch returnCode {
 		case UserAskedForNext:
+			// Skipping a paragraph counts as having dealt with it
+			saveProgressAt(listOfSegmentsToType, 1)
+			saveProgressAt(streamedSegments, 1)
 			idxOfPreparedSegments++
 		case UserAskedForPrevious:
 			if idxOfPreparedSegments == 0 {
@@ -491,21 +833,79 @@ func main() {
 				// needs to be recalculated because now we need to shift the start index back if it permits it
 			} else if idxOfPreparedSegments > 0 {
 				idxOfPreparedSegments--
+				saveProgressAt(lstx2OfSegmentsFound[idxOfPreparedSegments], 0)
 			}
 		case UserCompleted:
+			if terminatedBy == EndedByText {
+				saveProgressAt(listOfSegmentsToType, 1)
+				saveProgressAt(streamedSegments, 1)
+			} else {
+				// The clock or count ended the test part way through a paragraph, so resume at it
+				saveProgressAt([]segment{lastSegment}, 0)
+			}
+
+			// There is nothing worth replaying if the clock ran out before anything was typed.
+			if correctCount+errorCount > 0 {
+				saveReplay(replay{
+					Timestamp: time.Now().Unix(),
+					Wpm:       int(float64(correctCount) / 5 / (float64(duration) / 60e9)),
+					Accuracy:  float64(correctCount) / float64(errorCount+correctCount) * 100,
+					Segments:  typerScreen.Replay,
+				}, listOfSegmentsToType)
+			}
+
+			var notes []string
+			if ghost != nil {
+				if margin, ok := ghostMargin(ghost, typerScreen.Replay); !ok {
+					notes = append(notes, "Ghost:    ahead (it never got this far)")
+				} else if margin >= 0 {
+					notes = append(notes, fmt.Sprintf("Ghost: %8.2fs ahead", margin.Seconds()))
+				} else {
+					notes = append(notes, fmt.Sprintf("Ghost: %8.2fs behind", -margin.Seconds()))
+				}
+			} else if ghostName == "best" {
+				notes = append(notes, "Ghost:    none (this text hasn't been typed before)")
+			}
+			if paceWpm > 0 {
+				ahead, behind := timeAgainstPace(paceWpm, typerScreen.Replay)
+				notes = append(notes, fmt.Sprintf("Pace:  %8.1fs ahead, %.1fs behind (%d WPM)", ahead.Seconds(), behind.Seconds(), paceWpm))
+			}
+
 			if !disableReport {
 				attribution := ""
 				if len(listOfSegmentsToType) == 1 {
 					attribution = listOfSegmentsToType[0].Attribution
 				}
 
-				showReport(scr, duration, correctCount, errorCount, attribution, mistakes)
+				showReport(scr, duration, correctCount, errorCount, attribution, mistakes, terminatedBy,
+					breakDownByKey(statsLayout, typedChars), typerScreen.Timeline, typedChars, blindMode, notes,
+					typerScreen.mistakeStyles())
 			}
 			if oneShotMode {
 				exit(0)
 			}
 
 			idxOfPreparedSegments++
+		case UserFailed:
+			// Failed tests aren't saved as replays and are retried rather than moved past.
+			if !disableReport {
+				attribution := ""
+				if len(listOfSegmentsToType) == 1 {
+					attribution = listOfSegmentsToType[0].Attribution
+				}
+
+				note := "Failed:   sudden death"
+				if terminatedBy == FailedByMinAccuracy {
+					note = fmt.Sprintf("Failed:   accuracy below %g%%", minAccuracy)
+				}
+
+				showReport(scr, duration, correctCount, errorCount, attribution, mistakes, terminatedBy,
+					breakDownByKey(statsLayout, typedChars), typerScreen.Timeline, typedChars, blindMode, []string{note},
+					typerScreen.mistakeStyles())
+			}
+			if oneShotMode {
+				exit(2)
+			}
 		case UserAskedForSigInt:
 			exit(1)
 
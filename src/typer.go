@@ -18,10 +18,28 @@ const (
 	UserAskedForPrevious
 	UserAskedForNext
 	TyperAppResize
+	UserFailed       // The typist made a mistake in -suddendeath or fell below -minacc.
+	TyperInterrupted // An event interrupt posted by the caller, see TyperScreen.Interrupt.
 
 	yLineMultiplier = 2 // so it leaves space for the typed text, which will show the errors as well
 )
 
+// The conditions which can end a test, recorded alongside its results.
+const (
+	EndedByText  = "text"
+	EndedByTime  = "time"
+	EndedByWords = "words"
+	EndedByChars = "chars"
+
+	// Failed tests.
+	FailedBySuddenDeath = "suddendeath"
+	FailedByMinAccuracy = "minacc"
+)
+
+// minAccuracyGrace is the number of characters which have to be typed before -minacc applies, so
+// a single early mistake doesn't fail the test outright.
+const minAccuracyGrace = 20
+
 type segment struct {
 	Text           string `json:"text"`
 	Attribution    string `json:"attribution"`
@@ -33,21 +51,94 @@ type mistake struct {
 	Typed string `json:"typed"`
 }
 
+// rival is someone (or something) racing the typist, drawn as a caret at the position in the text
+// returned for the given segment of the test and time since the segment started (-1 if none). The
+// position doesn't count line breaks (see plainPosition), so it is the same whatever the width the
+// text is wrapped to.
+type rival struct {
+	name     string
+	position func(segment int, elapsed time.Duration) int
+}
+
 type TyperScreen struct {
 	Screen           tcell.Screen
 	SkipWord         bool
 	ReaderMode       bool
-	ShowWpm          bool
 	DisableBackspace bool
 	BlockCursor      bool
 	Tty              io.Writer
 
+	// WordLimit and CharLimit end a test once the given number of words or
+	// characters have been typed, -1 meaning no limit.
+	WordLimit int
+	CharLimit int
+
+	// Strict stops the cursor advancing past a mistyped character, which must be typed correctly
+	// instead. StopOnWord stops it leaving a word which contains mistakes.
+	Strict     bool
+	StopOnWord bool
+
+	// Blind hides whether the characters typed were right, leaving errors to the report.
+	Blind bool
+
+	// SuddenDeath fails the test on the first error, MinAccuracy as soon as the accuracy falls
+	// below it once minAccuracyGrace characters have been typed (0 meaning never).
+	SuddenDeath bool
+	MinAccuracy float64
+
+	// Stats are the statistics shown above the text while typing (see liveStatNames).
+	Stats []string
+
+	// VisibleLines is the number of lines of text shown at once, 0 meaning as many as fit on the screen.
+	VisibleLines int
+
+	// TextSource, when set, is called for more segments whenever a timed test
+	// is about to run out of text, so that only the clock ends the test.
+	TextSource func() []segment
+
+	// Keyboard, when set, is drawn below the text with the next key to press highlighted.
+	Keyboard *keyboardLayout
+
+	// KeyMap, when set, replaces the characters typed with the ones they map to, emulating
+	// another keyboard layout.
+	KeyMap map[rune]rune
+
+	// Rivals are drawn as additional carets racing the typist through the text.
+	Rivals []rival
+
+	// Replay holds the keystrokes of the last test, one entry per segment.
+	Replay []replaySegment
+
+	// SegmentsReached is the number of segments of the last test the cursor got to, counting those
+	// drawn from TextSource as following the test's own.
+	SegmentsReached int
+
+	// Timeline holds the number of characters typed in each second of the last test.
+	Timeline []secondStats
+
+	// Progress, when set, is called with the position of the cursor after every keystroke.
+	Progress func(pos int)
+
+	// Interrupt holds the data of the event interrupt which ended the last test, when it was ended
+	// by one (TyperInterrupted).
+	Interrupt interface{}
+
+	segment int // The index within the test of the segment being typed.
+
 	currentWordStyle    tcell.Style
 	nextWordStyle       tcell.Style
 	incorrectSpaceStyle tcell.Style
 	incorrectStyle      tcell.Style
 	correctStyle        tcell.Style
+	correctedStyle      tcell.Style // Correct characters which were previously mistyped.
+	typedSpaceStyle     tcell.Style
+	typedStyle          tcell.Style // The row below the text showing what was typed instead.
+	attributionStyle    tcell.Style
+	timerStyle          tcell.Style
+	wpmStyle            tcell.Style
 	defaultStyle        tcell.Style
+
+	cursorColor tcell.Color
 }
 
 func NewTyper(
@@ -56,9 +147,6 @@ func NewTyper(
 	fgColor, bgColor, hiColor, hiColor2, hiColor3, errColor tcell.Color,
 ) *TyperScreen {
 	var tty io.Writer
-	def := tcell.StyleDefault.
-		Foreground(fgColor).
-		Background(bgColor)
 
 	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
 	// Will fail on windows, but tty is still mostly usable via tcell
@@ -66,22 +154,61 @@ func NewTyper(
 		tty = io.Discard
 	}
 
-	correctStyle := def.Foreground(hiColor)
+	t := &TyperScreen{
+		Screen:    screen,
+		SkipWord:  true,
+		Tty:       tty,
+		WordLimit: -1,
+		CharLimit: -1,
+	}
+
+	t.setTheme(emboldenTypedText, map[string]themeColour{
+		"fgcol":  {colour: fgColor},
+		"bgcol":  {colour: bgColor},
+		"hicol":  {colour: hiColor},
+		"hicol2": {colour: hiColor2},
+		"hicol3": {colour: hiColor3},
+		"errcol": {colour: errColor},
+	})
+	return t
+}
+
+// setTheme derives the styles used to draw the text from the given theme colours.
+// The optional colours fall back to one of the six every theme defines.
+func (t *TyperScreen) setTheme(emboldenTypedText bool, theme map[string]themeColour) {
+	colour := func(key, fallback string) themeColour {
+		if c, ok := theme[key]; ok {
+			return c
+		}
+		return theme[fallback]
+	}
+
+	def := theme["fgcol"].style(tcell.StyleDefault).
+		Background(theme["bgcol"].colour)
+
+	correctStyle := theme["hicol"].style(def)
+	correctedStyle := colour("fixedcol", "hicol").style(def)
 	if emboldenTypedText {
 		correctStyle = correctStyle.Bold(true)
+		correctedStyle = correctedStyle.Bold(true)
 	}
 
-	return &TyperScreen{
-		Screen:   screen,
-		SkipWord: true,
-		Tty:      tty,
-
-		defaultStyle:        def,
-		correctStyle:        correctStyle,
-		currentWordStyle:    def.Foreground(hiColor2),
-		nextWordStyle:       def.Foreground(hiColor3),
-		incorrectStyle:      def.Foreground(errColor),
-		incorrectSpaceStyle: def.Background(errColor),
+	t.defaultStyle = def
+	t.correctStyle = correctStyle
+	t.correctedStyle = correctedStyle
+	t.currentWordStyle = theme["hicol2"].style(def)
+	t.nextWordStyle = theme["hicol3"].style(def)
+	t.incorrectStyle = theme["errcol"].style(def)
+	t.incorrectSpaceStyle = def.Background(theme["errcol"].colour)
+	t.typedStyle = colour("typedcol", "errcol").style(def)
+	t.typedSpaceStyle = def.Background(colour("typedcol", "errcol").colour)
+	t.attributionStyle = colour("attrcol", "fgcol").style(def)
+	t.timerStyle = colour("timercol", "fgcol").style(def)
+	t.wpmStyle = colour("wpmcol", "fgcol").style(def)
+
+	t.cursorColor = tcell.ColorDefault
+	if c, ok := theme["cursorcol"]; ok {
+		t.cursorColor = c.colour
 	}
 }
 
@@ -94,26 +221,43 @@ func (t *TyperScreen) Start(
 	duration time.Duration,
 	returnCode int,
 	mistakes []mistake,
+	terminatedBy string,
+	chars []typedChar,
 ) {
 	timeLeft := timeout
+	wordsLeft := t.WordLimit
+	t.Replay = nil
+	t.Timeline = nil
+	charsLeft := t.CharLimit
 
 	for i, segmentToType := range listOfSegmentsToType {
 		startImmediately := true
 		var testDuration time.Duration
-		var errCount, correctCount int
+		var errCount, correctCount, wordCount, charCount int
 		var mistakesMadeDuringTest []mistake
+		var typedChars []typedChar
+		var moreText func() []segment
 
 		if i == 0 {
 			startImmediately = false
 		}
+		t.segment = i
+		t.SegmentsReached = i + 1
+
+		// Only the last segment is extended, earlier ones are typed as generated.
+		if i == len(listOfSegmentsToType)-1 {
+			moreText = t.TextSource
+		}
 
-		errCount, correctCount, returnCode, testDuration, mistakesMadeDuringTest =
-			t.start(segmentToType.Text, timeLeft, startImmediately, segmentToType.Attribution)
+		errCount, correctCount, returnCode, testDuration, mistakesMadeDuringTest, wordCount, charCount, terminatedBy, typedChars =
+			t.start(segmentToType.Text, timeLeft, wordsLeft, charsLeft,
+				startImmediately, segmentToType.Attribution, moreText)
 
 		numErrors += errCount
 		numCorrect += correctCount
 		duration += testDuration
 		mistakes = append(mistakes, mistakesMadeDuringTest...)
+		chars = append(chars, typedChars...)
 
 		if timeout != -1 {
 			timeLeft -= testDuration
@@ -122,6 +266,21 @@ func (t *TyperScreen) Start(
 			}
 		}
 
+		if wordsLeft != -1 {
+			wordsLeft -= wordCount
+			if wordsLeft <= 0 {
+				return
+			}
+		}
+
+		if charsLeft != -1 {
+			// Counted the same way as within a segment, so keys rejected by -strict don't count.
+			charsLeft -= charCount
+			if charsLeft <= 0 {
+				return
+			}
+		}
+
 		if returnCode != UserCompleted {
 			return
 		}
@@ -179,31 +338,267 @@ func extractMistypedWords(
 	return
 }
 
+// typingState holds the text of the segment being typed, what the typist has entered so far
+// and where the text is laid out on the screen.
+type typingState struct {
+	referenceText []rune
+	userTypedText []rune
+	everMistyped  []bool      // Positions which have been typed incorrectly at some point.
+	typedAt       []time.Time // When each position was last typed.
+
+	// cursorPositionInText represents the current position of the typist within the text to be typed.
+	// It tracks the position where the next character is to be typed or erased.
+	// This variable starts at 0 and increases as characters are typed, and decreases when characters are erased.
+	cursorPositionInText int
+
+	attribution string
+	startTime   time.Time
+	timeLimit   time.Duration
+	wordLimit   int // -1 meaning no limit, as with charLimit.
+	charLimit   int
+
+	keystrokes []keystroke   // Every keystroke which didn't abandon the segment, in order.
+	timeline   []secondStats // The characters typed in each second since the segment started.
+
+	// The positions at which segments were appended to the text.
+	appendedAt []int
+
+	// Running totals of the characters before the cursor, kept up to date by tally.
+	numCorrect     int
+	numErrors      int
+	numWords       int    // Words whose last character is before the cursor, see countTyped.
+	numLineBreaks  int    // The row of the cursor.
+	tallied        int    // The position up to which the characters are included in the totals.
+	talliedCorrect []bool // Whether each character was correct when it was included.
+
+	rejected int // Mistyped keys which -strict didn't let through, counted as errors.
+
+	xStartLeftSideOfScreen      int
+	yStartTopSideOfSideOfScreen int
+	numCols                     int
+	numRows                     int
+
+	// The rows of text which are currently drawn, the first one being firstVisibleRow.
+	numVisibleRows  int
+	firstVisibleRow int
+
+	// The last incorrect character typed and when, which is flashed on the keyboard.
+	mistypedKey  rune
+	mistypedTime time.Time
+}
+
+func newTypingState(textToType string, attribution string, timeLimit time.Duration) *typingState {
+	st := &typingState{
+		referenceText: []rune(textToType),
+		attribution:   attribution,
+		timeLimit:     timeLimit,
+		wordLimit:     -1,
+		charLimit:     -1,
+	}
+	st.userTypedText = make([]rune, len(st.referenceText))
+	st.everMistyped = make([]bool, len(st.referenceText))
+	st.typedAt = make([]time.Time, len(st.referenceText))
+	st.talliedCorrect = make([]bool, len(st.referenceText))
+
+	return st
+}
+
+// layout centres the text on the screen. Only as many rows as fit on the screen (or VisibleLines if
+// set) are shown at once. The height of the text block grows with text streamed in later until it
+// reaches that limit, but never shrinks, so the text scrolls through it rather than jumping about.
+func (t *TyperScreen) layout(st *typingState) {
+	screenWidth, screenHeight := t.Screen.Size()
+	st.numCols, st.numRows = calcStringDimensions(string(st.referenceText))
+	st.xStartLeftSideOfScreen = (screenWidth - st.numCols) / 2
+
+	// Leave room for the WPM above the text and the attribution and timer below it.
+	maxVisibleRows := (screenHeight - 5 - t.keyboardHeight()) / yLineMultiplier
+	if t.VisibleLines > 0 && t.VisibleLines < maxVisibleRows {
+		maxVisibleRows = t.VisibleLines
+	}
+
+	if st.numVisibleRows < st.numRows {
+		st.numVisibleRows = st.numRows
+	}
+	if st.numVisibleRows > maxVisibleRows {
+		st.numVisibleRows = maxVisibleRows
+	}
+	if st.numVisibleRows < 1 {
+		st.numVisibleRows = 1
+	}
+
+	st.yStartTopSideOfSideOfScreen = (screenHeight - st.numVisibleRows*yLineMultiplier - t.keyboardHeight()) / 2
+	if st.yStartTopSideOfSideOfScreen < 0 {
+		st.yStartTopSideOfSideOfScreen = 0
+	}
+}
+
+// keyboardHeight returns the number of rows taken up by the keyboard below the text, if shown.
+func (t *TyperScreen) keyboardHeight() int {
+	if t.Keyboard == nil {
+		return 0
+	}
+
+	return keyboardHeight
+}
+
+// cursorRow returns the row of the text on which the cursor currently is.
+func (st *typingState) cursorRow() int {
+	return st.numLineBreaks
+}
+
+// scroll keeps the row of the cursor in a fixed band of the visible rows: the second one, so that
+// the previous row stays in view, unless only two rows or fewer are visible in which case the first.
+// It reports whether the visible rows changed.
+func (st *typingState) scroll() bool {
+	band := st.numVisibleRows - 2
+	if band > 1 {
+		band = 1
+	}
+	if band < 0 {
+		band = 0
+	}
+
+	firstVisibleRow := st.cursorRow() - band
+	if firstVisibleRow > st.numRows-st.numVisibleRows {
+		firstVisibleRow = st.numRows - st.numVisibleRows
+	}
+	if firstVisibleRow < 0 {
+		firstVisibleRow = 0
+	}
+
+	changed := firstVisibleRow != st.firstVisibleRow
+	st.firstVisibleRow = firstVisibleRow
+	return changed
+}
+
+// limitReached reports which of the word and character limits, if any, has been reached.
+func (st *typingState) limitReached() string {
+	typedWords, typedChars := st.countTyped()
+	if st.wordLimit != -1 && typedWords >= st.wordLimit {
+		return EndedByWords
+	}
+	if st.charLimit != -1 && typedChars >= st.charLimit {
+		return EndedByChars
+	}
+
+	return ""
+}
+
+// failure returns the condition the test has failed by, if any. completed is set when the
+// keystroke completed the test, which applies MinAccuracy however little of it was typed.
+func (t *TyperScreen) failure(st *typingState, completed bool) string {
+	numErrors := st.numErrors + st.rejected
+
+	if t.SuddenDeath && numErrors > 0 {
+		return FailedBySuddenDeath
+	}
+
+	typed := st.numCorrect + numErrors
+	if t.MinAccuracy > 0 && typed > 0 && (typed >= minAccuracyGrace || completed) &&
+		float64(st.numCorrect)/float64(typed)*100 < t.MinAccuracy {
+		return FailedByMinAccuracy
+	}
+
+	return ""
+}
+
+// record adds a keystroke, which has just been handled, to the replay of the segment.
+func (st *typingState) record(ev *tcell.EventKey) {
+	k := keystroke{Key: ev.Key(), Mod: ev.Modifiers(), Pos: st.cursorPositionInText}
+	if ev.Key() == tcell.KeyRune {
+		k.Rune = ev.Rune()
+	}
+	if !st.startTime.IsZero() {
+		k.Time = time.Since(st.startTime).Milliseconds()
+	}
+
+	st.keystrokes = append(st.keystrokes, k)
+}
+
+// countTyped returns the number of words and characters the typist has got through. A word
+// counts once its last character has been typed (or it has been skipped).
+func (st *typingState) countTyped() (numWords, numChars int) {
+	return st.numWords, st.numCorrect + st.numErrors
+}
+
+// endsWord reports whether the character at the given position is the last one of a word.
+func (st *typingState) endsWord(i int) bool {
+	c := st.referenceText[i]
+	if c == ' ' || c == '\n' {
+		return false
+	}
+
+	return i+1 == len(st.referenceText) || st.referenceText[i+1] == ' ' || st.referenceText[i+1] == '\n'
+}
+
+// appendSegments extends the text being typed with the given segments, each one starting on a new line.
+func (st *typingState) appendSegments(segments []segment) {
+	for _, s := range segments {
+		st.appendedAt = append(st.appendedAt, len(st.referenceText))
+
+		text := []rune(" \n" + s.Text)
+		st.referenceText = append(st.referenceText, text...)
+		st.userTypedText = append(st.userTypedText, make([]rune, len(text))...)
+		st.everMistyped = append(st.everMistyped, make([]bool, len(text))...)
+		st.typedAt = append(st.typedAt, make([]time.Time, len(text))...)
+		st.talliedCorrect = append(st.talliedCorrect, make([]bool, len(text))...)
+	}
+
+	// The attribution would no longer match all of the text.
+	st.attribution = ""
+}
+
+// typedChars returns the characters of the text which have been typed along with what was typed
+// in their place, timing each one from the keystroke before it.
+func (st *typingState) typedChars() []typedChar {
+	var chars []typedChar
+	var previous time.Time
+
+	for i := 0; i < st.cursorPositionInText; i++ {
+		if st.referenceText[i] == '\n' {
+			continue
+		}
+
+		c := typedChar{expected: st.referenceText[i], typed: st.userTypedText[i]}
+		c.lineEnd = i+1 < len(st.referenceText) && st.referenceText[i+1] == '\n'
+		if at := st.typedAt[i]; !at.IsZero() {
+			if !previous.IsZero() && at.After(previous) {
+				c.interval = at.Sub(previous)
+			}
+			previous = at
+		}
+
+		chars = append(chars, c)
+	}
+
+	return chars
+}
+
 func (t *TyperScreen) start(
 	textToType string,
 	timeLimit time.Duration,
+	wordLimit int,
+	charLimit int,
 	startImmediately bool,
 	attribution string,
+	moreText func() []segment,
 ) (
 	numErrors int,
 	numCorrect int,
 	returnCode int,
 	duration time.Duration,
 	mistakes []mistake,
+	numWords int,
+	numChars int,
+	terminatedBy string,
+	chars []typedChar,
 ) {
 
-	var startTime time.Time
-	referenceText := []rune(textToType)
-	userTypedText := make([]rune, len(referenceText))
-
-	screenWidth, screenHeight := t.Screen.Size()
-	numCols, numRows := calcStringDimensions(textToType)
-	xStartLeftSideOfScreen := (screenWidth - numCols) / 2
-
-	yStartTopSideOfSideOfScreen := (screenHeight - numRows*yLineMultiplier) / 2
-	if yStartTopSideOfSideOfScreen < 0 {
-		yStartTopSideOfSideOfScreen = 0
-	}
+	st := newTypingState(textToType, attribution, timeLimit)
+	st.wordLimit = wordLimit
+	st.charLimit = charLimit
+	t.layout(st)
 
 	if !t.BlockCursor {
 		t.Tty.Write([]byte("\033[5 q"))
@@ -213,15 +608,21 @@ func (t *TyperScreen) start(
 		defer t.Tty.Write([]byte("\033[2 q"))
 	}
 
-	t.Screen.SetStyle(t.defaultStyle)
+	if r, g, b := t.cursorColor.RGB(); t.cursorColor != tcell.ColorDefault && r != -1 {
+		t.Tty.Write([]byte(fmt.Sprintf("\033]12;#%02x%02x%02x\007", r, g, b)))
+		defer t.Tty.Write([]byte("\033]112\007"))
+	}
 
-	// cursorPositionInText represents the current position of the typist within the text to be typed.
-	// It tracks the position where the next character is to be typed or erased.
-	// This variable starts at 0 and increases as characters are typed, and decreases when characters are erased.
-	cursorPositionInText := 0
+	t.Screen.SetStyle(t.defaultStyle)
 
 	tickerCloser := make(chan bool)
 
+	// Rivals' carets move on their own so need redrawing more often.
+	tickInterval := time.Duration(5e8)
+	if len(t.Rivals) > 0 {
+		tickInterval = 1e8
+	}
+
 	// Inject nil events into the main event loop at regular intervals to force an update
 	ticker := func() {
 		for {
@@ -231,8 +632,7 @@ func (t *TyperScreen) start(
 			default:
 			}
 
-			halfSecond := time.Duration(5e8)
-			time.Sleep(halfSecond)
+			time.Sleep(tickInterval)
 			t.Screen.PostEventWait(nil)
 		}
 	}
@@ -241,125 +641,261 @@ func (t *TyperScreen) start(
 	defer close(tickerCloser)
 
 	if startImmediately {
-		startTime = time.Now()
+		st.startTime = time.Now()
+	}
+
+	// The replay of the segment is recorded even if it isn't completed.
+	t.Replay = append(t.Replay, replaySegment{Text: textToType, Attribution: attribution})
+	defer func() {
+		t.Replay[len(t.Replay)-1].Text = string(st.referenceText)
+		t.Replay[len(t.Replay)-1].Keystrokes = st.keystrokes
+		t.Timeline = append(t.Timeline, st.timeline...)
+	}()
+
+	// finish collects the statistics of the segment once one of the conditions ending it has been met.
+	finish := func(condition string) {
+		numErrors, numCorrect, mistakes, duration = t.calculateStatistics(st.startTime, st.referenceText, st.userTypedText, st.cursorPositionInText)
+		numErrors += st.rejected
+		numWords, numChars = st.countTyped()
+		chars = st.typedChars()
+		terminatedBy = condition
+		returnCode = UserCompleted
+
+		// The cursor is only in an appended segment once it is past the space before it.
+		for i, at := range st.appendedAt {
+			if st.cursorPositionInText > at {
+				t.SegmentsReached = t.segment + i + 2
+			}
+		}
 	}
 
 	t.Screen.Clear()
 	for {
+		// Top up timed tests before the typist reaches the last line.
+		if moreText != nil && st.cursorRow() >= st.numRows-1 {
+			if segments := moreText(); len(segments) == 0 {
+				moreText = nil
+			} else {
+				st.appendSegments(segments)
+				t.layout(st)
+			}
+		}
+
+		if st.scroll() {
+			t.Screen.Clear()
+		}
 
-		t.redraw(referenceText, userTypedText, cursorPositionInText,
-			xStartLeftSideOfScreen, yStartTopSideOfSideOfScreen,
-			numCols, numRows, attribution, startTime, timeLimit)
+		t.redraw(st)
 
 		ev := t.Screen.PollEvent()
 
+		if k, ok := ev.(*tcell.EventKey); ok && k.Key() == tcell.KeyRune && t.KeyMap != nil {
+			if r, ok := t.KeyMap[k.Rune()]; ok {
+				ev = tcell.NewEventKey(tcell.KeyRune, r, k.Modifiers())
+			}
+		}
+
 		switch ev := ev.(type) {
 		case *tcell.EventResize:
 			returnCode = TyperAppResize
 			return
+		case *tcell.EventInterrupt:
+			t.Interrupt = ev.Data()
+			returnCode = TyperInterrupted
+			return
 		case *tcell.EventKey:
-			if runtime.GOOS != "windows" && ev.Key() == tcell.KeyBackspace { // Control+backspace on unix terms
-				if !t.DisableBackspace {
-					t.deleteWord(&cursorPositionInText, referenceText, userTypedText)
+			code, done, condition := t.handleKey(st, ev)
+			if !done || code == UserCompleted {
+				if failure := t.failure(st, done); failure != "" {
+					code, done, condition = UserFailed, true, failure
+				}
+
+				st.record(ev)
+
+				if t.Progress != nil {
+					t.Progress(st.cursorPositionInText)
 				}
-				continue
 			}
 
-			if startTime.IsZero() {
-				startTime = time.Now()
+			if done && (code == UserCompleted || code == UserFailed) {
+				finish(condition)
+				returnCode = code
+				return
+			} else if done {
+				returnCode = code
+				return
+			}
+		default: // tick
+			if timeLimit != -1 && !st.startTime.IsZero() && timeLimit <= time.Now().Sub(st.startTime) {
+				finish(EndedByTime)
+				return
 			}
 
-			switch key := ev.Key(); key {
-			case tcell.KeyCtrlC:
-				returnCode = UserAskedForSigInt
+			t.redraw(st)
+		}
+	}
+}
 
-				return
-			case tcell.KeyEscape:
-				returnCode = UserTypedEscape
+// handleKey applies a keystroke to the test. It reports whether the keystroke ended the test along
+// with the return code and, if the test was completed, the condition which ended it.
+func (t *TyperScreen) handleKey(st *typingState, ev *tcell.EventKey) (returnCode int, done bool, condition string) {
+	// Nothing before where the keystroke moved the cursor from or to changes, other than the space
+	// deleteWord leaves the cursor after.
+	before := st.cursorPositionInText
+	defer func() {
+		if st.cursorPositionInText < before {
+			st.tally(st.cursorPositionInText - 1)
+		} else {
+			st.tally(before)
+		}
 
-				return
-			case tcell.KeyCtrlL:
-				t.Screen.Sync()
+		// The limits are checked against the totals once they are up to date, and take precedence
+		// over reaching the end of the text.
+		if !done || returnCode == UserCompleted {
+			if limit := st.limitReached(); limit != "" {
+				returnCode, done, condition = UserCompleted, true, limit
+			}
+		}
+	}()
 
-			case tcell.KeyRight:
-				returnCode = UserAskedForNext
-				return
+	if runtime.GOOS != "windows" && ev.Key() == tcell.KeyBackspace { // Control+backspace on unix terms
+		if !t.DisableBackspace {
+			t.deleteWord(&st.cursorPositionInText, st.referenceText, st.userTypedText)
+		}
+		return
+	}
 
-			case tcell.KeyLeft:
-				returnCode = UserAskedForPrevious
-				return
+	if st.startTime.IsZero() {
+		st.startTime = time.Now()
+	}
 
-			case tcell.KeyCtrlW:
-				if !t.DisableBackspace {
-					t.deleteWord(&cursorPositionInText, referenceText, userTypedText)
-				}
+	switch key := ev.Key(); key {
+	case tcell.KeyCtrlC:
+		return UserAskedForSigInt, true, ""
+	case tcell.KeyEscape:
+		return UserTypedEscape, true, ""
+	case tcell.KeyCtrlL:
+		t.Screen.Sync()
 
-			case tcell.KeyBackspace, tcell.KeyBackspace2:
-				if !t.DisableBackspace {
-					if ev.Modifiers() == tcell.ModAlt || ev.Modifiers() == tcell.ModCtrl {
-						t.deleteWord(&cursorPositionInText, referenceText, userTypedText)
-					} else {
-						if cursorPositionInText == 0 {
-							break
-						}
-
-						cursorPositionInText--
-
-						for cursorPositionInText > 0 && referenceText[cursorPositionInText] == '\n' {
-							cursorPositionInText--
-						}
-					}
-				}
-			case tcell.KeyEnter:
-				if cursorPositionInText < len(referenceText) {
-					if !t.ReaderMode && cursorPositionInText > 0 {
-						prevCharacterIsSpace := referenceText[cursorPositionInText-1] == ' '
-						if prevCharacterIsSpace && referenceText[cursorPositionInText] != ' ' { // Do nothing on word boundaries.
-							break
-						}
-					}
-
-					for cursorPositionInText < len(referenceText) && referenceText[cursorPositionInText] != ' ' && referenceText[cursorPositionInText] != '\n' {
-						userTypedText[cursorPositionInText] = 0
-						cursorPositionInText++
-					}
-
-					if cursorPositionInText < len(referenceText) {
-						userTypedText[cursorPositionInText] = referenceText[cursorPositionInText]
-						cursorPositionInText++
-					}
+	case tcell.KeyRight:
+		return UserAskedForNext, true, ""
+
+	case tcell.KeyLeft:
+		return UserAskedForPrevious, true, ""
+
+	case tcell.KeyCtrlW:
+		if !t.DisableBackspace {
+			t.deleteWord(&st.cursorPositionInText, st.referenceText, st.userTypedText)
+		}
+
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if !t.DisableBackspace {
+			if ev.Modifiers() == tcell.ModAlt || ev.Modifiers() == tcell.ModCtrl {
+				t.deleteWord(&st.cursorPositionInText, st.referenceText, st.userTypedText)
+			} else {
+				if st.cursorPositionInText == 0 {
+					break
 				}
 
-			case tcell.KeyRune:
-				if cursorPositionInText < len(userTypedText) {
-					// feed the character into the userTypedText buffer
-					userTypedText[cursorPositionInText] = ev.Rune()
-					cursorPositionInText++
+				st.cursorPositionInText--
 
-					for cursorPositionInText < len(referenceText) && referenceText[cursorPositionInText] == '\n' {
-						userTypedText[cursorPositionInText] = referenceText[cursorPositionInText]
-						cursorPositionInText++
-					}
+				for st.cursorPositionInText > 0 && st.referenceText[st.cursorPositionInText] == '\n' {
+					st.cursorPositionInText--
 				}
+			}
+		}
+	case tcell.KeyEnter:
+		if t.Strict || t.StopOnWord { // Skipping a word leaves it incomplete.
+			break
+		}
 
-				if cursorPositionInText == len(referenceText) {
-					numErrors, numCorrect, mistakes, duration = t.calculateStatistics(startTime, referenceText, userTypedText, cursorPositionInText)
-					returnCode = UserCompleted
-					return
+		if st.cursorPositionInText < len(st.referenceText) {
+			if !t.ReaderMode && st.cursorPositionInText > 0 {
+				prevCharacterIsSpace := st.referenceText[st.cursorPositionInText-1] == ' '
+				if prevCharacterIsSpace && st.referenceText[st.cursorPositionInText] != ' ' { // Do nothing on word boundaries.
+					break
 				}
 			}
-		default: // tick
-			if timeLimit != -1 && !startTime.IsZero() && timeLimit <= time.Now().Sub(startTime) {
-				numErrors, numCorrect, mistakes, duration = t.calculateStatistics(startTime, referenceText, userTypedText, cursorPositionInText)
-				returnCode = UserCompleted
-				return
+
+			for st.cursorPositionInText < len(st.referenceText) && st.referenceText[st.cursorPositionInText] != ' ' && st.referenceText[st.cursorPositionInText] != '\n' {
+				st.userTypedText[st.cursorPositionInText] = 0
+				st.everMistyped[st.cursorPositionInText] = true
+				st.cursorPositionInText++
 			}
 
-			t.redraw(referenceText, userTypedText, cursorPositionInText,
-				xStartLeftSideOfScreen, yStartTopSideOfSideOfScreen,
-				numCols, numRows, attribution, startTime, timeLimit)
+			if st.cursorPositionInText < len(st.referenceText) {
+				st.userTypedText[st.cursorPositionInText] = st.referenceText[st.cursorPositionInText]
+				st.typedAt[st.cursorPositionInText] = time.Now()
+				st.cursorPositionInText++
+			}
+		}
+
+	case tcell.KeyRune:
+		if st.cursorPositionInText < len(st.userTypedText) && t.rejectKey(st, ev.Rune()) {
+			break
+		}
+
+		if st.cursorPositionInText < len(st.userTypedText) {
+			// feed the character into the userTypedText buffer
+			st.userTypedText[st.cursorPositionInText] = ev.Rune()
+			st.typedAt[st.cursorPositionInText] = time.Now()
+
+			if ev.Rune() != st.referenceText[st.cursorPositionInText] {
+				st.everMistyped[st.cursorPositionInText] = true
+				st.mistypedKey = ev.Rune()
+				st.mistypedTime = time.Now()
+				st.currentSecond().errors++
+			} else {
+				st.currentSecond().correct++
+			}
+			st.cursorPositionInText++
+
+			for st.cursorPositionInText < len(st.referenceText) && st.referenceText[st.cursorPositionInText] == '\n' {
+				st.userTypedText[st.cursorPositionInText] = st.referenceText[st.cursorPositionInText]
+				st.cursorPositionInText++
+			}
+		}
+
+		if st.cursorPositionInText == len(st.referenceText) {
+			return UserCompleted, true, EndedByText
+		}
+	}
+
+	return
+}
+
+// rejectKey reports whether the character typed at the cursor must be refused by -strict, because it
+// is wrong, or by -stoponword, because it would leave a word containing mistakes.
+func (t *TyperScreen) rejectKey(st *typingState, r rune) bool {
+	pos := st.cursorPositionInText
+	expected := st.referenceText[pos]
+
+	// The word is left by typing the space after it, or its last character at the end of the text
+	// or of a line (the newline being skipped over, as in -raw input). Reflowed lines end in a space
+	// instead, which is left like any other.
+	last := expected != ' ' && (pos == len(st.referenceText)-1 || st.referenceText[pos+1] == '\n')
+
+	if r != expected && (t.Strict || t.StopOnWord && last) {
+		st.rejected++
+		st.everMistyped[pos] = true
+		st.mistypedKey = r
+		st.mistypedTime = time.Now()
+		st.currentSecond().errors++
+
+		return true
+	}
+
+	if !t.StopOnWord || expected != ' ' && !last {
+		return false
+	}
+
+	for i := pos - 1; i >= 0 && st.referenceText[i] != ' ' && st.referenceText[i] != '\n'; i-- {
+		if st.userTypedText[i] != st.referenceText[i] {
+			return true
 		}
 	}
+
+	return false
 }
 
 func (t *TyperScreen) deleteWord(cursorPositionInText *int, referenceText []rune, userTypedText []rune) {
@@ -383,28 +919,38 @@ func (t *TyperScreen) deleteWord(cursorPositionInText *int, referenceText []rune
 	}
 }
 
-func (t *TyperScreen) redraw(
-	referenceText []rune,
-	userTypedText []rune,
-	cursorPositionInText int,
-	xStartLeftSideOfScreen int,
-	yStartTopSideOfSideOfScreen int,
-	numCols int,
-	numRows int,
-	attribution string,
-	startTime time.Time,
-	timeLimit time.Duration,
-) {
+func (t *TyperScreen) redraw(st *typingState) {
+	referenceText := st.referenceText
+	userTypedText := st.userTypedText
+	cursorPositionInText := st.cursorPositionInText
+	xStartLeftSideOfScreen := st.xStartLeftSideOfScreen
+	yStartTopSideOfSideOfScreen := st.yStartTopSideOfSideOfScreen
+	numCols := st.numCols
+	numVisibleRows := st.numVisibleRows
+
 	cursorX := xStartLeftSideOfScreen
-	cursorY := yStartTopSideOfSideOfScreen
+	row := 0
 	inWord := -1
 
+	var elapsed time.Duration
+	if !st.startTime.IsZero() {
+		elapsed = time.Since(st.startTime)
+	}
+
+	rivalAt := map[int]bool{}
+	for _, r := range t.Rivals {
+		if pos := r.position(t.segment, elapsed); pos != -1 {
+			rivalAt[wrappedPosition(referenceText, pos)] = true
+		}
+	}
+
 	for i := range referenceText {
 		style := t.defaultStyle
+		typedStyle := t.defaultStyle
 
 		characterInSegment := referenceText[i]
 		if characterInSegment == '\n' {
-			cursorY += yLineMultiplier
+			row++
 			cursorX = xStartLeftSideOfScreen
 			if inWord != -1 {
 				inWord++
@@ -412,6 +958,10 @@ func (t *TyperScreen) redraw(
 			continue
 		}
 
+		// Rows scrolled out of view still take part in the word highlighting logic below.
+		cursorY := yStartTopSideOfSideOfScreen + (row-st.firstVisibleRow)*yLineMultiplier
+		isVisible := row >= st.firstVisibleRow && row < st.firstVisibleRow+numVisibleRows
+
 		if i == cursorPositionInText {
 			t.Screen.ShowCursor(cursorX, cursorY)
 			inWord = 0
@@ -427,69 +977,78 @@ func (t *TyperScreen) redraw(
 			} else {
 				style = t.defaultStyle
 			}
+		} else if t.Blind {
+			style = t.correctStyle
 		} else if characterInSegment != userTypedText[i] {
 			if characterInSegment == ' ' {
 				style = t.incorrectSpaceStyle
+				typedStyle = t.typedSpaceStyle
 			} else {
 				style = t.incorrectStyle
+				typedStyle = t.typedStyle
 			}
+		} else if st.everMistyped[i] {
+			style = t.correctedStyle
 		} else {
 			style = t.correctStyle
 		}
 
-		t.Screen.SetContent(cursorX, cursorY, characterInSegment, nil, style)
-		// only type the character in the row below if it is different from the correct character
-		if referenceText[i] != userTypedText[i] {
-			t.Screen.SetContent(cursorX, cursorY+1, userTypedText[i], nil, style)
+		if rivalAt[i] && i != cursorPositionInText {
+			style = style.Reverse(true)
+		}
+
+		if isVisible {
+			t.Screen.SetContent(cursorX, cursorY, characterInSegment, nil, style)
+			// only type the character in the row below if it is different from the correct character
+			if referenceText[i] != userTypedText[i] && !t.Blind {
+				t.Screen.SetContent(cursorX, cursorY+1, userTypedText[i], nil, typedStyle)
+			} else {
+				t.Screen.SetContent(cursorX, cursorY+1, ' ', nil, t.defaultStyle)
+			}
 		}
 
 		cursorX++
 	}
 
-	attributionWidth, attributionHeight := calcStringDimensions(attribution)
+	attributionWidth, attributionHeight := calcStringDimensions(st.attribution)
 	drawString(
 		t.Screen,
 		xStartLeftSideOfScreen+numCols-attributionWidth,
-		yStartTopSideOfSideOfScreen+numRows*yLineMultiplier+1,
-		attribution,
+		yStartTopSideOfSideOfScreen+numVisibleRows*yLineMultiplier+1,
+		st.attribution,
 		-1,
-		t.defaultStyle,
+		t.attributionStyle,
 	)
 
-	if timeLimit != -1 && !startTime.IsZero() {
-		remaining := timeLimit - time.Now().Sub(startTime)
+	if st.timeLimit != -1 && !st.startTime.IsZero() {
+		remaining := st.timeLimit - time.Now().Sub(st.startTime)
 		drawString(t.Screen,
 			xStartLeftSideOfScreen+numCols/2,
-			yStartTopSideOfSideOfScreen+numRows*yLineMultiplier+attributionHeight+1,
+			yStartTopSideOfSideOfScreen+numVisibleRows*yLineMultiplier+attributionHeight+1,
 			"      ",
 			-1,
-			t.defaultStyle,
+			t.timerStyle,
 		)
 		drawString(t.Screen,
 			xStartLeftSideOfScreen+numCols/2,
-			yStartTopSideOfSideOfScreen+numRows*yLineMultiplier+attributionHeight+1,
+			yStartTopSideOfSideOfScreen+numVisibleRows*yLineMultiplier+attributionHeight+1,
 			strconv.Itoa(int(remaining/1e9)+1),
 			-1,
-			t.defaultStyle,
+			t.timerStyle,
 		)
 	}
 
-	if t.ShowWpm && !startTime.IsZero() {
-		//calculateStatistics()
-		_, numCorrect, _, duration := t.calculateStatistics(startTime, referenceText, userTypedText, cursorPositionInText)
-		//returnCode = UserCompleted
-		if duration > 1e7 { // Avoid flashing large numbers on test start.
-			wpm := int((float64(numCorrect) / 5) / (float64(duration) / 60e9))
-			drawString(t.Screen,
-				xStartLeftSideOfScreen+numCols/2-4,
-				yStartTopSideOfSideOfScreen-2,
-				fmt.Sprintf("WPM: %-10d\n", wpm),
-				-1,
-				t.defaultStyle,
-			)
+	if t.Keyboard != nil {
+		screenWidth, screenHeight := t.Screen.Size()
+		// Below the attribution and timer.
+		y := yStartTopSideOfSideOfScreen + numVisibleRows*yLineMultiplier + attributionHeight + 3
+		if screenWidth >= keyboardWidth && y+SpaceRow < screenHeight {
+			t.drawKeyboard((screenWidth-keyboardWidth)/2, y, st)
 		}
 	}
 
+	t.drawStats(st)
+
 	t.Screen.Show()
 }
 
@@ -5,23 +5,46 @@ import (
 	"io"
 	"math/rand"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"tt"
 
 	"github.com/gdamore/tcell"
 )
 
+// CONFIG_DIRS holds the directories searched for user and system resources, in order of precedence.
 var CONFIG_DIRS []string
 
 func init() {
 	home, _ := os.LookupEnv("HOME")
 
+	configHome, ok := os.LookupEnv("XDG_CONFIG_HOME")
+	if !ok || configHome == "" {
+		configHome = filepath.Join(home, ".config")
+	}
+
+	dataDirs, ok := os.LookupEnv("XDG_DATA_DIRS")
+	if !ok || dataDirs == "" {
+		dataDirs = "/usr/local/share:/usr/share"
+	}
+
 	CONFIG_DIRS = []string{
+		filepath.Join(configHome, "tt"),
 		filepath.Join(home, ".tt"),
-		"/etc/tt",
 	}
+
+	for _, d := range filepath.SplitList(dataDirs) {
+		if d != "" {
+			CONFIG_DIRS = append(CONFIG_DIRS, filepath.Join(d, "tt"))
+		}
+	}
+
+	CONFIG_DIRS = append(CONFIG_DIRS, "/etc/tt")
 }
 
 type cell struct {
@@ -189,10 +212,40 @@ func calcStringDimensions(inputStr string) (numColumns, numRows int) {
 	return
 }
 
-// makeTcellColorFromHex converts a hex color string to a tcell.Color value.
+// makeTcellColorFromHex converts a color string to a tcell.Color value. Besides hex colors
+// (#rrggbb or #rgb) it accepts rgb(r, g, b) and X11 color names (e.g. "steelblue" or "gray40").
 func makeTcellColorFromHex(hexColor string) (tcell.Color, error) {
+	// Handle rgb(r, g, b) with each component in the range 0-255.
+	if strings.HasPrefix(hexColor, "rgb(") && strings.HasSuffix(hexColor, ")") {
+		var components [3]int32
+		parts := strings.Split(hexColor[4:len(hexColor)-1], ",")
+		if len(parts) != 3 {
+			return 0, fmt.Errorf("%s is not a valid rgb color", hexColor)
+		}
+
+		for i, p := range parts {
+			v, err := strconv.Atoi(strings.TrimSpace(p))
+			if err != nil || v < 0 || v > 255 {
+				return 0, fmt.Errorf("%s is not a valid rgb color", hexColor)
+			}
+			components[i] = int32(v)
+		}
+
+		return tcell.NewRGBColor(components[0], components[1], components[2]), nil
+	}
+
+	// Handle color names.
+	if hexColor != "" && hexColor[0] != '#' {
+		return makeTcellColorFromName(hexColor)
+	}
+
+	// Expand the short form (like "#FFF") to the long one.
+	if len(hexColor) == 4 {
+		hexColor = string([]byte{'#', hexColor[1], hexColor[1], hexColor[2], hexColor[2], hexColor[3], hexColor[3]})
+	}
+
 	// Validate that the string is a 7-character hex color (like "#FFFFFF").
-	if len(hexColor) != 7 || hexColor[0] != '#' {
+	if len(hexColor) != 7 || hexColor[0] != '#' || strings.Trim(hexColor[1:], "0123456789abcdefABCDEF") != "" {
 		return 0, fmt.Errorf("%s is not a valid hex color", hexColor)
 	}
 
@@ -220,6 +273,18 @@ func makeTcellColorFromHex(hexColor string) (tcell.Color, error) {
 	return tcell.NewRGBColor(redComponent, greenComponent, blueComponent), nil
 }
 
+// makeTcellColorFromName converts an X11 color name to an RGB tcell.Color value, ignoring
+// case and spaces.
+func makeTcellColorFromName(name string) (tcell.Color, error) {
+	normalized := strings.ToLower(strings.Replace(name, " ", "", -1))
+
+	if c, ok := x11Colors[normalized]; ok {
+		return tcell.NewHexColor(c), nil
+	}
+
+	return 0, fmt.Errorf("%s is not a known color name", name)
+}
+
 func readResource(typ, name string) []byte {
 	if name == "-" {
 		b, err := io.ReadAll(os.Stdin)
@@ -240,5 +305,61 @@ func readResource(typ, name string) []byte {
 		}
 	}
 
-	return readPackedFile(filepath.Join(typ, name))
+	return readBuiltinResource(path.Join(typ, name))
+}
+
+// readBuiltinResource returns the contents of the given embedded resource (e.g. "themes/gruvbox")
+// or nil if there is no such resource.
+func readBuiltinResource(name string) []byte {
+	b, err := tt.Resources.ReadFile(name)
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// resourceEntry describes a resource available to readResource and where it comes from.
+type resourceEntry struct {
+	name       string
+	source     string
+	shadowedBy string // The source of the resource of the same name which is used instead, if any.
+}
+
+// listResources returns every resource of the given type found in CONFIG_DIRS and the
+// builtin resources, sorted by name and then in the order readResource searches them.
+func listResources(typ string) []resourceEntry {
+	var entries []resourceEntry
+	providedBy := map[string]string{}
+
+	add := func(name, source string) {
+		entries = append(entries, resourceEntry{name, source, providedBy[name]})
+		if _, ok := providedBy[name]; !ok {
+			providedBy[name] = source
+		}
+	}
+
+	for _, d := range CONFIG_DIRS {
+		dir := filepath.Join(d, typ)
+		files, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, f := range files {
+			if !f.IsDir() {
+				add(f.Name(), dir)
+			}
+		}
+	}
+
+	builtin, _ := tt.Resources.ReadDir(typ)
+	for _, f := range builtin {
+		if !f.IsDir() {
+			add(f.Name(), "builtin")
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+	return entries
 }